@@ -0,0 +1,60 @@
+package nmea
+
+import "sort"
+
+// AlarmPanel struct keeps the state of alarms reported by ALR sentences and
+// applies acknowledgements received from ACK sentences
+type AlarmPanel struct {
+	alarms map[int]GPALR // Last state of alarms by alarm number
+}
+
+// NewAlarmPanel allocate AlarmPanel struct
+func NewAlarmPanel() *AlarmPanel {
+	return &AlarmPanel{alarms: make(map[int]GPALR)}
+}
+
+// Update feed AlarmPanel with a message, other messages than ALR and ACK are
+// ignored. Return the new state of the alarm when it changed: the ALR sentence
+// to broadcast back is the state returned on acknowledgement
+func (p *AlarmPanel) Update(msg NMEA) *GPALR {
+	switch m := msg.(type) {
+	case *GPALR:
+		last, ok := p.alarms[m.AlarmID]
+		p.alarms[m.AlarmID] = *m
+		if ok && last.Active == m.Active && last.Acknowledged == m.Acknowledged {
+			return nil
+		}
+		alr := *m
+		return &alr
+	case *GPACK:
+		alr, ok := p.alarms[m.AlarmID]
+		if !ok || alr.Active != Valid || alr.Acknowledged == Valid {
+			return nil
+		}
+		alr.Acknowledged = Valid
+		p.alarms[m.AlarmID] = alr
+		return &alr
+	}
+	return nil
+}
+
+// Active return the alarms whose threshold is exceeded, ordered by alarm number
+func (p AlarmPanel) Active() []GPALR {
+	return p.filter(func(alr GPALR) bool { return alr.Active == Valid })
+}
+
+// Unacknowledged return the active alarms not yet acknowledged, ordered by alarm number
+func (p AlarmPanel) Unacknowledged() []GPALR {
+	return p.filter(func(alr GPALR) bool { return alr.Active == Valid && alr.Acknowledged != Valid })
+}
+
+func (p AlarmPanel) filter(keep func(GPALR) bool) []GPALR {
+	alarms := make([]GPALR, 0)
+	for _, alr := range p.alarms {
+		if keep(alr) {
+			alarms = append(alarms, alr)
+		}
+	}
+	sort.Slice(alarms, func(i, j int) bool { return alarms[i].AlarmID < alarms[j].AlarmID })
+	return alarms
+}
@@ -0,0 +1,153 @@
+package nmea
+
+import "testing"
+
+func TestAlarmPanel(t *testing.T) {
+	p := NewAlarmPanel()
+
+	for _, raw := range []string{
+		"$IIALR,142507.00,031,A,V,DEPTH ALARM*43",
+		"$GPALR,,005,V,A,ANCHOR WATCH*36",
+	} {
+		msg, err := Parse(raw)
+		if err != nil {
+			t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+		}
+		if alr := p.Update(msg); alr == nil {
+			t.Fatalf("New alarm should be returned (got: nil for %s)", raw)
+		}
+	}
+
+	unack := p.Unacknowledged()
+	if len(unack) != 1 || unack[0].AlarmID != 31 {
+		t.Fatalf("Wrong unacknowledged alarms (got: %+v)", unack)
+	}
+
+	// Acknowledge alarm and broadcast back its new state
+	msg, err := Parse(unack[0].ACK().Serialize())
+	if err != nil {
+		t.Fatal(err)
+	}
+	alr := p.Update(msg)
+	if alr == nil || alr.Acknowledged != Valid || alr.Description != "DEPTH ALARM" {
+		t.Fatalf("Wrong acknowledged alarm (got: %+v)", alr)
+	}
+
+	if len(p.Unacknowledged()) != 0 || len(p.Active()) != 1 {
+		t.Fatalf("Wrong alarms after acknowledgement (got: %+v)", p.Active())
+	}
+
+	// Acknowledging twice doesn't change state
+	if alr := p.Update(msg); alr != nil {
+		t.Fatalf("Alarm shouldn't change (got: %+v)", alr)
+	}
+}
+
+func TestALFAssembler(t *testing.T) {
+	a := NewALFAssembler()
+	alerts := make([]*Alert, 0)
+	for _, raw := range []string{
+		"$IIALF,2,1,3,100110.00,B,A,V,,3008,1,2,0,HEADING LOST*79",
+		"$IIALF,1,1,0,124304.50,A,W,A,,192,1,1,0,LOST TARGET*14",
+		"$IIALF,2,2,3,,,,,,,,,,NO HDG REFERENCE*55",
+	} {
+		msg, err := Parse(raw)
+		if err != nil {
+			t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+		}
+		alert, err := a.Add(msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if alert != nil {
+			alerts = append(alerts, alert)
+		}
+	}
+
+	if len(alerts) != 2 {
+		t.Fatalf("Wrong number of assembled alerts (got: %d)", len(alerts))
+	}
+
+	if alerts[0].AlertID != 192 || alerts[0].Priority != WarningPriority || len(alerts[0].Description) > 0 {
+		t.Fatalf("Wrong single sentence alert (got: %+v)", alerts[0])
+	}
+
+	if alerts[1].Text != "HEADING LOST" || alerts[1].Description != "NO HDG REFERENCE" || alerts[1].State != ActiveUnacknowledgedAlert {
+		t.Fatalf("Wrong two sentences alert (got: %+v)", alerts[1])
+	}
+}
+
+func TestDSCDistress(t *testing.T) {
+	msg, err := Parse("$CDDSC,12,3380400790,12,06,00,1423108312,2019,,,S,E*6A")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dsc := msg.(*GPDSC)
+
+	if mmsi, ok := dsc.MMSI(); !ok || mmsi != 338040079 {
+		t.Fatalf("Wrong MMSI (got: %d)", mmsi)
+	}
+
+	if nature, ok := dsc.NatureOfDistress(); !ok || nature != DisabledAdrift {
+		t.Fatalf("Wrong nature of distress (got: %s)", nature)
+	}
+
+	// Quadrant 1 is north-west
+	p, ok := dsc.Position()
+	if !ok || Round(float64(p.Latitude), 4) != 42.5167 || Round(float64(p.Longitude), 4) != -83.2 {
+		t.Fatalf("Wrong distress position (got: %+v)", p)
+	}
+
+	if tod, ok := dsc.Time(); !ok || tod.Hour != 20 || tod.Minute != 19 {
+		t.Fatalf("Wrong distress time (got: %s)", tod)
+	}
+
+	msg, err = Parse("$CDDSC,20,2320001230,00,21,26,0000000016,,,,R,*2C")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := msg.(*GPDSC).Position(); ok {
+		t.Fatal("Channel of routine call shouldn't be decoded as position")
+	}
+}
+
+func TestDSCAssembler(t *testing.T) {
+	a := NewDSCAssembler()
+	var call *DSCCall
+	for _, raw := range []string{
+		"$CDDSC,12,3380400790,12,06,00,1423108312,2019,,,S,E*6A",
+		"$CDDSE,1,1,A,3380400790,00,45894494*1B",
+	} {
+		msg, err := Parse(raw)
+		if err != nil {
+			t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+		}
+		if call, err = a.Add(msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if call == nil || len(call.Expansions) != 1 {
+		t.Fatalf("Wrong assembled DSC call (got: %+v)", call)
+	}
+
+	// 42°31.4589'N 083°12.4494'W
+	p, ok := call.Position()
+	if !ok || Round(float64(p.Latitude), 6) != 42.524315 || Round(float64(p.Longitude), 6) != -83.20749 {
+		t.Fatalf("Wrong enhanced position (got: %+v)", p)
+	}
+}
+
+func TestManOverboard(t *testing.T) {
+	msg, err := Parse("$GPRMC,135608.130,A,5106.25,N,12149.76,E,1.2,231.7,131218,,,A*62")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, _ := NewFix(msg)
+
+	activation, _ := ParseTimeOfDay("135407.60")
+	mob := NewManOverboard("12A3F", activation, f, 538007213)
+	if wanted := "$GPMOB,12A3F,A,135407.60,0,131218,135608.13,5106.25,N,12149.76,E,231.7,1.2,538007213,0*2D"; mob.Serialize() != wanted {
+		t.Fatalf("Wrong MOB notification (got: %s, wanted: %s)", mob.Serialize(), wanted)
+	}
+}
@@ -0,0 +1,76 @@
+package nmea
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// AnchorWatch struct monitors the vessel position against a circle around the anchor
+type AnchorWatch struct {
+	Anchor   Position `json:"anchor"`
+	Radius   float64  `json:"radius"`   // Radius of the swinging circle in meters
+	Dragging bool     `json:"dragging"` // True while the vessel is outside the circle
+}
+
+// AnchorAlarm struct is the event raised by AnchorWatch
+type AnchorAlarm struct {
+	Fix      Fix
+	Distance float64 // Distance from anchor in meters
+	Bearing  float64 // Bearing from anchor in degree (true)
+	Dragging bool    // False when the vessel went back inside the circle
+}
+
+// String return AnchorAlarm as human string
+func (a AnchorAlarm) String() string {
+	if !a.Dragging {
+		return fmt.Sprintf("back in anchor circle (distance: %.1fm, bearing: %.0f°)", a.Distance, a.Bearing)
+	}
+	return fmt.Sprintf("anchor dragging (distance: %.1fm, bearing: %.0f°)", a.Distance, a.Bearing)
+}
+
+// NewAnchorWatch allocate AnchorWatch struct with anchor position and radius in meters
+func NewAnchorWatch(anchor Position, radius float64) (*AnchorWatch, error) {
+	if radius <= 0 {
+		return nil, fmt.Errorf("Invalid anchor radius, should be positive (got: %f)", radius)
+	}
+	return &AnchorWatch{Anchor: anchor, Radius: radius}, nil
+}
+
+// LoadAnchorWatch reload AnchorWatch state previously persisted with Save()
+func LoadAnchorWatch(r io.Reader) (*AnchorWatch, error) {
+	w := &AnchorWatch{}
+	if err := json.NewDecoder(r).Decode(w); err != nil {
+		return nil, fmt.Errorf("Unable to load anchor watch state: %s", err.Error())
+	}
+	if w.Radius <= 0 {
+		return nil, fmt.Errorf("Invalid anchor radius, should be positive (got: %f)", w.Radius)
+	}
+	return w, nil
+}
+
+// Save persist AnchorWatch state as JSON
+func (w AnchorWatch) Save(wr io.Writer) error {
+	return json.NewEncoder(wr).Encode(w)
+}
+
+// Update feed AnchorWatch with a new fix, return an alarm for each valid fix
+// outside the circle and once when the vessel goes back inside, nil otherwise
+func (w *AnchorWatch) Update(f Fix) *AnchorAlarm {
+	if !f.IsValid {
+		return nil
+	}
+
+	alarm := AnchorAlarm{
+		Fix:      f,
+		Distance: w.Anchor.DistanceTo(f.Position),
+		Bearing:  w.Anchor.BearingTo(f.Position),
+	}
+
+	if alarm.Dragging = alarm.Distance > w.Radius; !alarm.Dragging && !w.Dragging {
+		return nil
+	}
+
+	w.Dragging = alarm.Dragging
+	return &alarm
+}
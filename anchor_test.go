@@ -0,0 +1,75 @@
+package nmea
+
+import (
+	"bytes"
+	"math"
+	"testing"
+)
+
+func TestAnchorWatch(t *testing.T) {
+	anchor := Position{Latitude: 47.5, Longitude: -3.0}
+	w, err := NewAnchorWatch(anchor, 50)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// ~11m north of the anchor
+	if alarm := w.Update(Fix{Position: Position{Latitude: 47.5001, Longitude: -3.0}, IsValid: Valid}); alarm != nil {
+		t.Fatalf("Unexpected alarm inside circle: %s", alarm)
+	}
+
+	// ~111m north of the anchor
+	alarm := w.Update(Fix{Position: Position{Latitude: 47.501, Longitude: -3.0}, IsValid: Valid})
+	if alarm == nil || !alarm.Dragging {
+		t.Fatal("Expected dragging alarm outside circle")
+	}
+	if math.Abs(alarm.Distance-111.2) > 0.5 || math.Abs(alarm.Bearing) > 0.1 {
+		t.Fatalf("Wrong distance or bearing (got: %f, %f)", alarm.Distance, alarm.Bearing)
+	}
+
+	// Invalid fixes are ignored
+	if alarm := w.Update(Fix{Position: anchor}); alarm != nil {
+		t.Fatal("Invalid fix shouldn't raise alarm")
+	}
+
+	var buf bytes.Buffer
+	if err := w.Save(&buf); err != nil {
+		t.Fatal(err)
+	}
+	reloaded, err := LoadAnchorWatch(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *reloaded != *w {
+		t.Fatalf("Wrong reloaded state (got: %+v, wanted: %+v)", *reloaded, *w)
+	}
+
+	if alarm := reloaded.Update(Fix{Position: anchor, IsValid: Valid}); alarm == nil || alarm.Dragging {
+		t.Fatal("Expected end of dragging alarm when back inside circle")
+	}
+}
+
+func TestAnchorWatchEstimatedFix(t *testing.T) {
+	w, err := NewAnchorWatch(Position{Latitude: 47.6, Longitude: -3.0}, 50)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Dead reckoning position isn't a valid fix
+	for raw, valid := range map[string]DataValid{
+		"$GPGGA,015540.000,4730.00000,N,00300.00000,W,6,00,,0051.6,M,0.0,M,,*63":    Invalid,
+		"$GPGGA,015540.000,4730.00000,N,00300.00000,W,1,08,0.9,0051.6,M,0.0,M,,*4B": Valid,
+	} {
+		msg, err := Parse(raw)
+		if err != nil {
+			t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+		}
+		f, ok := NewFix(msg)
+		if !ok || f.IsValid != valid {
+			t.Fatalf("Wrong fix validity for \"%s\" (got: %t)", raw, f.IsValid)
+		}
+		if alarm := w.Update(f); (alarm != nil) != bool(valid) {
+			t.Fatalf("Wrong alarm for \"%s\" (got: %v)", raw, alarm)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package nmea
+
+import "fmt"
+
+// Attitude struct is the vessel attitude extracted from a NMEA message
+type Attitude struct {
+	Heading *float64 // True heading in degree, nil if not provided
+	Roll    float64  // In degree, positive when port side up (starboard down)
+	Pitch   float64  // In degree, positive when bow up
+	Heave   *float64 // In meters, positive up, nil if not provided
+}
+
+// String return Attitude as human string
+func (a Attitude) String() string {
+	return fmt.Sprintf("roll %.2f°, pitch %.2f°", a.Roll, a.Pitch)
+}
+
+// NewAttitude extract Attitude from a parsed NMEA message (PASHR, PRDID, PHTRO, PSXN23 or PFECGPatt),
+// return false if the message doesn't provide an attitude
+func NewAttitude(msg NMEA) (Attitude, bool) {
+	switch m := msg.(type) {
+	case *PASHR:
+		a := Attitude{Roll: m.Roll, Pitch: m.Pitch}
+		if m.IsTrueHeading {
+			heading := m.Heading
+			a.Heading = &heading
+		}
+		heave := m.Heave
+		a.Heave = &heave
+		return a, true
+	case *PRDID:
+		heading := m.Heading
+		return Attitude{Heading: &heading, Roll: m.Roll, Pitch: m.Pitch}, true
+	case *PHTRO:
+		return Attitude{Roll: m.Roll, Pitch: m.Pitch}, true
+	case *PSXN23:
+		heading, heave := m.Heading, -m.Heave // Heave is positive down
+		return Attitude{Heading: &heading, Roll: m.Roll, Pitch: m.Pitch, Heave: &heave}, true
+	case *PFECGPatt:
+		heading := m.Yaw
+		return Attitude{Heading: &heading, Roll: m.Roll, Pitch: m.Pitch}, true
+	}
+	return Attitude{}, false
+}
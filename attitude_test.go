@@ -0,0 +1,72 @@
+package nmea
+
+import (
+	"testing"
+	"time"
+)
+
+func TestInertialAttitude(t *testing.T) {
+	msg, err := Parse("$PASHR,085335.000,224.19,T,-01.26,+00.83,+00.00,0.101,0.113,0.267,1,0*06")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a, ok := NewAttitude(msg)
+	if !ok || a.Heading == nil || *a.Heading != 224.19 || a.Roll != -1.26 || a.Pitch != 0.83 || *a.Heave != 0 {
+		t.Fatalf("Wrong inertial attitude (got: %+v)", a)
+	}
+
+	if pashr := msg.(*PASHR); *pashr.HeadingAccuracy != 0.267 || pashr.GPSQuality != 1 {
+		t.Fatalf("Wrong inertial attitude accuracy (got: %+v)", pashr)
+	}
+
+	now := time.Now()
+	f := NewHeadingFusion(time.Minute)
+	f.Update(msg, now)
+	if h, ok := f.Heading(now); !ok || h.Source != HeadingSourceTrue || h.Value != 224.19 {
+		t.Fatalf("Wrong fused heading (got: %+v)", h)
+	}
+}
+
+func TestMotionReferenceAttitude(t *testing.T) {
+	msg, err := Parse("$PRDID,-1.31,7.81,047.31*58")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a, ok := NewAttitude(msg)
+	if !ok || *a.Heading != 47.31 || a.Roll != 7.81 || a.Pitch != -1.31 || a.Heave != nil {
+		t.Fatalf("Wrong motion reference attitude (got: %+v)", a)
+	}
+
+	// Bow down and port side down
+	msg, err = Parse("$PHTRO,0.84,P,3.07,B*4B")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a, ok = NewAttitude(msg)
+	if !ok || a.Heading != nil || a.Roll != -3.07 || a.Pitch != -0.84 {
+		t.Fatalf("Wrong motion reference attitude (got: %+v)", a)
+	}
+}
+
+func TestSeatexAttitude(t *testing.T) {
+	msg, err := Parse("$PSXN,23,0.30,-0.97,298.57,0.13*1B")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a, ok := NewAttitude(msg)
+	if !ok || *a.Heading != 298.57 || a.Roll != 0.3 || a.Pitch != -0.97 || *a.Heave != -0.13 {
+		t.Fatalf("Wrong Seatex attitude (got: %+v)", a)
+	}
+
+	msg, err = Parse("$PSXN,20,1,0,0,2*38")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if q := msg.(*PSXN20); q.HorizontalQuality != SXNReduced || q.RollPitchQuality != SXNInvalid {
+		t.Fatalf("Wrong Seatex quality (got: %+v)", q)
+	}
+}
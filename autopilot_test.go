@@ -0,0 +1,48 @@
+package nmea
+
+import "testing"
+
+func TestHeadingSteeringCommand(t *testing.T) {
+	// Magnetic heading wraps around north with an easterly variation
+	hsc := NewHeadingSteeringCommand(2, 5)
+	if wanted := "$GPHSC,002.0,T,357.0,M,C*3A"; hsc.Serialize() != wanted {
+		t.Fatalf("Wrong heading steering command (got: %s, wanted: %s)", hsc.Serialize(), wanted)
+	}
+}
+
+func TestHeadingTrackControl(t *testing.T) {
+	msg, err := Parse("$INHTC,A,,,T,R,35.0,10.0,1.20,,045.0,0.25,047.5,M,C*15")
+	if err != nil {
+		t.Fatal(err)
+	}
+	htc := msg.(*GPHTC)
+
+	if htc.Override != Valid || htc.SteeringMode != TrackControlSteering || htc.TurnMode != RadiusControlledTurn {
+		t.Fatalf("Wrong heading/track control modes (got: %+v)", htc.HeadingTrackControl)
+	}
+
+	if htc.RudderAngle != nil || htc.TurnRate != nil || *htc.Track != 47.5 || htc.HeadingReference != MagneticReference {
+		t.Fatalf("Wrong heading/track control settings (got: %+v)", htc.HeadingTrackControl)
+	}
+
+	// Generated without header
+	if raw := (GPHTC{HeadingTrackControl: htc.HeadingTrackControl, Status: htc.Status}).Serialize(); raw[:6] != "$GPHTC" {
+		t.Fatalf("Wrong generated HTC (got: %s)", raw)
+	}
+}
+
+func TestHeadingTrackControlData(t *testing.T) {
+	msg, err := Parse("$AGHTD,A,5.0,R,T,T,35.0,10.0,,20.0,045.0,0.25,047.5,M,V,A,V,052.1*6B")
+	if err != nil {
+		t.Fatal(err)
+	}
+	htd := msg.(*GPHTD)
+
+	if htd.IsWithinLimits() || htd.RudderStatus != Invalid || htd.OffHeadingStatus != Valid {
+		t.Fatalf("Wrong heading/track control status (got: %+v)", htd)
+	}
+
+	if e, ok := htd.HeadingError(); !ok || Round(e, 1) != 7.1 {
+		t.Fatalf("Wrong heading error (got: %.1f)", e)
+	}
+}
@@ -0,0 +1,84 @@
+package nmea
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// ClockOffset struct estimates the offset of the local clock against GNSS time
+// from RMC/ZDA sentences and their receipt timestamps
+type ClockOffset struct {
+	Alpha float64 // Smoothing factor (0 ~ 1) of offset and jitter
+
+	offset  float64 // Smoothed offset in seconds
+	jitter  float64 // Smoothed mean absolute deviation in seconds
+	samples int
+	lastUTC time.Time
+}
+
+// NewClockOffset allocate ClockOffset struct with smoothing factor alpha (0 < alpha <= 1)
+func NewClockOffset(alpha float64) (*ClockOffset, error) {
+	if alpha <= 0 || alpha > 1 {
+		return nil, fmt.Errorf("Invalid smoothing factor, should be in range ]0, 1] (got: %f)", alpha)
+	}
+	return &ClockOffset{Alpha: alpha}, nil
+}
+
+// Update feed ClockOffset with a message received at the local time received.
+// Only valid RMC and ZDA sentences are used, return false if message was ignored
+func (c *ClockOffset) Update(msg NMEA, received time.Time) bool {
+	switch m := msg.(type) {
+	case *GPRMC:
+		if !m.IsValid {
+			return false
+		}
+		return c.AddSample(m.DateTimeUTC, received)
+	case *GPZDA:
+		return c.AddSample(m.DateTimeUTC, received)
+	}
+	return false
+}
+
+// AddSample feed ClockOffset with a GNSS UTC time and local receipt time,
+// a sample with the same UTC time than the previous one (ie: RMC and ZDA
+// of the same epoch) is ignored
+func (c *ClockOffset) AddSample(utc, received time.Time) bool {
+	if utc.Equal(c.lastUTC) {
+		return false
+	}
+	c.lastUTC = utc
+
+	offset := received.Sub(utc).Seconds()
+	if c.samples++; c.samples == 1 {
+		c.offset = offset
+		return true
+	}
+
+	deviation := math.Abs(offset - c.offset)
+	c.offset += c.Alpha * (offset - c.offset)
+	c.jitter += c.Alpha * (deviation - c.jitter)
+	return true
+}
+
+// Offset return the smoothed offset of local clock against GNSS time
+// (positive if local clock is ahead), false if no sample received yet
+func (c ClockOffset) Offset() (time.Duration, bool) {
+	return time.Duration(c.offset * float64(time.Second)), c.samples > 0
+}
+
+// Jitter return the smoothed mean absolute deviation of samples against the offset
+func (c ClockOffset) Jitter() time.Duration {
+	return time.Duration(c.jitter * float64(time.Second))
+}
+
+// Samples return the number of samples used
+func (c ClockOffset) Samples() int {
+	return c.samples
+}
+
+// Now return local time corrected with the estimated offset
+func (c ClockOffset) Now() time.Time {
+	offset, _ := c.Offset()
+	return time.Now().Add(-offset).UTC()
+}
@@ -0,0 +1,45 @@
+package nmea
+
+import (
+	"testing"
+	"time"
+)
+
+func TestClockOffset(t *testing.T) {
+	if _, err := NewClockOffset(1.5); err == nil {
+		t.Fatal("Expected error for invalid smoothing factor")
+	}
+
+	c, err := NewClockOffset(0.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := c.Offset(); ok {
+		t.Fatal("Offset shouldn't be available without sample")
+	}
+
+	msg, err := Parse("$GPZDA,201530.00,04,07,2002,00,00*60")
+	if err != nil {
+		t.Fatal(err)
+	}
+	utc := msg.(*GPZDA).DateTimeUTC
+
+	// Local clock 2 seconds ahead, then 2.4 seconds
+	if !c.Update(msg, utc.Add(2*time.Second)) {
+		t.Fatal("ZDA sample should be used")
+	}
+	if c.Update(msg, utc.Add(3*time.Second)) {
+		t.Fatal("Sample of the same epoch should be ignored")
+	}
+	c.AddSample(utc.Add(time.Second), utc.Add(3400*time.Millisecond))
+
+	if offset, ok := c.Offset(); !ok || offset.Round(time.Millisecond) != 2200*time.Millisecond || c.Jitter().Round(time.Millisecond) != 200*time.Millisecond || c.Samples() != 2 {
+		t.Fatalf("Wrong clock offset (got: %s, jitter: %s)", offset, c.Jitter())
+	}
+
+	// ZDA crafted from scratch
+	zda := GPZDA{DateTimeUTC: utc}
+	if s := zda.Serialize(); s[:6] != "$GPZDA" {
+		t.Fatalf("Wrong ZDA serialization (got: %s)", s)
+	}
+}
@@ -40,6 +40,26 @@ const (
 	TalkerIDBD TalkerID = "BD"
 	// TalkerIDQZ QZSS regional GPS augmentation system (Japan)
 	TalkerIDQZ TalkerID = "QZ"
+	// TalkerIDHE Heading, North seeking gyro
+	TalkerIDHE TalkerID = "HE"
+	// TalkerIDHC Heading, Magnetic compass
+	TalkerIDHC TalkerID = "HC"
+	// TalkerIDTI Turn Rate Indicator
+	TalkerIDTI TalkerID = "TI"
+	// TalkerIDAG Autopilot, General
+	TalkerIDAG TalkerID = "AG"
+	// TalkerIDVW Velocity Sensor, Speed Log, Water, Mechanical
+	TalkerIDVW TalkerID = "VW"
+	// TalkerIDWI Weather Instruments
+	TalkerIDWI TalkerID = "WI"
+	// TalkerIDSD Sounder, Depth
+	TalkerIDSD TalkerID = "SD"
+	// TalkerIDRA Radar and/or Radar Plotting
+	TalkerIDRA TalkerID = "RA"
+	// TalkerIDCT Communications, Radio-Telephone (MF/HF)
+	TalkerIDCT TalkerID = "CT"
+	// TalkerIDTR Transit Navigation System
+	TalkerIDTR TalkerID = "TR"
 )
 
 // TypeID struct
@@ -69,6 +89,29 @@ func (t MtkTypeID) Serialize() string {
 	return t.TypeID.Serialize() + t.PacketType
 }
 
+// SrfTypeID struct
+type SrfTypeID struct {
+	TypeID
+	MessageID string
+}
+
+// Serialize SrfTypeID struct with TypeID and MessageID
+func (t SrfTypeID) Serialize() string {
+	return t.TypeID.Serialize() + t.MessageID
+}
+
+// SubTypeID struct is a proprietary header followed by a sub-type as first
+// data field (ie: PUBX,00)
+type SubTypeID struct {
+	TypeID
+	SubType string
+}
+
+// Serialize SubTypeID struct with TypeID and SubType
+func (t SubTypeID) Serialize() string {
+	return t.TypeID.Serialize() + FieldDelimiter + t.SubType
+}
+
 // TalkerID type as string
 type TalkerID string
 
@@ -82,97 +125,259 @@ var TypeIDs map[string]Header
 
 func init() {
 	TypeIDs = map[string]Header{
-		"GPAAM":   TypeID{Talker: TalkerIDGPS, Code: "AAM"},                                               // Waypoint Arrival Alarm
-		"GPALM":   TypeID{Talker: TalkerIDGPS, Code: "ALM"},                                               // GPS Almanac Data
-		"GPAPA":   TypeID{Talker: TalkerIDGPS, Code: "APA"},                                               // Autopilot Sentence "A"
-		"GPAPB":   TypeID{Talker: TalkerIDGPS, Code: "APB"},                                               // Autopilot Sentence "B"
-		"GPASD":   TypeID{Talker: TalkerIDGPS, Code: "ASD"},                                               // Autopilot System Data
-		"GPBEC":   TypeID{Talker: TalkerIDGPS, Code: "BEC"},                                               // Bearing & Distance to Waypoint, Dead Reckoning
-		"GPBOD":   TypeID{Talker: TalkerIDGPS, Code: "BOD"},                                               // Bearing, Origin to Destination
-		"GPBWC":   TypeID{Talker: TalkerIDGPS, Code: "BWC"},                                               // Bearing & Distance to Waypoint, Great Circle
-		"GPBWR":   TypeID{Talker: TalkerIDGPS, Code: "BWR"},                                               // Bearing & Distance to Waypoint, Rhumb Line
-		"GPBWW":   TypeID{Talker: TalkerIDGPS, Code: "BWW"},                                               // Bearing, Waypoint to Waypoint
-		"GPDBT":   TypeID{Talker: TalkerIDGPS, Code: "DBT"},                                               // Depth Below Transducer
-		"GPDCN":   TypeID{Talker: TalkerIDGPS, Code: "DCN"},                                               // Decca Position
-		"GPDPT":   TypeID{Talker: TalkerIDGPS, Code: "DPT"},                                               // Depth
-		"GPFSI":   TypeID{Talker: TalkerIDGPS, Code: "FSI"},                                               // Frequency Set Information
-		"GPGGA":   TypeID{Talker: TalkerIDGPS, Code: "GGA"},                                               // Global Positioning System Fix Data
-		"GPGLC":   TypeID{Talker: TalkerIDGPS, Code: "GLC"},                                               // Geographic Position, Loran-C
-		"GPGLL":   TypeID{Talker: TalkerIDGPS, Code: "GLL"},                                               // Geographic Position, Latitude/Longitude
-		"GPGSA":   TypeID{Talker: TalkerIDGPS, Code: "GSA"},                                               // GPS DOP and Active Satellites
-		"GPGSV":   TypeID{Talker: TalkerIDGPS, Code: "GSV"},                                               // GPS Satellites in View
-		"GPGXA":   TypeID{Talker: TalkerIDGPS, Code: "GXA"},                                               // TRANSIT Position
-		"GPHDG":   TypeID{Talker: TalkerIDGPS, Code: "HDG"},                                               // Heading, Deviation & Variation
-		"GPHDT":   TypeID{Talker: TalkerIDGPS, Code: "HDT"},                                               // Heading, True
-		"GPHSC":   TypeID{Talker: TalkerIDGPS, Code: "HSC"},                                               // Heading Steering Command
-		"GPLCD":   TypeID{Talker: TalkerIDGPS, Code: "LCD"},                                               // Loran-C Signal Data
-		"GPMTA":   TypeID{Talker: TalkerIDGPS, Code: "MTA"},                                               // Air Temperature (to be phased out)
-		"GPMTW":   TypeID{Talker: TalkerIDGPS, Code: "MTW"},                                               // Water Temperature
-		"GPMWD":   TypeID{Talker: TalkerIDGPS, Code: "MWD"},                                               // Wind Direction
-		"GPMWV":   TypeID{Talker: TalkerIDGPS, Code: "MWV"},                                               // Wind Speed and Angle
-		"GPOLN":   TypeID{Talker: TalkerIDGPS, Code: "OLN"},                                               // Omega Lane Numbers
-		"GPOSD":   TypeID{Talker: TalkerIDGPS, Code: "OSD"},                                               // Own Ship Data
-		"GPR00":   TypeID{Talker: TalkerIDGPS, Code: "R00"},                                               // Waypoint active route (not standard)
-		"GPRMA":   TypeID{Talker: TalkerIDGPS, Code: "RMA"},                                               // Recommended Minimum Specific Loran-C Data
-		"GPRMB":   TypeID{Talker: TalkerIDGPS, Code: "RMB"},                                               // Recommended Minimum Navigation Information
-		"GPRMC":   TypeID{Talker: TalkerIDGPS, Code: "RMC"},                                               // Recommended Minimum Specific GPS/TRANSIT Data
-		"GPROT":   TypeID{Talker: TalkerIDGPS, Code: "ROT"},                                               // Rate of Turn
-		"GPRPM":   TypeID{Talker: TalkerIDGPS, Code: "RPM"},                                               // Revolutions
-		"GPRSA":   TypeID{Talker: TalkerIDGPS, Code: "RSA"},                                               // Rudder Sensor Angle
-		"GPRSD":   TypeID{Talker: TalkerIDGPS, Code: "RSD"},                                               // RADAR System Data
-		"GPRTE":   TypeID{Talker: TalkerIDGPS, Code: "RTE"},                                               // Routes
-		"GPSFI":   TypeID{Talker: TalkerIDGPS, Code: "SFI"},                                               // Scanning Frequency Information
-		"GPSTN":   TypeID{Talker: TalkerIDGPS, Code: "STN"},                                               // Multiple Data ID
-		"GPTRF":   TypeID{Talker: TalkerIDGPS, Code: "TRF"},                                               // Transit Fix Data
-		"GPTTM":   TypeID{Talker: TalkerIDGPS, Code: "TTM"},                                               // Tracked Target Message
-		"GPTXT":   TypeID{Talker: TalkerIDGPS, Code: "TXT"},                                               // Tracked Status of External Antenna
-		"GPVBW":   TypeID{Talker: TalkerIDGPS, Code: "VBW"},                                               // Dual Ground/Water Speed
-		"GPVDR":   TypeID{Talker: TalkerIDGPS, Code: "VDR"},                                               // Set and Drift
-		"GPVHW":   TypeID{Talker: TalkerIDGPS, Code: "VHW"},                                               // Water Speed and Heading
-		"GPVLW":   TypeID{Talker: TalkerIDGPS, Code: "VLW"},                                               // Distance Traveled through the Water
-		"GPVPW":   TypeID{Talker: TalkerIDGPS, Code: "VPW"},                                               // Speed, Measured Parallel to Wind
-		"GPVTG":   TypeID{Talker: TalkerIDGPS, Code: "VTG"},                                               // Track Made Good and Ground Speed
-		"GPWCV":   TypeID{Talker: TalkerIDGPS, Code: "WCV"},                                               // Waypoint Closure Velocity
-		"GPWNC":   TypeID{Talker: TalkerIDGPS, Code: "WNC"},                                               // Distance, Waypoint to Waypoint
-		"GPWPL":   TypeID{Talker: TalkerIDGPS, Code: "WPL"},                                               // Waypoint Location
-		"GPXDR":   TypeID{Talker: TalkerIDGPS, Code: "XDR"},                                               // Transducer Measurements
-		"GPXTE":   TypeID{Talker: TalkerIDGPS, Code: "XTE"},                                               // Cross-Track Error, Measured
-		"GPXTR":   TypeID{Talker: TalkerIDGPS, Code: "XTR"},                                               // Cross-Track Error, Dead Reckoning
-		"GPZDA":   TypeID{Talker: TalkerIDGPS, Code: "ZDA"},                                               // Time & Date
-		"GPZFO":   TypeID{Talker: TalkerIDGPS, Code: "ZFO"},                                               // UTC & Time from Origin Waypoint
-		"GPZTG":   TypeID{Talker: TalkerIDGPS, Code: "ZTG"},                                               // UTC & Time to Destination Waypoint
-		"PMTK010": MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "010"}, // PMTK_SYS_MSG
-		"PMTK011": MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "011"}, // PMTK_TXT_MSG
-		"PMTK001": MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "001"}, // PMTK_ACK
-		"PMTK101": MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "101"}, // PMTK_CMD_HOT_START
-		"PMTK102": MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "102"}, // PMTK_CMD_WARM_START
-		"PMTK103": MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "103"}, // PMTK_CMD_COLD_START
-		"PMTK104": MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "104"}, // PMTK_CMD_FULL_COLD_START
-		"PMTK161": MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "161"}, // PMTK_CMD_STANDBY_MODE
-		"PMTK183": MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "183"}, // PMTK_LOCUS_QUERY_STATUS
-		"PMTKLOG": MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "LOG"}, // PMTK_LOG
-		"PMTK184": MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "184"}, // PMTK_LOCUS_ERASE_FLASH
-		"PMTK185": MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "185"}, // PMTK_LOCUS_STOP_LOGGER
-		"PMTK622": MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "622"}, // PMTK_Q_LOCUS_DATA
-		"PMTK225": MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "225"}, // PMTK_SET_PERIODIC
-		"PMTK251": MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "251"}, // PMTK_SET_NMEA_BAUDRATE
-		"PMTK286": MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "286"}, // PMTK_SET_AIC_ENABLED
-		"PMTK300": MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "300"}, // PMTK_API_SET_FIX_CTL
-		"PMTK301": MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "301"}, // PMTK_API_SET_DGPS_MODE
-		"PMTK313": MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "313"}, // PMTK_API_SET_SBAS_ENABLED
-		"PMTK314": MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "314"}, // PMTK_API_SET_NMEA_OUTPUT
-		"PMTK386": MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "386"}, // PMTK_API_SET_STATIC_NAV_THD
-		"PMTK400": MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "400"}, // PMTK_API_Q_FIX_CTL
-		"PMTK401": MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "401"}, // PMTK_API_Q_DGPS_MODE
-		"PMTK413": MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "413"}, // PMTK_API_Q_SBAS_ENABLED
-		"PMTK414": MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "414"}, // PMTK_API_Q_NMEA_OUTPUT
-		"PMTK605": MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "605"}, // PMTK_Q_RELEASE
-		"PMTK500": MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "500"}, // PMTK_DT_FIX_CTL
-		"PMTK501": MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "501"}, // PMTK_DT_DGPS_MODE
-		"PMTK513": MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "513"}, // PMTK_DT_SBAS_ENABLED
-		"PMTK514": MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "514"}, // PMTK_DT_NMEA_OUTPUT
-		"PMTK705": MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "705"}, // PMTK_DT_RELEASE
-		"PMTK869": MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "869"}, // PMTK_EASY_ENABLE
+		"GPAAM":      TypeID{Talker: TalkerIDGPS, Code: "AAM"},                                               // Waypoint Arrival Alarm
+		"GPALM":      TypeID{Talker: TalkerIDGPS, Code: "ALM"},                                               // GPS Almanac Data
+		"GPAPA":      TypeID{Talker: TalkerIDGPS, Code: "APA"},                                               // Autopilot Sentence "A"
+		"GPAPB":      TypeID{Talker: TalkerIDGPS, Code: "APB"},                                               // Autopilot Sentence "B"
+		"GPASD":      TypeID{Talker: TalkerIDGPS, Code: "ASD"},                                               // Autopilot System Data
+		"GPBEC":      TypeID{Talker: TalkerIDGPS, Code: "BEC"},                                               // Bearing & Distance to Waypoint, Dead Reckoning
+		"GPBOD":      TypeID{Talker: TalkerIDGPS, Code: "BOD"},                                               // Bearing, Origin to Destination
+		"GPBWC":      TypeID{Talker: TalkerIDGPS, Code: "BWC"},                                               // Bearing & Distance to Waypoint, Great Circle
+		"GPBWR":      TypeID{Talker: TalkerIDGPS, Code: "BWR"},                                               // Bearing & Distance to Waypoint, Rhumb Line
+		"GPBWW":      TypeID{Talker: TalkerIDGPS, Code: "BWW"},                                               // Bearing, Waypoint to Waypoint
+		"GPDBT":      TypeID{Talker: TalkerIDGPS, Code: "DBT"},                                               // Depth Below Transducer
+		"SDDBT":      TypeID{Talker: TalkerIDSD, Code: "DBT"},                                                // Depth Below Transducer
+		"IIDBT":      TypeID{Talker: TalkerIDII, Code: "DBT"},                                                // Depth Below Transducer
+		"INDBT":      TypeID{Talker: TalkerIDIN, Code: "DBT"},                                                // Depth Below Transducer
+		"GPDCN":      TypeID{Talker: TalkerIDGPS, Code: "DCN"},                                               // Decca Position
+		"GPDPT":      TypeID{Talker: TalkerIDGPS, Code: "DPT"},                                               // Depth
+		"GPFSI":      TypeID{Talker: TalkerIDGPS, Code: "FSI"},                                               // Frequency Set Information
+		"GPGGA":      TypeID{Talker: TalkerIDGPS, Code: "GGA"},                                               // Global Positioning System Fix Data
+		"GPGLC":      TypeID{Talker: TalkerIDGPS, Code: "GLC"},                                               // Geographic Position, Loran-C
+		"GPGLL":      TypeID{Talker: TalkerIDGPS, Code: "GLL"},                                               // Geographic Position, Latitude/Longitude
+		"GPGSA":      TypeID{Talker: TalkerIDGPS, Code: "GSA"},                                               // GPS DOP and Active Satellites
+		"GPGSV":      TypeID{Talker: TalkerIDGPS, Code: "GSV"},                                               // GPS Satellites in View
+		"GPGXA":      TypeID{Talker: TalkerIDGPS, Code: "GXA"},                                               // TRANSIT Position
+		"GPHDG":      TypeID{Talker: TalkerIDGPS, Code: "HDG"},                                               // Heading, Deviation & Variation
+		"GPHDT":      TypeID{Talker: TalkerIDGPS, Code: "HDT"},                                               // Heading, True
+		"GPHSC":      TypeID{Talker: TalkerIDGPS, Code: "HSC"},                                               // Heading Steering Command
+		"GPHTC":      TypeID{Talker: TalkerIDGPS, Code: "HTC"},                                               // Heading/Track Control Command
+		"GPHTD":      TypeID{Talker: TalkerIDGPS, Code: "HTD"},                                               // Heading/Track Control Data
+		"GPLCD":      TypeID{Talker: TalkerIDGPS, Code: "LCD"},                                               // Loran-C Signal Data
+		"GPMTA":      TypeID{Talker: TalkerIDGPS, Code: "MTA"},                                               // Air Temperature (to be phased out)
+		"GPMTW":      TypeID{Talker: TalkerIDGPS, Code: "MTW"},                                               // Water Temperature
+		"GPMWD":      TypeID{Talker: TalkerIDGPS, Code: "MWD"},                                               // Wind Direction
+		"GPMWV":      TypeID{Talker: TalkerIDGPS, Code: "MWV"},                                               // Wind Speed and Angle
+		"GPOLN":      TypeID{Talker: TalkerIDGPS, Code: "OLN"},                                               // Omega Lane Numbers
+		"GPOSD":      TypeID{Talker: TalkerIDGPS, Code: "OSD"},                                               // Own Ship Data
+		"GPR00":      TypeID{Talker: TalkerIDGPS, Code: "R00"},                                               // Waypoint active route (not standard)
+		"GPRMA":      TypeID{Talker: TalkerIDGPS, Code: "RMA"},                                               // Recommended Minimum Specific Loran-C Data
+		"GPRMB":      TypeID{Talker: TalkerIDGPS, Code: "RMB"},                                               // Recommended Minimum Navigation Information
+		"GPRMC":      TypeID{Talker: TalkerIDGPS, Code: "RMC"},                                               // Recommended Minimum Specific GPS/TRANSIT Data
+		"GPROT":      TypeID{Talker: TalkerIDGPS, Code: "ROT"},                                               // Rate of Turn
+		"GPRPM":      TypeID{Talker: TalkerIDGPS, Code: "RPM"},                                               // Revolutions
+		"GPRSA":      TypeID{Talker: TalkerIDGPS, Code: "RSA"},                                               // Rudder Sensor Angle
+		"GPRSD":      TypeID{Talker: TalkerIDGPS, Code: "RSD"},                                               // RADAR System Data
+		"GPRTE":      TypeID{Talker: TalkerIDGPS, Code: "RTE"},                                               // Routes
+		"GPSFI":      TypeID{Talker: TalkerIDGPS, Code: "SFI"},                                               // Scanning Frequency Information
+		"GPSTN":      TypeID{Talker: TalkerIDGPS, Code: "STN"},                                               // Multiple Data ID
+		"GPTRF":      TypeID{Talker: TalkerIDGPS, Code: "TRF"},                                               // Transit Fix Data
+		"GPTTM":      TypeID{Talker: TalkerIDGPS, Code: "TTM"},                                               // Tracked Target Message
+		"GPTXT":      TypeID{Talker: TalkerIDGPS, Code: "TXT"},                                               // Tracked Status of External Antenna
+		"GPVBW":      TypeID{Talker: TalkerIDGPS, Code: "VBW"},                                               // Dual Ground/Water Speed
+		"GPVDR":      TypeID{Talker: TalkerIDGPS, Code: "VDR"},                                               // Set and Drift
+		"GPVHW":      TypeID{Talker: TalkerIDGPS, Code: "VHW"},                                               // Water Speed and Heading
+		"GPVLW":      TypeID{Talker: TalkerIDGPS, Code: "VLW"},                                               // Distance Traveled through the Water
+		"GPVPW":      TypeID{Talker: TalkerIDGPS, Code: "VPW"},                                               // Speed, Measured Parallel to Wind
+		"GPVTG":      TypeID{Talker: TalkerIDGPS, Code: "VTG"},                                               // Track Made Good and Ground Speed
+		"GPWCV":      TypeID{Talker: TalkerIDGPS, Code: "WCV"},                                               // Waypoint Closure Velocity
+		"GPWNC":      TypeID{Talker: TalkerIDGPS, Code: "WNC"},                                               // Distance, Waypoint to Waypoint
+		"GPWPL":      TypeID{Talker: TalkerIDGPS, Code: "WPL"},                                               // Waypoint Location
+		"GPXDR":      TypeID{Talker: TalkerIDGPS, Code: "XDR"},                                               // Transducer Measurements
+		"GPXTE":      TypeID{Talker: TalkerIDGPS, Code: "XTE"},                                               // Cross-Track Error, Measured
+		"GPXTR":      TypeID{Talker: TalkerIDGPS, Code: "XTR"},                                               // Cross-Track Error, Dead Reckoning
+		"GPZDA":      TypeID{Talker: TalkerIDGPS, Code: "ZDA"},                                               // Time & Date
+		"GNZDA":      TypeID{Talker: TalkerIDGN, Code: "ZDA"},                                                // Time & Date
+		"GLZDA":      TypeID{Talker: TalkerIDGL, Code: "ZDA"},                                                // Time & Date
+		"GAZDA":      TypeID{Talker: TalkerIDGA, Code: "ZDA"},                                                // Time & Date
+		"GPZFO":      TypeID{Talker: TalkerIDGPS, Code: "ZFO"},                                               // UTC & Time from Origin Waypoint
+		"GPZTG":      TypeID{Talker: TalkerIDGPS, Code: "ZTG"},                                               // UTC & Time to Destination Waypoint
+		"GNGNS":      TypeID{Talker: TalkerIDGN, Code: "GNS"},                                                // GNSS Fix Data
+		"GPGNS":      TypeID{Talker: TalkerIDGPS, Code: "GNS"},                                               // GNSS Fix Data
+		"GLGSV":      TypeID{Talker: TalkerIDGL, Code: "GSV"},                                                // GLONASS Satellites in View
+		"GAGSV":      TypeID{Talker: TalkerIDGA, Code: "GSV"},                                                // Galileo Satellites in View
+		"GPGST":      TypeID{Talker: TalkerIDGPS, Code: "GST"},                                               // GNSS Pseudorange Error Statistics
+		"GNGST":      TypeID{Talker: TalkerIDGN, Code: "GST"},                                                // GNSS Pseudorange Error Statistics
+		"GPGBS":      TypeID{Talker: TalkerIDGPS, Code: "GBS"},                                               // GNSS Satellite Fault Detection
+		"GNGBS":      TypeID{Talker: TalkerIDGN, Code: "GBS"},                                                // GNSS Satellite Fault Detection
+		"GPGRS":      TypeID{Talker: TalkerIDGPS, Code: "GRS"},                                               // GNSS Range Residuals
+		"GNGRS":      TypeID{Talker: TalkerIDGN, Code: "GRS"},                                                // GNSS Range Residuals
+		"GPDTM":      TypeID{Talker: TalkerIDGPS, Code: "DTM"},                                               // Datum Reference
+		"GNDTM":      TypeID{Talker: TalkerIDGN, Code: "DTM"},                                                // Datum Reference
+		"HEHDT":      TypeID{Talker: TalkerIDHE, Code: "HDT"},                                                // Heading, True
+		"INHDT":      TypeID{Talker: TalkerIDIN, Code: "HDT"},                                                // Heading, True
+		"GPHDM":      TypeID{Talker: TalkerIDGPS, Code: "HDM"},                                               // Heading, Magnetic
+		"HCHDM":      TypeID{Talker: TalkerIDHC, Code: "HDM"},                                                // Heading, Magnetic
+		"IIHDM":      TypeID{Talker: TalkerIDII, Code: "HDM"},                                                // Heading, Magnetic
+		"HCHDG":      TypeID{Talker: TalkerIDHC, Code: "HDG"},                                                // Heading, Deviation & Variation
+		"IIHDG":      TypeID{Talker: TalkerIDII, Code: "HDG"},                                                // Heading, Deviation & Variation
+		"GPTHS":      TypeID{Talker: TalkerIDGPS, Code: "THS"},                                               // True Heading and Status
+		"INTHS":      TypeID{Talker: TalkerIDIN, Code: "THS"},                                                // True Heading and Status
+		"HETHS":      TypeID{Talker: TalkerIDHE, Code: "THS"},                                                // True Heading and Status
+		"HEROT":      TypeID{Talker: TalkerIDHE, Code: "ROT"},                                                // Rate of Turn
+		"TIROT":      TypeID{Talker: TalkerIDTI, Code: "ROT"},                                                // Rate of Turn
+		"IIRSA":      TypeID{Talker: TalkerIDII, Code: "RSA"},                                                // Rudder Sensor Angle
+		"AGRSA":      TypeID{Talker: TalkerIDAG, Code: "RSA"},                                                // Rudder Sensor Angle
+		"VWVHW":      TypeID{Talker: TalkerIDVW, Code: "VHW"},                                                // Water Speed and Heading
+		"IIVHW":      TypeID{Talker: TalkerIDII, Code: "VHW"},                                                // Water Speed and Heading
+		"IIVLW":      TypeID{Talker: TalkerIDII, Code: "VLW"},                                                // Distance Traveled through the Water
+		"VWVLW":      TypeID{Talker: TalkerIDVW, Code: "VLW"},                                                // Distance Traveled through the Water
+		"IIVBW":      TypeID{Talker: TalkerIDII, Code: "VBW"},                                                // Dual Ground/Water Speed
+		"VWVBW":      TypeID{Talker: TalkerIDVW, Code: "VBW"},                                                // Dual Ground/Water Speed
+		"IIVDR":      TypeID{Talker: TalkerIDII, Code: "VDR"},                                                // Set and Drift
+		"IIXTE":      TypeID{Talker: TalkerIDII, Code: "XTE"},                                                // Cross-Track Error, Measured
+		"INXTE":      TypeID{Talker: TalkerIDIN, Code: "XTE"},                                                // Cross-Track Error, Measured
+		"IIRMB":      TypeID{Talker: TalkerIDII, Code: "RMB"},                                                // Recommended Minimum Navigation Information
+		"INRMB":      TypeID{Talker: TalkerIDIN, Code: "RMB"},                                                // Recommended Minimum Navigation Information
+		"LCRMA":      TypeID{Talker: TalkerIDLC, Code: "RMA"},                                                // Recommended Minimum Specific Loran-C Data
+		"IIAPB":      TypeID{Talker: TalkerIDII, Code: "APB"},                                                // Autopilot Sentence B
+		"INAPB":      TypeID{Talker: TalkerIDIN, Code: "APB"},                                                // Autopilot Sentence B
+		"IIAAM":      TypeID{Talker: TalkerIDII, Code: "AAM"},                                                // Waypoint Arrival Alarm
+		"INAAM":      TypeID{Talker: TalkerIDIN, Code: "AAM"},                                                // Waypoint Arrival Alarm
+		"IIBOD":      TypeID{Talker: TalkerIDII, Code: "BOD"},                                                // Bearing Origin to Destination
+		"INBOD":      TypeID{Talker: TalkerIDIN, Code: "BOD"},                                                // Bearing Origin to Destination
+		"IIBWC":      TypeID{Talker: TalkerIDII, Code: "BWC"},                                                // Bearing and Distance to Waypoint, Great Circle
+		"INBWC":      TypeID{Talker: TalkerIDIN, Code: "BWC"},                                                // Bearing and Distance to Waypoint, Great Circle
+		"IIBWR":      TypeID{Talker: TalkerIDII, Code: "BWR"},                                                // Bearing and Distance to Waypoint, Rhumb Line
+		"INBWR":      TypeID{Talker: TalkerIDIN, Code: "BWR"},                                                // Bearing and Distance to Waypoint, Rhumb Line
+		"IIBWW":      TypeID{Talker: TalkerIDII, Code: "BWW"},                                                // Bearing, Waypoint to Waypoint
+		"INBWW":      TypeID{Talker: TalkerIDIN, Code: "BWW"},                                                // Bearing, Waypoint to Waypoint
+		"IIWCV":      TypeID{Talker: TalkerIDII, Code: "WCV"},                                                // Waypoint Closure Velocity
+		"INWCV":      TypeID{Talker: TalkerIDIN, Code: "WCV"},                                                // Waypoint Closure Velocity
+		"IIWNC":      TypeID{Talker: TalkerIDII, Code: "WNC"},                                                // Distance, Waypoint to Waypoint
+		"INWNC":      TypeID{Talker: TalkerIDIN, Code: "WNC"},                                                // Distance, Waypoint to Waypoint
+		"IIWPL":      TypeID{Talker: TalkerIDII, Code: "WPL"},                                                // Waypoint Location
+		"INWPL":      TypeID{Talker: TalkerIDIN, Code: "WPL"},                                                // Waypoint Location
+		"ECWPL":      TypeID{Talker: TalkerIDEC, Code: "WPL"},                                                // Waypoint Location
+		"IIRTE":      TypeID{Talker: TalkerIDII, Code: "RTE"},                                                // Routes
+		"INRTE":      TypeID{Talker: TalkerIDIN, Code: "RTE"},                                                // Routes
+		"ECRTE":      TypeID{Talker: TalkerIDEC, Code: "RTE"},                                                // Routes
+		"LCGLC":      TypeID{Talker: TalkerIDLC, Code: "GLC"},                                                // Geographic Position, Loran-C
+		"GPGTD":      TypeID{Talker: TalkerIDGPS, Code: "GTD"},                                               // Geographic Location in Time Differences
+		"LCGTD":      TypeID{Talker: TalkerIDLC, Code: "GTD"},                                                // Geographic Location in Time Differences
+		"IIXDR":      TypeID{Talker: TalkerIDII, Code: "XDR"},                                                // Transducer Measurements
+		"WIXDR":      TypeID{Talker: TalkerIDWI, Code: "XDR"},                                                // Transducer Measurements
+		"IIMWV":      TypeID{Talker: TalkerIDII, Code: "MWV"},                                                // Wind Speed and Angle
+		"WIMWV":      TypeID{Talker: TalkerIDWI, Code: "MWV"},                                                // Wind Speed and Angle
+		"IIMWD":      TypeID{Talker: TalkerIDII, Code: "MWD"},                                                // Wind Direction and Speed
+		"WIMWD":      TypeID{Talker: TalkerIDWI, Code: "MWD"},                                                // Wind Direction and Speed
+		"GPMDA":      TypeID{Talker: TalkerIDGPS, Code: "MDA"},                                               // Meteorological Composite
+		"IIMDA":      TypeID{Talker: TalkerIDII, Code: "MDA"},                                                // Meteorological Composite
+		"WIMDA":      TypeID{Talker: TalkerIDWI, Code: "MDA"},                                                // Meteorological Composite
+		"IIMTW":      TypeID{Talker: TalkerIDII, Code: "MTW"},                                                // Mean Temperature of Water
+		"IIMTA":      TypeID{Talker: TalkerIDII, Code: "MTA"},                                                // Air Temperature
+		"WIMTA":      TypeID{Talker: TalkerIDWI, Code: "MTA"},                                                // Air Temperature
+		"GPMMB":      TypeID{Talker: TalkerIDGPS, Code: "MMB"},                                               // Barometer
+		"IIMMB":      TypeID{Talker: TalkerIDII, Code: "MMB"},                                                // Barometer
+		"WIMMB":      TypeID{Talker: TalkerIDWI, Code: "MMB"},                                                // Barometer
+		"GPMHU":      TypeID{Talker: TalkerIDGPS, Code: "MHU"},                                               // Humidity
+		"IIMHU":      TypeID{Talker: TalkerIDII, Code: "MHU"},                                                // Humidity
+		"WIMHU":      TypeID{Talker: TalkerIDWI, Code: "MHU"},                                                // Humidity
+		"GPVWR":      TypeID{Talker: TalkerIDGPS, Code: "VWR"},                                               // Relative Wind Speed and Angle
+		"IIVWR":      TypeID{Talker: TalkerIDII, Code: "VWR"},                                                // Relative Wind Speed and Angle
+		"WIVWR":      TypeID{Talker: TalkerIDWI, Code: "VWR"},                                                // Relative Wind Speed and Angle
+		"GPVWT":      TypeID{Talker: TalkerIDGPS, Code: "VWT"},                                               // True Wind Speed and Angle
+		"IIVWT":      TypeID{Talker: TalkerIDII, Code: "VWT"},                                                // True Wind Speed and Angle
+		"WIVWT":      TypeID{Talker: TalkerIDWI, Code: "VWT"},                                                // True Wind Speed and Angle
+		"SDDPT":      TypeID{Talker: TalkerIDSD, Code: "DPT"},                                                // Depth of Water
+		"IIDPT":      TypeID{Talker: TalkerIDII, Code: "DPT"},                                                // Depth of Water
+		"GPDBS":      TypeID{Talker: TalkerIDGPS, Code: "DBS"},                                               // Depth Below Surface
+		"SDDBS":      TypeID{Talker: TalkerIDSD, Code: "DBS"},                                                // Depth Below Surface
+		"IIDBS":      TypeID{Talker: TalkerIDII, Code: "DBS"},                                                // Depth Below Surface
+		"GPDBK":      TypeID{Talker: TalkerIDGPS, Code: "DBK"},                                               // Depth Below Keel
+		"SDDBK":      TypeID{Talker: TalkerIDSD, Code: "DBK"},                                                // Depth Below Keel
+		"IIDBK":      TypeID{Talker: TalkerIDII, Code: "DBK"},                                                // Depth Below Keel
+		"RAOSD":      TypeID{Talker: TalkerIDRA, Code: "OSD"},                                                // Own Ship Data
+		"INOSD":      TypeID{Talker: TalkerIDIN, Code: "OSD"},                                                // Own Ship Data
+		"RARSD":      TypeID{Talker: TalkerIDRA, Code: "RSD"},                                                // Radar System Data
+		"RATTM":      TypeID{Talker: TalkerIDRA, Code: "TTM"},                                                // Tracked Target Message
+		"GPTLL":      TypeID{Talker: TalkerIDGPS, Code: "TLL"},                                               // Target Latitude and Longitude
+		"RATLL":      TypeID{Talker: TalkerIDRA, Code: "TLL"},                                                // Target Latitude and Longitude
+		"GPMSS":      TypeID{Talker: TalkerIDGPS, Code: "MSS"},                                               // MSK Receiver Signal Status
+		"GPZDL":      TypeID{Talker: TalkerIDGPS, Code: "ZDL"},                                               // Time and Distance to Variable Point
+		"CTFSI":      TypeID{Talker: TalkerIDCT, Code: "FSI"},                                                // Frequency Set Information
+		"CTSFI":      TypeID{Talker: TalkerIDCT, Code: "SFI"},                                                // Scanning Frequency Information
+		"INHSC":      TypeID{Talker: TalkerIDIN, Code: "HSC"},                                                // Heading Steering Command
+		"AGHTC":      TypeID{Talker: TalkerIDAG, Code: "HTC"},                                                // Heading/Track Control Command
+		"INHTC":      TypeID{Talker: TalkerIDIN, Code: "HTC"},                                                // Heading/Track Control Command
+		"AGHTD":      TypeID{Talker: TalkerIDAG, Code: "HTD"},                                                // Heading/Track Control Data
+		"GPALR":      TypeID{Talker: TalkerIDGPS, Code: "ALR"},                                               // Set Alarm State
+		"IIALR":      TypeID{Talker: TalkerIDII, Code: "ALR"},                                                // Set Alarm State
+		"GPACK":      TypeID{Talker: TalkerIDGPS, Code: "ACK"},                                               // Acknowledge Alarm
+		"IIACK":      TypeID{Talker: TalkerIDII, Code: "ACK"},                                                // Acknowledge Alarm
+		"GPALF":      TypeID{Talker: TalkerIDGPS, Code: "ALF"},                                               // Alert Sentence
+		"IIALF":      TypeID{Talker: TalkerIDII, Code: "ALF"},                                                // Alert Sentence
+		"GPDSC":      TypeID{Talker: TalkerIDGPS, Code: "DSC"},                                               // Digital Selective Calling Information
+		"CDDSC":      TypeID{Talker: TalkerIDCD, Code: "DSC"},                                                // Digital Selective Calling Information
+		"GPDSE":      TypeID{Talker: TalkerIDGPS, Code: "DSE"},                                               // Expanded Digital Selective Calling
+		"CDDSE":      TypeID{Talker: TalkerIDCD, Code: "DSE"},                                                // Expanded Digital Selective Calling
+		"GPMOB":      TypeID{Talker: TalkerIDGPS, Code: "MOB"},                                               // Man Over Board Notification
+		"IIMOB":      TypeID{Talker: TalkerIDII, Code: "MOB"},                                                // Man Over Board Notification
+		"TRTRF":      TypeID{Talker: TalkerIDTR, Code: "TRF"},                                                // Transit Fix Data
+		"IISTN":      TypeID{Talker: TalkerIDII, Code: "STN"},                                                // Multiple Data ID
+		"GPHBT":      TypeID{Talker: TalkerIDGPS, Code: "HBT"},                                               // Heartbeat Supervision Sentence
+		"IIHBT":      TypeID{Talker: TalkerIDII, Code: "HBT"},                                                // Heartbeat Supervision Sentence
+		"GPNAK":      TypeID{Talker: TalkerIDGPS, Code: "NAK"},                                               // Negative Acknowledgement
+		"IINAK":      TypeID{Talker: TalkerIDII, Code: "NAK"},                                                // Negative Acknowledgement
+		"IIAPA":      TypeID{Talker: TalkerIDII, Code: "APA"},                                                // Autopilot Sentence A
+		"GPRLM":      TypeID{Talker: TalkerIDGPS, Code: "RLM"},                                               // Return Link Message
+		"GARLM":      TypeID{Talker: TalkerIDGA, Code: "RLM"},                                                // Return Link Message
+		"GNRLM":      TypeID{Talker: TalkerIDGN, Code: "RLM"},                                                // Return Link Message
+		"PMTK010":    MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "010"}, // PMTK_SYS_MSG
+		"PMTK011":    MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "011"}, // PMTK_TXT_MSG
+		"PMTK001":    MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "001"}, // PMTK_ACK
+		"PMTK101":    MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "101"}, // PMTK_CMD_HOT_START
+		"PMTK102":    MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "102"}, // PMTK_CMD_WARM_START
+		"PMTK103":    MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "103"}, // PMTK_CMD_COLD_START
+		"PMTK104":    MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "104"}, // PMTK_CMD_FULL_COLD_START
+		"PMTK161":    MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "161"}, // PMTK_CMD_STANDBY_MODE
+		"PMTK183":    MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "183"}, // PMTK_LOCUS_QUERY_STATUS
+		"PMTKLOG":    MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "LOG"}, // PMTK_LOG
+		"PMTK184":    MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "184"}, // PMTK_LOCUS_ERASE_FLASH
+		"PMTK185":    MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "185"}, // PMTK_LOCUS_STOP_LOGGER
+		"PMTK622":    MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "622"}, // PMTK_Q_LOCUS_DATA
+		"PMTK220":    MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "220"}, // PMTK_SET_NMEA_UPDATERATE
+		"PMTK225":    MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "225"}, // PMTK_SET_PERIODIC
+		"PMTK251":    MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "251"}, // PMTK_SET_NMEA_BAUDRATE
+		"PMTK286":    MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "286"}, // PMTK_SET_AIC_ENABLED
+		"PMTK300":    MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "300"}, // PMTK_API_SET_FIX_CTL
+		"PMTK301":    MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "301"}, // PMTK_API_SET_DGPS_MODE
+		"PMTK313":    MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "313"}, // PMTK_API_SET_SBAS_ENABLED
+		"PMTK314":    MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "314"}, // PMTK_API_SET_NMEA_OUTPUT
+		"PMTK386":    MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "386"}, // PMTK_API_SET_STATIC_NAV_THD
+		"PMTK400":    MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "400"}, // PMTK_API_Q_FIX_CTL
+		"PMTK401":    MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "401"}, // PMTK_API_Q_DGPS_MODE
+		"PMTK413":    MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "413"}, // PMTK_API_Q_SBAS_ENABLED
+		"PMTK414":    MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "414"}, // PMTK_API_Q_NMEA_OUTPUT
+		"PMTK605":    MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "605"}, // PMTK_Q_RELEASE
+		"PMTK607":    MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "607"}, // PMTK_Q_EPO_INFO
+		"PMTK500":    MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "500"}, // PMTK_DT_FIX_CTL
+		"PMTK501":    MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "501"}, // PMTK_DT_DGPS_MODE
+		"PMTK513":    MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "513"}, // PMTK_DT_SBAS_ENABLED
+		"PMTK514":    MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "514"}, // PMTK_DT_NMEA_OUTPUT
+		"PMTK705":    MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "705"}, // PMTK_DT_RELEASE
+		"PMTK707":    MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "707"}, // PMTK_DT_EPO_INFO
+		"PMTK869":    MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: "869"}, // PMTK_EASY_ENABLE
+		"PUBX,00":    SubTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "UBX"}, SubType: "00"},     // u-blox Lat/Long Position Data
+		"PUBX,03":    SubTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "UBX"}, SubType: "03"},     // u-blox Satellite Status
+		"PUBX,04":    SubTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "UBX"}, SubType: "04"},     // u-blox Time of Day and Clock Information
+		"PUBX,40":    SubTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "UBX"}, SubType: "40"},     // u-blox Set NMEA message output rate
+		"PUBX,41":    SubTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "UBX"}, SubType: "41"},     // u-blox Set protocols and baud rate
+		"PTNL,GGK":   SubTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "TNL"}, SubType: "GGK"},    // Trimble Time, Position, Position Type and DOP
+		"PSXN,20":    SubTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "SXN"}, SubType: "20"},     // Kongsberg Seatex Quality
+		"PSXN,23":    SubTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "SXN"}, SubType: "23"},     // Kongsberg Seatex Roll, pitch, heading and heave
+		"PFEC,GPatt": SubTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "FEC"}, SubType: "GPatt"},  // Furuno Attitude
+		"PFEC,GPhve": SubTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "FEC"}, SubType: "GPhve"},  // Furuno Heave
+		"PFEC,pidat": SubTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "FEC"}, SubType: "pidat"},  // Furuno Equipment identification
+		"PSRF100":    SrfTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "SRF"}, MessageID: "100"},  // SiRF Set Serial Port
+		"PSRF103":    SrfTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "SRF"}, MessageID: "103"},  // SiRF Query/Rate Control
+		"PSRF104":    SrfTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "SRF"}, MessageID: "104"},  // SiRF LLA Navigation Initialization
+		"PGRME":      TypeID{Talker: TalkerIDProprietary, Code: "GRME"},                                      // Garmin Estimated Position Error
+		"PGRMM":      TypeID{Talker: TalkerIDProprietary, Code: "GRMM"},                                      // Garmin Map Datum
+		"PGRMZ":      TypeID{Talker: TalkerIDProprietary, Code: "GRMZ"},                                      // Garmin Altitude
+		"PASHR":      TypeID{Talker: TalkerIDProprietary, Code: "ASHR"},                                      // Inertial Attitude Data
+		"PRDID":      TypeID{Talker: TalkerIDProprietary, Code: "RDID"},                                      // Pitch, Roll and Heading
+		"PHTRO":      TypeID{Talker: TalkerIDProprietary, Code: "HTRO"},                                      // Pitch and Roll
 	}
 }
 
@@ -201,6 +406,18 @@ const (
 	AutonomousGNSSFix PositioningMode = "A"
 	// DifferentialGNSSFix is a PositioningMode type as string "D"
 	DifferentialGNSSFix PositioningMode = "D"
+	// PreciseGNSSFix is a PositioningMode type as string "P"
+	PreciseGNSSFix PositioningMode = "P"
+	// RTKFixedMode is a PositioningMode type as string "R"
+	RTKFixedMode PositioningMode = "R"
+	// RTKFloatMode is a PositioningMode type as string "F"
+	RTKFloatMode PositioningMode = "F"
+	// EstimatedMode is a PositioningMode type as string "E" (dead reckoning)
+	EstimatedMode PositioningMode = "E"
+	// ManualInputMode is a PositioningMode type as string "M"
+	ManualInputMode PositioningMode = "M"
+	// SimulatorMode is a PositioningMode type as string "S"
+	SimulatorMode PositioningMode = "S"
 )
 
 // PositioningMode type as string
@@ -220,6 +437,18 @@ func (p PositioningMode) String() string {
 		return "Autonomous GNSS fix"
 	case DifferentialGNSSFix:
 		return "Differential GNSS fix"
+	case PreciseGNSSFix:
+		return "Precise GNSS fix"
+	case RTKFixedMode:
+		return "RTK fixed"
+	case RTKFloatMode:
+		return "RTK float"
+	case EstimatedMode:
+		return "Estimated (dead reckoning)"
+	case ManualInputMode:
+		return "Manual input"
+	case SimulatorMode:
+		return "Simulator"
 	default:
 		return "unknow"
 	}
@@ -230,7 +459,7 @@ func (p PositioningMode) String() string {
 func ParsePositioningMode(raw string) (pm PositioningMode, err error) {
 	pm = PositioningMode(raw)
 	switch pm {
-	case NoFixMode, AutonomousGNSSFix, DifferentialGNSSFix:
+	case NoFixMode, AutonomousGNSSFix, DifferentialGNSSFix, PreciseGNSSFix, RTKFixedMode, RTKFloatMode, EstimatedMode, ManualInputMode, SimulatorMode:
 	default:
 		err = fmt.Errorf("unknow value")
 	}
@@ -283,3 +512,81 @@ func ParseSeverity(raw string) (s Severity, err error) {
 	}
 	return
 }
+
+const (
+	// SteerLeft is a SteerDirection type as string "L"
+	SteerLeft SteerDirection = "L"
+	// SteerRight is a SteerDirection type as string "R"
+	SteerRight SteerDirection = "R"
+)
+
+// SteerDirection type as string, direction to steer to correct an error
+type SteerDirection string
+
+// Serialize return SteerDirection as string
+func (s SteerDirection) Serialize() string {
+	return string(s)
+}
+
+// String return SteerDirection as human string
+func (s SteerDirection) String() string {
+	switch s {
+	case SteerLeft:
+		return "left"
+	case SteerRight:
+		return "right"
+	default:
+		return "unknow"
+	}
+}
+
+// ParseSteerDirection check SteerDirection validity, return an error
+// "unknow value" if not
+func ParseSteerDirection(raw string) (s SteerDirection, err error) {
+	s = SteerDirection(raw)
+	switch s {
+	case SteerLeft, SteerRight:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
+
+const (
+	// TrueReference is a NorthReference type as string "T"
+	TrueReference NorthReference = "T"
+	// MagneticReference is a NorthReference type as string "M"
+	MagneticReference NorthReference = "M"
+)
+
+// NorthReference type as string, reference of a bearing or heading
+type NorthReference string
+
+// Serialize return NorthReference as string
+func (r NorthReference) Serialize() string {
+	return string(r)
+}
+
+// String return NorthReference as human string
+func (r NorthReference) String() string {
+	switch r {
+	case TrueReference:
+		return "true"
+	case MagneticReference:
+		return "magnetic"
+	default:
+		return "unknow"
+	}
+}
+
+// ParseNorthReference check NorthReference validity, return an error
+// "unknow value" if not
+func ParseNorthReference(raw string) (r NorthReference, err error) {
+	r = NorthReference(raw)
+	switch r {
+	case TrueReference, MagneticReference:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
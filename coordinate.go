@@ -147,6 +147,25 @@ func (l LatLong) CardinalPoint(isLatitude bool) CardinalPoint {
 	return East
 }
 
+// serializePosition return latitude and longitude data fields followed by
+// their cardinal points (ie: ddmm.mm,N,dddmm.mm,W), minutes have at least
+// decimals digits
+func serializePosition(latitude, longitude LatLong, decimals int) []string {
+	return []string{
+		latitude.formatDM(2, decimals), latitude.hemisphere(true).String(),
+		longitude.formatDM(3, decimals), longitude.hemisphere(false).String(),
+	}
+}
+
+// serializeOptionalPosition do the same as serializePosition but return
+// empty data fields for a position not provided (0, 0)
+func serializeOptionalPosition(latitude, longitude LatLong, decimals int) []string {
+	if latitude == 0 && longitude == 0 {
+		return []string{"", "", "", ""}
+	}
+	return serializePosition(latitude, longitude, decimals)
+}
+
 // DM extract degrees and minutes
 func (l LatLong) DM() (int, float64) {
 	var d, m float64
@@ -179,10 +198,46 @@ func (l LatLong) ToDM() string {
 	if l == 0 {
 		return ""
 	}
-	d, m := l.DM()
+	d, m := LatLong(math.Abs(float64(l))).DM() // Sign is given by cardinal point
 	return strings.Trim(fmt.Sprintf("%02d%09.6f", d, m), "0")
 }
 
+// formatDM return string like ‘dddmm.mmmm’ with degrees padded to width and
+// at least decimals of minutes, up to 6 if significant (ie: high precision sentences)
+func (l LatLong) formatDM(width, decimals int) string {
+	precision := decimals
+	if precision < 6 {
+		precision = 6
+	}
+
+	d, m := LatLong(math.Abs(float64(l))).DM() // Sign is given by cardinal point
+	if m = Round(m, precision); m >= 60 {
+		d, m = d+1, m-60 // Carry rounded minutes, ie: 59.9999999
+	}
+
+	minutes := strconv.FormatFloat(m, 'f', precision, 64)
+	for precision > decimals && strings.HasSuffix(minutes, "0") {
+		minutes, precision = minutes[:len(minutes)-1], precision-1
+	}
+	minutes = strings.TrimSuffix(minutes, ".")
+	if m < 10 {
+		minutes = "0" + minutes // Pad minutes to 2 digits
+	}
+	return fmt.Sprintf("%0*d%s", width, d, minutes)
+}
+
+// hemisphere return the cardinal point related to the kind of coordinate,
+// unlike CardinalPoint it's North or East for 0 (ie: equator)
+func (l LatLong) hemisphere(isLatitude bool) CardinalPoint {
+	if l != 0 {
+		return l.CardinalPoint(isLatitude)
+	}
+	if isLatitude {
+		return North
+	}
+	return East
+}
+
 // PrintDMS return string like: dd° mm' ss.ss" to be human readable
 func (l LatLong) PrintDMS() string {
 	degrees, minutes, secondes := l.DMS()
@@ -0,0 +1,44 @@
+package nmea
+
+import (
+	"fmt"
+	"math"
+)
+
+// Current struct is the water current affecting the vessel
+type Current struct {
+	Set   float64 // Direction the current flows toward in degree (true)
+	Drift float64 // Current speed in knots
+}
+
+// String return Current as human string
+func (c Current) String() string {
+	return fmt.Sprintf("set %.0f°, drift %.1fkn", c.Set, c.Drift)
+}
+
+// ComputeCurrent return the current from the motion through water (heading
+// in degree true and speed through water in knots) and the motion over
+// ground (course and speed over ground)
+func ComputeCurrent(heading, stw, cog, sog float64) Current {
+	// Vectors (east, north): current = ground velocity - water velocity
+	x := sog*math.Sin(toRadians(cog)) - stw*math.Sin(toRadians(heading))
+	y := sog*math.Cos(toRadians(cog)) - stw*math.Cos(toRadians(heading))
+
+	c := Current{Drift: math.Hypot(x, y)}
+	if c.Drift > 0 {
+		c.Set = normalizeDegrees(toDegrees(math.Atan2(x, y)))
+	}
+	return c
+}
+
+// SerializeVDR return a VDR sentence for the current, the magnetic set is
+// computed from the variation (degree, east is positive)
+func (c Current) SerializeVDR(variation float64) string {
+	magnetic := normalizeDegrees(c.Set - variation)
+	set := c.Set
+	return GPVDR{
+		SetTrue:     &set,
+		SetMagnetic: &magnetic,
+		Drift:       c.Drift,
+	}.Serialize()
+}
@@ -0,0 +1,26 @@
+package nmea
+
+import (
+	"math"
+	"testing"
+)
+
+func TestComputeCurrent(t *testing.T) {
+	// Heading north at 5 knots through water while making 5 knots east over
+	// ground: the current flows south-east at 5√2 knots
+	c := ComputeCurrent(0, 5, 90, 5)
+	if Round(c.Drift, 3) != Round(5*math.Sqrt2, 3) || Round(c.Set, 3) != 135 {
+		t.Fatalf("Wrong current (got: %s)", c)
+	}
+
+	// Heading west at 6 knots and slowed down to 4 knots: 2 knots current
+	// flowing east
+	if c = ComputeCurrent(270, 6, 270, 4); Round(c.Drift, 3) != 2 || Round(c.Set, 3) != 90 {
+		t.Fatalf("Wrong current (got: %s)", c)
+	}
+
+	// Same motion through water and over ground: no current
+	if c = ComputeCurrent(45, 5, 45, 5); Round(c.Drift, 3) != 0 || c.Set != 0 {
+		t.Fatalf("Wrong current (got: %s)", c)
+	}
+}
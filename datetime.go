@@ -0,0 +1,147 @@
+package nmea
+
+import "time"
+
+const (
+	// GPSWeekRollover is the period of GPS week number rollover (1024 weeks)
+	GPSWeekRollover = 1024 * 7 * 24 * time.Hour
+
+	// DefaultCenturyPivot is used by parsers to expand two-digit years (ie:
+	// RMC ddmmyy), a year lower than pivot is in 2000s, in 1900s otherwise
+	DefaultCenturyPivot = 80
+
+	// DefaultLeapSeconds is the offset between GPS time and UTC (since
+	// 2017-01-01), used until a receiver provides it
+	DefaultLeapSeconds = 18
+)
+
+// GPSEpoch is the origin of GPS time
+var GPSEpoch = time.Date(1980, time.January, 6, 0, 0, 0, 0, time.UTC)
+
+// ExpandYear return four-digit year from two-digit year according to pivot,
+// a year lower than pivot is in 2000s, in 1900s otherwise
+func ExpandYear(yy, pivot int) int {
+	if yy >= 100 {
+		return yy
+	}
+	if yy < pivot {
+		return 2000 + yy
+	}
+	return 1900 + yy
+}
+
+// IsPlausibleDate check if date is between minimum (ie: the earliest date a
+// receiver could provide) and one day after now
+func IsPlausibleDate(t, minimum, now time.Time) bool {
+	return !t.Before(minimum) && !t.After(now.Add(24*time.Hour))
+}
+
+// FixWeekRollover shift date by GPS week rollover periods until reaching
+// minimum, return true if date has been corrected
+func FixWeekRollover(t, minimum time.Time) (time.Time, bool) {
+	if t.Before(GPSEpoch) {
+		return t, false // Not a GPS date at all
+	}
+
+	corrected := false
+	for t.Before(minimum) {
+		t, corrected = t.Add(GPSWeekRollover), true
+	}
+	return t, corrected
+}
+
+// GPSTime return time from GPS week number (since GPSEpoch, without rollover)
+// and time of week in seconds. GPS time is ahead of UTC by the leap seconds
+func GPSTime(week int, tow float64) time.Time {
+	return GPSEpoch.Add(time.Duration(week)*7*24*time.Hour + time.Duration(Round(tow*1e9, 0)))
+}
+
+// GPSWeek return GPS week number and time of week in seconds of GPS time t
+func GPSWeek(t time.Time) (week int, tow float64) {
+	d := t.Sub(GPSEpoch)
+	week = int(d / (7 * 24 * time.Hour))
+	tow = float64(d%(7*24*time.Hour)) / float64(time.Second)
+	return
+}
+
+// GPSToUTC return UTC time of GPS time t, according to leap seconds
+func GPSToUTC(t time.Time, leapSeconds int) time.Time {
+	return t.Add(-time.Duration(leapSeconds) * time.Second)
+}
+
+// UTCToGPS return GPS time of UTC time t, according to leap seconds
+func UTCToGPS(t time.Time, leapSeconds int) time.Time {
+	return t.Add(time.Duration(leapSeconds) * time.Second)
+}
+
+// DateResolver struct provides dates from a stream, preferring ZDA four-digit
+// years over RMC two-digit years when both are present. It also keeps the
+// leap seconds provided by u-blox PUBX,04 sentences
+type DateResolver struct {
+	// MinimumDate is the earliest plausible date provided by the receiver,
+	// earlier dates are corrected as week rollover artifacts. Zero value
+	// (default) disables the correction, ie: to replay archived logs
+	MinimumDate time.Time
+
+	// CenturyPivot is used to expand two-digit years of RMC and PUBX,04
+	// dates, set to DefaultCenturyPivot constant by NewDateResolver
+	CenturyPivot int
+
+	// DefaultLeapSeconds is used until the receiver provides leap seconds,
+	// set to DefaultLeapSeconds constant by NewDateResolver
+	DefaultLeapSeconds int
+
+	lastZDA     *time.Time
+	leapSeconds *int
+}
+
+// NewDateResolver allocate DateResolver struct
+func NewDateResolver() *DateResolver {
+	return &DateResolver{CenturyPivot: DefaultCenturyPivot, DefaultLeapSeconds: DefaultLeapSeconds}
+}
+
+// Resolve return date and time UTC provided by a RMC, ZDA or PUBX,04 message, false for other messages.
+// RMC year is replaced by the year of the last ZDA sentence when they refer to the same day
+func (r *DateResolver) Resolve(msg NMEA) (time.Time, bool) {
+	t, ok := r.resolve(msg)
+	if ok && !r.MinimumDate.IsZero() {
+		t, _ = FixWeekRollover(t, r.MinimumDate)
+	}
+	return t, ok
+}
+
+func (r *DateResolver) resolve(msg NMEA) (time.Time, bool) {
+	switch m := msg.(type) {
+	case *PUBX04:
+		if !m.LeapSecondsDefault {
+			leap := m.LeapSeconds
+			r.leapSeconds = &leap
+		}
+		return r.expandYear(m.DateTimeUTC), true
+	case *GPZDA:
+		t := m.DateTimeUTC
+		r.lastZDA = &t
+		return t, true
+	case *GPRMC:
+		t := r.expandYear(m.DateTimeUTC)
+		if r.lastZDA != nil && r.lastZDA.Month() == t.Month() && r.lastZDA.Day() == t.Day() && r.lastZDA.Year()%100 == t.Year()%100 {
+			t = t.AddDate(r.lastZDA.Year()-t.Year(), 0, 0)
+		}
+		return t, true
+	}
+	return time.Time{}, false
+}
+
+// expandYear apply the resolver century pivot on the two-digit year of t
+func (r *DateResolver) expandYear(t time.Time) time.Time {
+	return t.AddDate(ExpandYear(t.Year()%100, r.CenturyPivot)-t.Year(), 0, 0)
+}
+
+// LeapSeconds return the leap seconds provided by the receiver, or the
+// resolver default leap seconds and false if not received yet
+func (r DateResolver) LeapSeconds() (int, bool) {
+	if r.leapSeconds == nil {
+		return r.DefaultLeapSeconds, false
+	}
+	return *r.leapSeconds, true
+}
@@ -0,0 +1,110 @@
+package nmea
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDateMerger(t *testing.T) {
+	d := NewDateMerger(nil)
+
+	gga, err := Parse("$GPGGA,000107.799,,,,,0,0,,,M,,M,,*49")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tod := gga.(*GPGGA).TimeUTC
+
+	if _, ok := d.Merge(tod); ok {
+		t.Fatal("Merge shouldn't succeed without date")
+	}
+
+	for _, raw := range []string{
+		"$GPZDA,235959.50,31,12,2003,00,00*62",
+		"$GPRMC,235959.500,A,3150.7238,N,11711.7278,E,0.00,0.00,311203,,,A*6C",
+	} {
+		msg, err := Parse(raw)
+		if err != nil {
+			t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+		}
+		d.Update(msg)
+	}
+
+	// Time of day after midnight belongs to the next day
+	merged, ok := d.Merge(tod)
+	if wanted := time.Date(2004, time.January, 1, 0, 1, 7, 799000000, time.UTC); !ok || !merged.Equal(wanted) {
+		t.Fatalf("Wrong merged time (got: %s, wanted: %s)", merged, wanted)
+	}
+}
+
+func TestFixWeekRollover(t *testing.T) {
+	if y := ExpandYear(3, DefaultCenturyPivot); y != 2003 {
+		t.Fatalf("Wrong expanded year (got: %d)", y)
+	}
+
+	// 1024 weeks before 2019-10-01
+	minimum := time.Date(2019, time.April, 7, 0, 0, 0, 0, time.UTC)
+	rollover := time.Date(2019, time.October, 1, 0, 0, 0, 0, time.UTC).Add(-GPSWeekRollover)
+	if IsPlausibleDate(rollover, minimum, time.Now()) {
+		t.Fatalf("Date shouldn't be plausible (got: %s)", rollover)
+	}
+
+	fixed, ok := FixWeekRollover(rollover, minimum)
+	if !ok || fixed.Year() != 2019 || fixed.Month() != time.October {
+		t.Fatalf("Wrong week rollover fix (got: %s)", fixed)
+	}
+
+	// Correction only applies when the resolver opts in
+	msg, err := Parse("$GPRMC,235959.500,A,3150.7238,N,11711.7278,E,0.00,0.00,311203,,,A*6C")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := NewDateResolver()
+	if archived, _ := r.Resolve(msg); archived.Year() != 2003 {
+		t.Fatalf("Archived date shouldn't be corrected (got: %s)", archived)
+	}
+
+	r.MinimumDate = minimum
+	if corrected, _ := r.Resolve(msg); corrected.Year() != 2023 || corrected.Month() != time.August {
+		t.Fatalf("Wrong week rollover correction (got: %s)", corrected)
+	}
+}
+
+func TestCenturyPivot(t *testing.T) {
+	msg, err := Parse("$GPRMC,235959.500,A,3150.7238,N,11711.7278,E,0.00,0.00,311203,,,A*6C")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Resolver pivot overrides the default one used by parser
+	r := NewDateResolver()
+	r.CenturyPivot = 3
+	if resolved, _ := r.Resolve(msg); resolved.Year() != 1903 {
+		t.Fatalf("Wrong expanded year (got: %s)", resolved)
+	}
+
+	// Date merger uses the resolver provided by caller
+	d := NewDateMerger(r)
+	d.Update(msg)
+	if merged, ok := d.Merge(TimeOfDay{Hour: 12}); !ok || merged.Year() != 1903 {
+		t.Fatalf("Wrong merged year (got: %s)", merged)
+	}
+}
+
+func TestTimeToGo(t *testing.T) {
+	msg, err := Parse("$GPZTG,145832.12,422359.17,WPT*26")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ztg := msg.(*GPZTG)
+
+	// Hours of time to go are not limited to a day
+	if wanted := 42*time.Hour + 23*time.Minute + 59170*time.Millisecond; ztg.TimeToGo != wanted {
+		t.Fatalf("Wrong time to go (got: %s, wanted: %s)", ztg.TimeToGo, wanted)
+	}
+
+	eta := ztg.ETA(time.Date(2004, time.March, 11, 0, 0, 0, 0, time.UTC))
+	if wanted := time.Date(2004, time.March, 13, 9, 22, 31, 290000000, time.UTC); !eta.Equal(wanted) {
+		t.Fatalf("Wrong ETA (got: %s, wanted: %s)", eta, wanted)
+	}
+}
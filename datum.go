@@ -0,0 +1,51 @@
+package nmea
+
+// DatumTracker struct keeps the datum of positions provided by the stream,
+// from the most recent DTM or Garmin PGRMM sentence
+type DatumTracker struct {
+	datum string // Empty if no datum received yet
+	wgs84 bool
+	dtm   *GPDTM // Offsets to reference datum, nil if not provided by a DTM sentence
+}
+
+// NewDatumTracker allocate DatumTracker struct
+func NewDatumTracker() *DatumTracker {
+	return &DatumTracker{wgs84: true}
+}
+
+// Update feed DatumTracker with a message, other messages than DTM and PGRMM are ignored
+func (d *DatumTracker) Update(msg NMEA) {
+	switch m := msg.(type) {
+	case *GPDTM:
+		d.datum, d.wgs84, d.dtm = m.LocalDatum, m.IsWGS84(), m
+	case *PGRMM:
+		d.datum, d.wgs84, d.dtm = m.Datum, m.IsWGS84(), nil
+	}
+}
+
+// Datum return the datum of positions, empty if not received yet
+func (d DatumTracker) Datum() string {
+	return d.datum
+}
+
+// IsWGS84 return true if positions are in WGS 84 datum, it is assumed
+// until a datum is received
+func (d DatumTracker) IsWGS84() bool {
+	return d.wgs84
+}
+
+// Convert return fix with position in WGS 84 datum, shifted according to
+// DTM offsets if needed. Return false if the position can't be converted
+// (ie: Garmin unit configured to another datum), it should be flagged
+func (d DatumTracker) Convert(f Fix) (Fix, bool) {
+	if d.wgs84 {
+		return f, true
+	}
+
+	if d.dtm == nil || d.dtm.ReferenceDatum != DatumWGS84 {
+		return f, false
+	}
+
+	f.Position = d.dtm.Shift(f.Position)
+	return f, true
+}
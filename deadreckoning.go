@@ -0,0 +1,88 @@
+package nmea
+
+import (
+	"fmt"
+	"time"
+)
+
+// KnotsToMetersPerSecond is the conversion factor from knots to m/s
+const KnotsToMetersPerSecond = 1852.0 / 3600
+
+// DeadReckoning struct extrapolates position from the last valid fix when
+// fixes stop arriving, using speed over ground and course (or heading and
+// rate of turn when provided)
+type DeadReckoning struct {
+	Timeout        time.Duration // Delay without valid fix before switching to DR
+	HeadingTimeout time.Duration // Maximum age of heading to be used instead of COG
+	GrowthRate     float64       // Uncertainty growth in meters per second of DR
+	BaseError      float64       // Uncertainty in meters of the last valid fix
+	heading        *float64      // Optional heading in degree (true), used instead of COG
+	rateOfTurn     float64       // Optional rate of turn in degree per minute (negative = port)
+	headingTime    time.Time     // Receipt time of the heading
+	lastFix        *Fix
+	lastFixTime    time.Time // Receipt time of the last valid fix
+}
+
+// Estimate struct is a position provided by DeadReckoning
+type Estimate struct {
+	Position    Position
+	Time        time.Time // Time of the estimate
+	IsDR        bool      // True if position is extrapolated and not a real fix
+	Uncertainty float64   // Estimated position error in meters
+}
+
+// String return Estimate as human string
+func (e Estimate) String() string {
+	kind := "fix"
+	if e.IsDR {
+		kind = "DR"
+	}
+	return fmt.Sprintf("%s %s, %s (±%.0fm)", kind, e.Position.Latitude.PrintDMS(), e.Position.Longitude.PrintDMS(), e.Uncertainty)
+}
+
+// NewDeadReckoning allocate DeadReckoning struct switching to DR after timeout,
+// heading older than 10 seconds is ignored
+func NewDeadReckoning(timeout time.Duration) *DeadReckoning {
+	return &DeadReckoning{Timeout: timeout, HeadingTimeout: 10 * time.Second, GrowthRate: 1, BaseError: 10}
+}
+
+// Update feed DeadReckoning with a fix received at time now, invalid fixes are ignored
+func (d *DeadReckoning) Update(f Fix, now time.Time) {
+	if !f.IsValid {
+		return
+	}
+	d.lastFix, d.lastFixTime = &f, now
+}
+
+// SetHeading set heading in degree (true) and rate of turn in degree per minute
+// received at time now, to be used instead of COG while extrapolating
+func (d *DeadReckoning) SetHeading(heading, rateOfTurn float64, now time.Time) {
+	d.heading, d.rateOfTurn, d.headingTime = &heading, rateOfTurn, now
+}
+
+// Estimate return position at time now: the last fix while it's recent enough,
+// an extrapolated position flagged as DR otherwise. Return false if no fix has been received yet
+func (d DeadReckoning) Estimate(now time.Time) (Estimate, bool) {
+	if d.lastFix == nil {
+		return Estimate{}, false
+	}
+
+	elapsed := now.Sub(d.lastFixTime)
+	if elapsed <= d.Timeout {
+		return Estimate{Position: d.lastFix.Position, Time: now, Uncertainty: d.BaseError}, true
+	}
+
+	course := d.lastFix.COG
+	if d.heading != nil && now.Sub(d.headingTime) <= d.HeadingTimeout {
+		// Mean course over the elapsed time when turning at constant rate
+		course = normalizeDegrees(*d.heading + d.rateOfTurn*elapsed.Minutes()/2)
+	}
+
+	distance := d.lastFix.Speed * KnotsToMetersPerSecond * elapsed.Seconds()
+	return Estimate{
+		Position:    d.lastFix.Position.Destination(course, distance),
+		Time:        now,
+		IsDR:        true,
+		Uncertainty: d.BaseError + d.GrowthRate*elapsed.Seconds(),
+	}, true
+}
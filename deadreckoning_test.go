@@ -0,0 +1,45 @@
+package nmea
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestDeadReckoning(t *testing.T) {
+	start := time.Date(2020, time.June, 1, 12, 0, 0, 0, time.UTC)
+	origin := Position{Latitude: 47.5, Longitude: -3.0}
+
+	d := NewDeadReckoning(10 * time.Second)
+	if _, ok := d.Estimate(start); ok {
+		t.Fatal("Estimate shouldn't succeed without fix")
+	}
+
+	// Heading east at 10 knots
+	d.Update(Fix{Position: origin, Speed: 10, COG: 90, IsValid: Valid}, start)
+	if e, ok := d.Estimate(start.Add(5 * time.Second)); !ok || e.IsDR || e.Position != origin {
+		t.Fatalf("Wrong estimate before timeout (got: %s)", e)
+	}
+
+	// One minute later: 308.7m east
+	now := start.Add(time.Minute)
+	e, ok := d.Estimate(now)
+	if !ok || !e.IsDR || e.Uncertainty != 70 {
+		t.Fatalf("Wrong DR estimate (got: %s)", e)
+	}
+	if dist, bearing := origin.DistanceTo(e.Position), origin.BearingTo(e.Position); math.Abs(dist-308.7) > 0.5 || math.Abs(bearing-90) > 0.1 {
+		t.Fatalf("Wrong DR position (got: %.1fm, %.1f°)", dist, bearing)
+	}
+
+	// Stale heading is ignored
+	d.SetHeading(0, 0, start)
+	if e, _ = d.Estimate(now); math.Abs(origin.BearingTo(e.Position)-90) > 0.1 {
+		t.Fatalf("Stale heading shouldn't be used (got: %s)", e)
+	}
+
+	// Recent heading is used instead of COG
+	d.SetHeading(0, 0, now.Add(-time.Second))
+	if e, _ = d.Estimate(now); math.Abs(origin.BearingTo(e.Position)) > 0.1 || math.Abs(origin.DistanceTo(e.Position)-308.7) > 0.5 {
+		t.Fatalf("Wrong DR position with heading (got: %s)", e)
+	}
+}
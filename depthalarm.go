@@ -0,0 +1,116 @@
+package nmea
+
+import "fmt"
+
+const (
+	// DepthOK is a DepthState as 0
+	DepthOK DepthState = iota
+	// DepthShallow is a DepthState as 1
+	DepthShallow
+	// DepthDeep is a DepthState as 2
+	DepthDeep
+)
+
+// DepthState type as int
+type DepthState int
+
+// String return DepthState as human string
+func (s DepthState) String() string {
+	switch s {
+	case DepthOK:
+		return "depth ok"
+	case DepthShallow:
+		return "shallow water"
+	case DepthDeep:
+		return "deep water"
+	default:
+		return "unknow"
+	}
+}
+
+// DepthAlarm struct monitors depth from echo-sounder sentences against
+// shallow and deep thresholds
+type DepthAlarm struct {
+	Shallow  float64   // Shallow threshold in Unit, 0 to disable
+	Deep     float64   // Deep threshold in Unit, 0 to disable
+	Unit     DepthUnit // Unit of thresholds and raised events
+	Debounce int       // Number of consecutive readings required to change state
+
+	state   DepthState
+	pending DepthState
+	count   int
+}
+
+// DepthEvent struct is the event raised by DepthAlarm on state change
+type DepthEvent struct {
+	State DepthState
+	Depth float64   // Depth which triggered the event
+	Unit  DepthUnit // Unit of Depth
+}
+
+// String return DepthEvent as human string
+func (e DepthEvent) String() string {
+	return fmt.Sprintf("%s (depth: %.1f %s)", e.State, e.Depth, e.Unit)
+}
+
+// NewDepthAlarm allocate DepthAlarm struct with thresholds expressed in unit,
+// a threshold set to 0 is disabled
+func NewDepthAlarm(shallow, deep float64, unit DepthUnit) (*DepthAlarm, error) {
+	if _, err := ParseDepthUnit(string(unit)); err != nil {
+		return nil, fmt.Errorf("Invalid depth unit (got: %s)", unit)
+	}
+	if shallow < 0 || deep < 0 || (deep > 0 && deep <= shallow) {
+		return nil, fmt.Errorf("Invalid depth thresholds (got shallow: %f, deep: %f)", shallow, deep)
+	}
+	return &DepthAlarm{Shallow: shallow, Deep: deep, Unit: unit, Debounce: 1}, nil
+}
+
+// State return the current DepthState
+func (a DepthAlarm) State() DepthState {
+	return a.state
+}
+
+// Update feed DepthAlarm with an echo-sounder message, other messages and
+// messages without depth are ignored.
+// Return an event when the depth state changes, nil otherwise
+func (a *DepthAlarm) Update(msg NMEA) *DepthEvent {
+	switch m := msg.(type) {
+	case *GPDBT:
+		if depth, ok := m.Depth(); ok {
+			return a.UpdateDepth(depth, Meters)
+		}
+	case *GPDPT:
+		return a.UpdateDepth(m.Depth, Meters)
+	}
+	return nil
+}
+
+// UpdateDepth feed DepthAlarm with a depth expressed in unit.
+// Return an event when the depth state changes, nil otherwise
+func (a *DepthAlarm) UpdateDepth(depth float64, unit DepthUnit) *DepthEvent {
+	depth = a.Unit.FromMeters(unit.ToMeters(depth))
+
+	state := DepthOK
+	switch {
+	case a.Shallow > 0 && depth < a.Shallow:
+		state = DepthShallow
+	case a.Deep > 0 && depth > a.Deep:
+		state = DepthDeep
+	}
+
+	if state == a.state {
+		a.count = 0
+		return nil
+	}
+
+	if state != a.pending {
+		a.pending, a.count = state, 0
+	}
+
+	if a.count++; a.count < a.Debounce {
+		return nil
+	}
+
+	a.state, a.count = state, 0
+	return &DepthEvent{State: state, Depth: depth, Unit: a.Unit}
+}
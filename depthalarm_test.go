@@ -0,0 +1,103 @@
+package nmea
+
+import "testing"
+
+func TestDepthAlarm(t *testing.T) {
+	a, err := NewDepthAlarm(3, 100, Meters)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.Debounce = 2
+
+	msg, err := Parse("$GPDBT,108.34,f,33.02,M,18.06,F*35")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e := a.Update(msg); e != nil {
+		t.Fatalf("Unexpected event: %s", e)
+	}
+
+	// 6 feet is under the shallow threshold, debounced on 2 readings
+	if e := a.UpdateDepth(6, Feet); e != nil {
+		t.Fatalf("Event should be debounced: %s", e)
+	}
+	if e := a.UpdateDepth(6, Feet); e == nil || e.State != DepthShallow {
+		t.Fatal("Expected shallow water event")
+	}
+	if e := a.UpdateDepth(1, Meters); e != nil {
+		t.Fatalf("Unexpected event without state change: %s", e)
+	}
+}
+
+func TestDPTDepthReferences(t *testing.T) {
+	msg, err := Parse("$SDDPT,12.6,-1.2*4C")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := msg.(*GPDPT)
+	if d, ok := m.DepthBelowKeel(); !ok || Round(d, 1) != 11.4 {
+		t.Fatalf("Wrong depth below keel (got: %f)", d)
+	}
+	if _, ok := m.DepthBelowSurface(); ok {
+		t.Fatal("Depth below surface shouldn't be available with a keel offset")
+	}
+}
+
+func TestDepthOptionalFields(t *testing.T) {
+	nmeas := map[string]float64{
+		"$IIDBS,,f,23.8,M,,F*2F":   23.8,
+		"$IIDBK,,f,9.7,M,,F*00":    9.7,
+		"$IIDBK,,f,,M,5.3,F*08":    9.69,
+		"$INDBT,,,000033.0,M,,*06": 33,
+		"$INDBT,,f,33.0,M,,F*26":   33,
+		"$SDDBT,36.1,f,,M,,F*32":   11,
+	}
+
+	for raw, depth := range nmeas {
+		msg, err := Parse(raw)
+		if err != nil {
+			t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+		}
+
+		var meters float64
+		var ok bool
+		switch m := msg.(type) {
+		case *GPDBT:
+			meters, ok = m.Depth()
+		case *GPDBS:
+			meters, ok = m.Depth()
+		case *GPDBK:
+			meters, ok = m.Depth()
+		}
+		if !ok || Round(meters, 2) != depth {
+			t.Fatalf("Wrong depth for \"%s\" (got: %f)", raw, meters)
+		}
+	}
+
+	a, err := NewDepthAlarm(3, 0, Meters)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Feet only sounder doesn't raise shallow water alarm, neither sentence without depth
+	for _, raw := range []string{"$SDDBT,36.1,f,,M,,F*32", "$SDDBT,,f,,M,,F*28"} {
+		msg, err := Parse(raw)
+		if err != nil {
+			t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+		}
+		if e := a.Update(msg); e != nil {
+			t.Fatalf("Unexpected event for \"%s\": %s", raw, e)
+		}
+
+		// Absent depths are kept empty
+		if msg.Serialize() != raw {
+			t.Fatalf("Unable to serialize \"%s\" (got: \"%s\")", raw, msg.Serialize())
+		}
+	}
+	// Message crafted from scratch provides depth not set to 0
+	dbt := GPDBT{DepthInMeters: 11.02}
+	if d, ok := dbt.Depth(); !ok || d != 11.02 || dbt.Serialize() != "$GPDBT,,f,11.02,M,,F*04" {
+		t.Fatalf("Wrong crafted DBT (got: %s, %f)", dbt.Serialize(), d)
+	}
+}
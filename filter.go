@@ -0,0 +1,146 @@
+package nmea
+
+import (
+	"fmt"
+	"math"
+)
+
+// FixFilter is an interface for each kind of filter stage smoothing fixes
+type FixFilter interface {
+	Filter(f Fix) Fix
+	Reset()
+}
+
+// EMAFilter struct smooths position and speed with an exponential moving average
+type EMAFilter struct {
+	Alpha float64 // Smoothing factor (0 ~ 1), 1 means no smoothing
+
+	last *Fix
+}
+
+// NewEMAFilter allocate EMAFilter struct with smoothing factor alpha (0 < alpha <= 1)
+func NewEMAFilter(alpha float64) (*EMAFilter, error) {
+	if alpha <= 0 || alpha > 1 {
+		return nil, fmt.Errorf("Invalid smoothing factor, should be in range ]0, 1] (got: %f)", alpha)
+	}
+	return &EMAFilter{Alpha: alpha}, nil
+}
+
+// Filter return smoothed fix, invalid fixes are returned as is
+func (e *EMAFilter) Filter(f Fix) Fix {
+	if !f.IsValid {
+		return f
+	}
+
+	if e.last == nil {
+		e.last = &f
+		return f
+	}
+
+	smooth := func(prev, cur float64) float64 {
+		return prev + e.Alpha*(cur-prev)
+	}
+
+	out := f
+	out.Position.Latitude = LatLong(smooth(float64(e.last.Position.Latitude), float64(f.Position.Latitude)))
+	out.Position.Longitude = interpolateLongitude(e.last.Position.Longitude, f.Position.Longitude, e.Alpha)
+	out.Speed = smooth(e.last.Speed, f.Speed)
+	e.last = &out
+	return out
+}
+
+// Reset forget filter history
+func (e *EMAFilter) Reset() {
+	e.last = nil
+}
+
+// KalmanFilter struct smooths position and speed with a constant position
+// Kalman filter whose uncertainty grows with elapsed time
+type KalmanFilter struct {
+	ProcessNoise     float64 // Expected position change in meters per second
+	MeasurementNoise float64 // Receiver position accuracy in meters
+	SpeedNoise       float64 // Receiver speed accuracy in knots
+
+	state         *Fix
+	variance      float64 // Position variance in meters²
+	speedVariance float64 // Speed variance in knots²
+}
+
+// NewKalmanFilter allocate KalmanFilter struct with process and measurement noise in meters
+func NewKalmanFilter(processNoise, measurementNoise float64) (*KalmanFilter, error) {
+	if processNoise <= 0 || measurementNoise <= 0 {
+		return nil, fmt.Errorf("Invalid noise, should be positive (got process: %f, measurement: %f)", processNoise, measurementNoise)
+	}
+	return &KalmanFilter{ProcessNoise: processNoise, MeasurementNoise: measurementNoise, SpeedNoise: 0.5}, nil
+}
+
+// Filter return smoothed fix, invalid fixes are returned as is
+func (k *KalmanFilter) Filter(f Fix) Fix {
+	if !f.IsValid {
+		return f
+	}
+
+	r := k.MeasurementNoise * k.MeasurementNoise
+	rs := k.SpeedNoise * k.SpeedNoise
+
+	// First or out of order fix: restart from measurement
+	if k.state == nil || f.Time.Before(k.state.Time) {
+		k.state, k.variance, k.speedVariance = &f, r, rs
+		return f
+	}
+
+	// Predict: uncertainty grows with elapsed time
+	if dt := f.Time.Sub(k.state.Time).Seconds(); dt > 0 {
+		k.variance += dt * k.ProcessNoise * k.ProcessNoise
+		k.speedVariance += dt * k.ProcessNoise * k.ProcessNoise / (KnotsToMetersPerSecond * KnotsToMetersPerSecond)
+	}
+
+	// Update with measurement
+	gain := k.variance / (k.variance + r)
+	speedGain := k.speedVariance / (k.speedVariance + rs)
+
+	out := f
+	out.Position.Latitude = k.state.Position.Latitude + LatLong(gain)*(f.Position.Latitude-k.state.Position.Latitude)
+	out.Position.Longitude = interpolateLongitude(k.state.Position.Longitude, f.Position.Longitude, gain)
+	out.Speed = k.state.Speed + speedGain*(f.Speed-k.state.Speed)
+	k.variance *= 1 - gain
+	k.speedVariance *= 1 - speedGain
+	k.state = &out
+	return out
+}
+
+// Accuracy return estimated position accuracy in meters
+func (k KalmanFilter) Accuracy() float64 {
+	return math.Sqrt(k.variance)
+}
+
+// Reset forget filter history
+func (k *KalmanFilter) Reset() {
+	k.state, k.variance, k.speedVariance = nil, 0, 0
+}
+
+// interpolateLongitude return longitude moved from prev toward cur by factor (0 ~ 1),
+// the shortest way round (ie: across the antimeridian)
+func interpolateLongitude(prev, cur LatLong, factor float64) LatLong {
+	lon := float64(prev) + factor*angleDiff(float64(prev), float64(cur))
+	return LatLong(normalizeDegrees(lon+180) - 180)
+}
+
+// FilteredFix struct is a raw fix along with its filtered value
+type FilteredFix struct {
+	Raw      Fix
+	Filtered Fix
+}
+
+// FilterStream read raw fixes from in and return a channel with raw and
+// filtered fixes, closed when in is closed
+func FilterStream(in <-chan Fix, filter FixFilter) <-chan FilteredFix {
+	out := make(chan FilteredFix)
+	go func() {
+		defer close(out)
+		for f := range in {
+			out <- FilteredFix{Raw: f, Filtered: filter.Filter(f)}
+		}
+	}()
+	return out
+}
@@ -0,0 +1,73 @@
+package nmea
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestEMAFilter(t *testing.T) {
+	if _, err := NewEMAFilter(0); err == nil {
+		t.Fatal("Expected error for invalid smoothing factor")
+	}
+
+	e, err := NewEMAFilter(0.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	e.Filter(Fix{Position: Position{Latitude: 10, Longitude: 179.9}, Speed: 4, IsValid: Valid})
+	f := e.Filter(Fix{Position: Position{Latitude: 10.2, Longitude: -179.7}, Speed: 6, IsValid: Valid})
+	if Round(float64(f.Position.Latitude), 6) != 10.1 || Round(float64(f.Position.Longitude), 6) != -179.9 || f.Speed != 5 {
+		t.Fatalf("Wrong smoothed fix across antimeridian (got: %+v)", f.Position)
+	}
+
+	// Invalid fixes are returned as is
+	if f = e.Filter(Fix{Position: Position{Latitude: 50}}); f.Position.Latitude != 50 {
+		t.Fatalf("Invalid fix shouldn't be filtered (got: %+v)", f.Position)
+	}
+}
+
+func TestKalmanFilter(t *testing.T) {
+	k, err := NewKalmanFilter(1, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Date(2020, time.June, 1, 12, 0, 0, 0, time.UTC)
+	k.Filter(Fix{Time: start, Position: Position{Latitude: -20, Longitude: -179.999}, IsValid: Valid})
+	f := k.Filter(Fix{Time: start.Add(time.Second), Position: Position{Latitude: -20, Longitude: 179.999}, IsValid: Valid})
+
+	// Smoothed position stays close to the antimeridian
+	if lon := float64(f.Position.Longitude); math.Abs(angleDiff(lon, 180)) > 0.001 {
+		t.Fatalf("Wrong smoothed longitude across antimeridian (got: %f)", lon)
+	}
+	if k.Accuracy() >= 10 {
+		t.Fatalf("Accuracy should improve with measurements (got: %f)", k.Accuracy())
+	}
+
+	// Out of order fix restarts from measurement
+	back := Fix{Time: start.Add(-time.Minute), Position: Position{Latitude: -19.9, Longitude: 179.9}, IsValid: Valid}
+	if f = k.Filter(back); f.Position != back.Position {
+		t.Fatalf("Out of order fix should restart filter (got: %+v)", f.Position)
+	}
+	if k.Accuracy() != 10 {
+		t.Fatalf("Accuracy should restart from measurement noise (got: %f)", k.Accuracy())
+	}
+}
+
+func TestFilterStream(t *testing.T) {
+	e, _ := NewEMAFilter(0.5)
+	in := make(chan Fix, 2)
+	in <- Fix{Speed: 4, IsValid: Valid}
+	in <- Fix{Speed: 6, IsValid: Valid}
+	close(in)
+
+	var last FilteredFix
+	for f := range FilterStream(in, e) {
+		last = f
+	}
+	if last.Raw.Speed != 6 || last.Filtered.Speed != 5 {
+		t.Fatalf("Wrong filtered stream (got: %+v)", last)
+	}
+}
@@ -0,0 +1,66 @@
+package nmea
+
+import "time"
+
+// Fix struct is a position report extracted from a NMEA message
+type Fix struct {
+	Time     time.Time // Time UTC, date is missing for GGA, GNS, GLL and PUBX,00 sentences
+	Position Position
+	Speed    float64 // Speed over ground in knots, 0 if not provided
+	COG      float64 // Course over ground in degree, 0 if not provided
+	IsValid  DataValid
+}
+
+// NewFix extract Fix from a parsed NMEA message (GPRMC, GPGGA, GPGNS, GPGLL, GPTRF, PUBX00 or PTNLGGK),
+// return false if the message doesn't provide a position
+func NewFix(msg NMEA) (Fix, bool) {
+	switch m := msg.(type) {
+	case *GPRMC:
+		return Fix{
+			Time:     m.DateTimeUTC,
+			Position: Position{Latitude: m.Latitude, Longitude: m.Longitude},
+			Speed:    m.Speed,
+			COG:      m.COG,
+			IsValid:  m.IsValid,
+		}, true
+	case *GPGGA:
+		return Fix{
+			Time:     m.TimeUTC.On(time.Time{}),
+			Position: Position{Latitude: m.Latitude, Longitude: m.Longitude},
+			IsValid:  m.QualityIndicator != InvalidIndicator && m.QualityIndicator != Estimated,
+		}, true
+	case *GPGNS:
+		return Fix{
+			Time:     m.TimeUTC.On(time.Time{}),
+			Position: Position{Latitude: m.Latitude, Longitude: m.Longitude},
+			IsValid:  DataValid(m.IsValid()),
+		}, true
+	case *GPGLL:
+		return Fix{
+			Time:     m.TimeUTC.On(time.Time{}),
+			Position: Position{Latitude: m.Latitude, Longitude: m.Longitude},
+			IsValid:  m.IsValid,
+		}, true
+	case *GPTRF:
+		return Fix{
+			Time:     m.DateTimeUTC,
+			Position: m.Position,
+			IsValid:  m.IsValid,
+		}, true
+	case *PUBX00:
+		return Fix{
+			Time:     m.TimeUTC.On(time.Time{}),
+			Position: Position{Latitude: m.Latitude, Longitude: m.Longitude},
+			Speed:    KilometersPerHour.ToKnots(m.SOG),
+			COG:      m.COG,
+			IsValid:  DataValid(m.IsValid()),
+		}, true
+	case *PTNLGGK:
+		return Fix{
+			Time:     m.DateTimeUTC,
+			Position: Position{Latitude: m.Latitude, Longitude: m.Longitude},
+			IsValid:  DataValid(m.IsValid()),
+		}, true
+	}
+	return Fix{}, false
+}
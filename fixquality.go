@@ -0,0 +1,248 @@
+package nmea
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+const (
+	// HDOPExceeded event kind as 0
+	HDOPExceeded FixQualityEventKind = iota
+	// HDOPRecovered event kind as 1
+	HDOPRecovered
+	// SatellitesLow event kind as 2
+	SatellitesLow
+	// SatellitesRecovered event kind as 3
+	SatellitesRecovered
+	// QualityDegraded event kind as 4
+	QualityDegraded
+	// QualityImproved event kind as 5
+	QualityImproved
+	// FixLost event kind as 6
+	FixLost
+	// FixRecovered event kind as 7
+	FixRecovered
+)
+
+// FixQualityEventKind type as int
+type FixQualityEventKind int
+
+// String return FixQualityEventKind as human string
+func (k FixQualityEventKind) String() string {
+	switch k {
+	case HDOPExceeded:
+		return "HDOP exceeded"
+	case HDOPRecovered:
+		return "HDOP recovered"
+	case SatellitesLow:
+		return "satellites low"
+	case SatellitesRecovered:
+		return "satellites recovered"
+	case QualityDegraded:
+		return "fix quality degraded"
+	case QualityImproved:
+		return "fix quality improved"
+	case FixLost:
+		return "fix lost"
+	case FixRecovered:
+		return "fix recovered"
+	default:
+		return "unknow"
+	}
+}
+
+// FixQualityEvent struct is the event raised by FixQualityMonitor
+type FixQualityEvent struct {
+	Kind   FixQualityEventKind
+	Time   time.Time // Local time of the event
+	Detail string
+}
+
+// String return FixQualityEvent as human string
+func (e FixQualityEvent) String() string {
+	return fmt.Sprintf("%s (%s)", e.Kind, e.Detail)
+}
+
+// FixAccuracy struct is the latest accuracy of position fix received, as
+// DOPs and estimated errors, nil if not provided by the stream
+type FixAccuracy struct {
+	PDOP            *float64
+	HDOP            *float64
+	VDOP            *float64
+	HorizontalError *float64 // Estimated horizontal position error in meters (PGRME, GST)
+	VerticalError   *float64 // Estimated vertical position error in meters (PGRME, GST)
+	PositionError   *float64 // Estimated position error in meters (PGRME)
+}
+
+// FixQualityMonitor struct is a watchdog raising events when fix quality
+// goes out of configured thresholds
+type FixQualityMonitor struct {
+	MaxHDOP       float64       // HDOP threshold, 0 to disable
+	MinSatellites int           // Minimum number of satellites used, 0 to disable
+	Timeout       time.Duration // Maximum delay without valid position, 0 to disable
+
+	started    time.Time // Time of the first call, start of timeout without any valid position
+	lastValid  time.Time
+	hdopHigh   bool
+	satsLow    bool
+	fixLost    bool
+	quality    QualityIndicator
+	hasQuality bool
+	accuracy   FixAccuracy
+}
+
+// NewFixQualityMonitor allocate FixQualityMonitor struct
+func NewFixQualityMonitor(maxHDOP float64, minSatellites int, timeout time.Duration) *FixQualityMonitor {
+	return &FixQualityMonitor{MaxHDOP: maxHDOP, MinSatellites: minSatellites, Timeout: timeout}
+}
+
+// Update feed FixQualityMonitor with a message received at time now,
+// return raised events (GGA, GSA, RMC and GLL sentences are used, GST and
+// PGRME sentences only update accuracy)
+func (q *FixQualityMonitor) Update(msg NMEA, now time.Time) []FixQualityEvent {
+	events := make([]FixQualityEvent, 0)
+
+	switch m := msg.(type) {
+	case *GPGGA:
+		events = append(events, q.checkHDOP(m.HDOP, now)...)
+		events = append(events, q.checkSatellites(int(m.NbOfSatellitesUsed), now)...)
+		events = append(events, q.checkQuality(m.QualityIndicator, now)...)
+		if m.HDOP > 0 {
+			hdop := m.HDOP
+			q.accuracy.HDOP = &hdop
+		}
+	case *GPGSA:
+		events = append(events, q.checkHDOP(m.HDOP, now)...)
+		if m.PDOP > 0 {
+			pdop := m.PDOP
+			q.accuracy.PDOP = &pdop
+		}
+		if m.HDOP > 0 {
+			hdop := m.HDOP
+			q.accuracy.HDOP = &hdop
+		}
+		if m.VDOP > 0 {
+			vdop := m.VDOP
+			q.accuracy.VDOP = &vdop
+		}
+	case *GPGST:
+		if m.LatitudeError != nil && m.LongitudeError != nil {
+			horizontal := math.Hypot(*m.LatitudeError, *m.LongitudeError)
+			q.accuracy.HorizontalError = &horizontal
+		}
+		if m.AltitudeError != nil {
+			vertical := *m.AltitudeError
+			q.accuracy.VerticalError = &vertical
+		}
+	case *PGRME:
+		hpe, vpe, epe := m.HorizontalError, m.VerticalError, m.PositionError
+		q.accuracy.HorizontalError, q.accuracy.VerticalError, q.accuracy.PositionError = &hpe, &vpe, &epe
+	}
+
+	if f, ok := NewFix(msg); ok && f.IsValid == Valid && !isEstimated(msg) {
+		q.lastValid = now
+		if q.fixLost {
+			q.fixLost = false
+			events = append(events, FixQualityEvent{Kind: FixRecovered, Time: now, Detail: "valid position received"})
+		}
+	}
+
+	return append(events, q.Check(now)...)
+}
+
+// Accuracy return the latest accuracy of position fix received
+func (q FixQualityMonitor) Accuracy() FixAccuracy {
+	return q.accuracy
+}
+
+// Check raise FixLost event if no valid position arrived since Timeout (or
+// since the first call if none arrived yet), it should be called periodically
+// when the stream could stop. Estimated positions (dead reckoning) are not valid
+func (q *FixQualityMonitor) Check(now time.Time) []FixQualityEvent {
+	if q.started.IsZero() {
+		q.started = now
+	}
+
+	if q.Timeout <= 0 || q.fixLost {
+		return nil
+	}
+
+	since := q.lastValid
+	if since.IsZero() {
+		since = q.started
+	}
+
+	if elapsed := now.Sub(since); elapsed > q.Timeout {
+		q.fixLost = true
+		return []FixQualityEvent{{Kind: FixLost, Time: now, Detail: fmt.Sprintf("no valid position since %s", elapsed)}}
+	}
+	return nil
+}
+
+// isEstimated return true if the position of message is estimated (dead reckoning)
+func isEstimated(msg NMEA) bool {
+	switch m := msg.(type) {
+	case *GPGGA:
+		return m.QualityIndicator == Estimated
+	case *GPRMC:
+		return m.PositioningMode == EstimatedMode
+	case *GPGLL:
+		return m.PositioningMode == EstimatedMode
+	case *GPGNS:
+		for _, mode := range m.Modes {
+			if mode != EstimatedMode && mode != NoFixMode {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func (q *FixQualityMonitor) checkHDOP(hdop float64, now time.Time) []FixQualityEvent {
+	if q.MaxHDOP <= 0 || hdop <= 0 {
+		return nil
+	}
+
+	if high := hdop > q.MaxHDOP; high != q.hdopHigh {
+		q.hdopHigh = high
+		kind := HDOPRecovered
+		if high {
+			kind = HDOPExceeded
+		}
+		return []FixQualityEvent{{Kind: kind, Time: now, Detail: fmt.Sprintf("HDOP: %.1f, threshold: %.1f", hdop, q.MaxHDOP)}}
+	}
+	return nil
+}
+
+func (q *FixQualityMonitor) checkSatellites(nb int, now time.Time) []FixQualityEvent {
+	if q.MinSatellites <= 0 {
+		return nil
+	}
+
+	if low := nb < q.MinSatellites; low != q.satsLow {
+		q.satsLow = low
+		kind := SatellitesRecovered
+		if low {
+			kind = SatellitesLow
+		}
+		return []FixQualityEvent{{Kind: kind, Time: now, Detail: fmt.Sprintf("satellites: %d, threshold: %d", nb, q.MinSatellites)}}
+	}
+	return nil
+}
+
+func (q *FixQualityMonitor) checkQuality(qi QualityIndicator, now time.Time) []FixQualityEvent {
+	previous, known := q.quality, q.hasQuality
+	q.quality, q.hasQuality = qi, true
+
+	if !known || qi.Rank() == previous.Rank() {
+		return nil
+	}
+
+	kind := QualityImproved
+	if qi.Rank() < previous.Rank() {
+		kind = QualityDegraded
+	}
+	return []FixQualityEvent{{Kind: kind, Time: now, Detail: fmt.Sprintf("%s -> %s", previous, qi)}}
+}
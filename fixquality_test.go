@@ -0,0 +1,65 @@
+package nmea
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFixQualityTimeout(t *testing.T) {
+	start := time.Date(2020, time.June, 1, 12, 0, 0, 0, time.UTC)
+	parse := func(raw string) NMEA {
+		msg, err := Parse(raw)
+		if err != nil {
+			t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+		}
+		return msg
+	}
+	valid := parse("$GPGGA,015540.000,3150.68378,N,11711.93139,E,1,17,0.6,0051.6,M,0.0,M,,*58")
+	invalid := parse("$GPGGA,000107.799,,,,,0,0,,,M,,M,,*49")
+	estimated := parse("$GPGGA,015548.000,3150.68378,N,11711.93139,E,6,17,0.6,0051.6,M,0.0,M,,*57")
+
+	// Receiver never gets a fix
+	q := NewFixQualityMonitor(0, 0, 10*time.Second)
+	if events := q.Update(invalid, start); len(events) != 0 {
+		t.Fatalf("Unexpected events (got: %v)", events)
+	}
+	if events := q.Check(start.Add(11 * time.Second)); len(events) != 1 || events[0].Kind != FixLost {
+		t.Fatalf("Expected fix lost without any fix (got: %v)", events)
+	}
+
+	// Fix then silence
+	q = NewFixQualityMonitor(0, 0, 10*time.Second)
+	q.Update(valid, start)
+	if events := q.Check(start.Add(5 * time.Second)); len(events) != 0 {
+		t.Fatalf("Unexpected events (got: %v)", events)
+	}
+	if events := q.Check(start.Add(11 * time.Second)); len(events) != 1 || events[0].Kind != FixLost {
+		t.Fatalf("Expected fix lost after silence (got: %v)", events)
+	}
+	if events := q.Update(valid, start.Add(12*time.Second)); len(events) != 1 || events[0].Kind != FixRecovered {
+		t.Fatalf("Expected fix recovered (got: %v)", events)
+	}
+
+	// Estimated positions (dead reckoning) don't count as valid fix
+	q = NewFixQualityMonitor(0, 0, 10*time.Second)
+	q.Update(valid, start)
+	q.Update(estimated, start.Add(8*time.Second))
+	if events := q.Check(start.Add(11 * time.Second)); len(events) != 1 || events[0].Kind != FixLost {
+		t.Fatalf("Expected fix lost with estimated positions (got: %v)", events)
+	}
+}
+
+func TestFixQualityNoFixDOP(t *testing.T) {
+	q := NewFixQualityMonitor(0, 0, 0)
+	now := time.Now()
+
+	// DOP data fields are empty without fix and shouldn't be reported as perfect
+	msg, err := Parse("$GPGSA,A,1,,,,,,,,,,,,,,,*1E")
+	if err != nil {
+		t.Fatal(err)
+	}
+	q.Update(msg, now)
+	if a := q.Accuracy(); a.PDOP != nil || a.HDOP != nil || a.VDOP != nil {
+		t.Fatalf("DOP should be unknown (got: %+v)", a)
+	}
+}
@@ -0,0 +1,35 @@
+package nmea
+
+import "testing"
+
+func TestFurunoSubTypes(t *testing.T) {
+	msg, err := Parse("$PFEC,GPatt,315.6,-02.4,+03.1*4B")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a, ok := NewAttitude(msg)
+	if !ok || *a.Heading != 315.6 || a.Pitch != -2.4 || a.Roll != 3.1 {
+		t.Fatalf("Wrong Furuno attitude (got: %+v)", a)
+	}
+
+	msg, err = Parse("$PFEC,GPhve,-0.125,A*14")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hve := msg.(*PFECGPhve); hve.Heave != -0.125 || !bool(hve.IsValid) {
+		t.Fatalf("Wrong Furuno heave (got: %+v)", hve)
+	}
+
+	msg, err = Parse("$PFEC,pidat,0,SC-50*5C")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id := msg.(*PFECPidat); id.Kind != FECModelName || id.Value != "SC-50" {
+		t.Fatalf("Wrong Furuno identification (got: %+v)", id)
+	}
+
+	if _, err := Parse("$PFEC,GPxxx,1*4E"); err == nil {
+		t.Fatal("Expected error for unknown PFEC sub-type")
+	}
+}
@@ -0,0 +1,89 @@
+package nmea
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestGarminPositionError(t *testing.T) {
+	q := NewFixQualityMonitor(0, 0, 0)
+	now := time.Now()
+
+	if a := q.Accuracy(); a.HDOP != nil || a.HorizontalError != nil {
+		t.Fatalf("Accuracy should be unknown (got: %+v)", a)
+	}
+
+	for _, raw := range []string{
+		"$GPGSA,A,3,14,06,16,31,23,,,,,,,,1.66,1.42,0.84*0F",
+		"$PGRME,3.3,M,4.9,M,6.0,M*25",
+	} {
+		msg, err := Parse(raw)
+		if err != nil {
+			t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+		}
+		q.Update(msg, now)
+	}
+
+	a := q.Accuracy()
+	if a.PDOP == nil || *a.PDOP != 1.66 || *a.HDOP != 1.42 || *a.VDOP != 0.84 {
+		t.Fatalf("Wrong DOPs (got: %+v)", a)
+	}
+	if a.HorizontalError == nil || *a.HorizontalError != 3.3 || *a.VerticalError != 4.9 || *a.PositionError != 6 {
+		t.Fatalf("Wrong estimated errors (got: %+v)", a)
+	}
+}
+
+func TestGarminAltitude(t *testing.T) {
+	msg, err := Parse("$PGRMZ,246,f,3*1B")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	alt := msg.(*PGRMZ)
+	if alt.FixDimension != 3 || math.Abs(alt.AltitudeMeters()-74.9808) > 1e-6 {
+		t.Fatalf("Wrong Garmin altitude (got: %+v, %fm)", alt, alt.AltitudeMeters())
+	}
+
+	if raw := (PGRMZ{Altitude: 93, FixDimension: 2}).Serialize(); raw != "$PGRMZ,93,f,2*20" {
+		t.Fatalf("Wrong generated PGRMZ (got: %s)", raw)
+	}
+
+	// Altitude in fathoms is rejected
+	if _, err := Parse("$PGRMZ,1000,F,3*0A"); err == nil {
+		t.Fatal("Altitude in fathoms should be rejected")
+	}
+}
+
+func TestGarminMapDatum(t *testing.T) {
+	d := NewDatumTracker()
+	f := Fix{Position: Position{Latitude: 45.5, Longitude: -73.5}}
+
+	if converted, ok := d.Convert(f); !ok || converted.Position != f.Position || !d.IsWGS84() {
+		t.Fatal("Position should be assumed in WGS 84")
+	}
+
+	for raw, wgs84 := range map[string]bool{"$PGRMM,WGS 84*06": true, "$PGRMM,NAD27 Canada*2F": false} {
+		msg, err := Parse(raw)
+		if err != nil {
+			t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+		}
+		if msg.(*PGRMM).IsWGS84() != wgs84 {
+			t.Fatalf("Wrong WGS 84 detection of \"%s\"", raw)
+		}
+	}
+
+	msg, _ := Parse("$PGRMM,NAD27 Canada*2F")
+	d.Update(msg)
+	if _, ok := d.Convert(f); ok || d.Datum() != "NAD27 Canada" {
+		t.Fatal("Position in NAD27 shouldn't be converted")
+	}
+
+	// Offsets provided by DTM are applied
+	msg, _ = Parse("$GPDTM,999,,0.08,N,0.07,E,-47.7,W84*1B")
+	d.Update(msg)
+	converted, ok := d.Convert(f)
+	if !ok || math.Abs(float64(converted.Position.Latitude)-45.501333) > 1e-6 {
+		t.Fatalf("Wrong converted position (got: %+v)", converted.Position)
+	}
+}
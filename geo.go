@@ -0,0 +1,115 @@
+package nmea
+
+import "math"
+
+// EarthRadius is the mean radius of the earth in meters (WGS-84)
+const EarthRadius float64 = 6371008.8
+
+// Position struct with latitude and longitude in decimal format
+type Position struct {
+	Latitude  LatLong `json:"latitude"`
+	Longitude LatLong `json:"longitude"`
+}
+
+// DistanceTo return the great-circle distance in meters to another position
+// (haversine formula)
+func (p Position) DistanceTo(o Position) float64 {
+	lat1, lat2 := toRadians(float64(p.Latitude)), toRadians(float64(o.Latitude))
+	dLat := lat2 - lat1
+	dLon := toRadians(float64(o.Longitude - p.Longitude))
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * EarthRadius * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+}
+
+// BearingTo return the initial great-circle bearing in degree (0 ~ 360)
+// from true north to another position
+func (p Position) BearingTo(o Position) float64 {
+	lat1, lat2 := toRadians(float64(p.Latitude)), toRadians(float64(o.Latitude))
+	dLon := toRadians(float64(o.Longitude - p.Longitude))
+
+	y := math.Sin(dLon) * math.Cos(lat2)
+	x := math.Cos(lat1)*math.Sin(lat2) - math.Sin(lat1)*math.Cos(lat2)*math.Cos(dLon)
+	return normalizeDegrees(toDegrees(math.Atan2(y, x)))
+}
+
+func toRadians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+func toDegrees(rad float64) float64 {
+	return rad * 180 / math.Pi
+}
+
+// normalizeDegrees return angle in range [0, 360)
+func normalizeDegrees(deg float64) float64 {
+	deg = math.Mod(deg, 360)
+	if deg < 0 {
+		deg += 360
+	}
+	return deg
+}
+
+// Destination return the position reached from p after travelling distance
+// in meters along the given initial bearing in degree
+func (p Position) Destination(bearing, distance float64) Position {
+	lat1, lon1 := toRadians(float64(p.Latitude)), toRadians(float64(p.Longitude))
+	brg := toRadians(bearing)
+	d := distance / EarthRadius
+
+	lat2 := math.Asin(math.Sin(lat1)*math.Cos(d) + math.Cos(lat1)*math.Sin(d)*math.Cos(brg))
+	lon2 := lon1 + math.Atan2(math.Sin(brg)*math.Sin(d)*math.Cos(lat1), math.Cos(d)-math.Sin(lat1)*math.Sin(lat2))
+
+	return Position{
+		Latitude:  LatLong(toDegrees(lat2)),
+		Longitude: LatLong(normalizeDegrees(toDegrees(lon2)+180) - 180),
+	}
+}
+
+// angleDiff return the signed shortest difference b-a in degree (-180 ~ 180)
+func angleDiff(a, b float64) float64 {
+	d := math.Mod(b-a, 360)
+	switch {
+	case d > 180:
+		d -= 360
+	case d <= -180:
+		d += 360
+	}
+	return d
+}
+
+// CrossTrackDistance return the signed distance in meters from p to the great
+// circle track going from origin to destination, positive when p is on the
+// right of the track
+func (p Position) CrossTrackDistance(origin, destination Position) float64 {
+	d := origin.DistanceTo(p) / EarthRadius
+	diff := toRadians(angleDiff(origin.BearingTo(destination), origin.BearingTo(p)))
+	return math.Asin(math.Sin(d)*math.Sin(diff)) * EarthRadius
+}
+
+// RhumbDistanceTo return distance in meters between p and o along a rhumb
+// line (constant bearing)
+func (p Position) RhumbDistanceTo(o Position) float64 {
+	lat1, lat2 := toRadians(float64(p.Latitude)), toRadians(float64(o.Latitude))
+	dLat := lat2 - lat1
+	dLon := toRadians(angleDiff(float64(p.Longitude), float64(o.Longitude)))
+
+	// Ratio of latitude difference to stretched latitude difference, E-W lines give 0/0
+	q := math.Cos(lat1)
+	if dPsi := math.Log(math.Tan(math.Pi/4+lat2/2) / math.Tan(math.Pi/4+lat1/2)); math.Abs(dPsi) > 1e-12 {
+		q = dLat / dPsi
+	}
+
+	return math.Sqrt(dLat*dLat+q*q*dLon*dLon) * EarthRadius
+}
+
+// RhumbBearingTo return the constant bearing in degree (0 ~ 360) to follow
+// from p to o along a rhumb line
+func (p Position) RhumbBearingTo(o Position) float64 {
+	lat1, lat2 := toRadians(float64(p.Latitude)), toRadians(float64(o.Latitude))
+	dLon := toRadians(angleDiff(float64(p.Longitude), float64(o.Longitude)))
+	dPsi := math.Log(math.Tan(math.Pi/4+lat2/2) / math.Tan(math.Pi/4+lat1/2))
+
+	return normalizeDegrees(toDegrees(math.Atan2(dLon, dPsi)))
+}
@@ -0,0 +1,98 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+AAM Waypoint Arrival Alarm
+       1 2 3   4 5    6
+       | | |   | |    |
+$--AAM,A,A,x.x,N,c--c*hh
+
+1) Status, A = Arrival circle entered, V = Not entered
+2) Status, A = Perpendicular passed at waypoint, V = Not passed
+3) Arrival circle radius
+4) Units of radius, N = Nautical miles
+5) Waypoint ID
+6) Checksum
+
+Examples:
+$GPAAM,A,A,0.10,N,WPTNME*32
+$GPAAM,V,V,0.50,N,EGLM*20
+*/
+
+// NewGPAAM allocate GPAAM struct for AAM sentence (Waypoint arrival alarm),
+// also used for other talkers (ie: IIAAM, INAAM)
+func NewGPAAM(m Message) *GPAAM {
+	return &GPAAM{Message: m}
+}
+
+// GPAAM struct
+type GPAAM struct {
+	Message
+
+	IsArrived             DataValid // Arrival circle entered
+	IsPerpendicularPassed DataValid // Perpendicular passed at waypoint
+	ArrivalRadius         float64   // Arrival circle radius in nautical miles
+	WaypointID            string
+}
+
+// AAM return the arrival alarm sentence matching the autopilot sentence,
+// the arrival circle radius is in nautical miles
+func (m GPAPB) AAM(arrivalRadius float64) GPAAM {
+	return GPAAM{
+		IsArrived:             m.IsArrived,
+		IsPerpendicularPassed: m.IsPerpendicularPassed,
+		ArrivalRadius:         arrivalRadius,
+		WaypointID:            m.DestinationID,
+	}
+}
+
+// IsAlarm return true when the waypoint is reached, ie: arrival circle
+// entered or perpendicular passed
+func (m GPAAM) IsAlarm() bool {
+	return m.IsArrived == Valid || m.IsPerpendicularPassed == Valid
+}
+
+func (m *GPAAM) parse() (err error) {
+	if len(m.Fields) != 5 {
+		return m.Error(fmt.Errorf("Incomplete GPAAM message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 5))
+	}
+
+	// Validate fixed field
+	if m.Fields[3] != "N" {
+		return m.Error(fmt.Errorf("Invalid fixed field at %d (got: %s, wanted: %s)", 4, m.Fields[3], "N"))
+	}
+
+	m.IsArrived = (m.Fields[0] == "A")
+	m.IsPerpendicularPassed = (m.Fields[1] == "A")
+
+	if len(m.Fields[2]) > 0 {
+		if m.ArrivalRadius, err = strconv.ParseFloat(m.Fields[2], 64); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse arrival circle radius from data field (got: %s)", m.Fields[2]))
+		}
+	}
+
+	m.WaypointID = m.Fields[4]
+
+	return nil
+}
+
+// Serialize return a valid sentence AAM as string
+func (m GPAAM) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPAAM")
+	fields := make([]string, 0)
+	fields = append(fields,
+		m.IsArrived.Serialize(),
+		m.IsPerpendicularPassed.Serialize(),
+		fmt.Sprintf("%.2f", m.ArrivalRadius),
+		"N",
+		m.WaypointID)
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -0,0 +1,63 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+ACK Acknowledge Alarm
+       1
+       |
+$--ACK,xxx*hh
+
+1) Local alarm number (identifier), as provided by ALR sentence
+2) Checksum
+
+Examples:
+$IIACK,031*57
+$GPACK,005*47
+*/
+
+// NewGPACK allocate GPACK struct for ACK sentence (Acknowledge alarm),
+// also used for other talkers (ie: IIACK)
+func NewGPACK(m Message) *GPACK {
+	return &GPACK{Message: m}
+}
+
+// GPACK struct
+type GPACK struct {
+	Message
+
+	AlarmID int
+}
+
+// ACK return the sentence acknowledging the alarm
+func (m GPALR) ACK() GPACK {
+	return GPACK{AlarmID: m.AlarmID}
+}
+
+func (m *GPACK) parse() (err error) {
+	if len(m.Fields) != 1 {
+		return m.Error(fmt.Errorf("Incomplete GPACK message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 1))
+	}
+
+	if m.AlarmID, err = strconv.Atoi(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse alarm number from data field (got: %s)", m.Fields[0]))
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence ACK as string
+func (m GPACK) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPACK")
+	fields := make([]string, 0)
+	fields = append(fields, fmt.Sprintf("%03d", m.AlarmID))
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -0,0 +1,390 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+ALF Alert Sentence (NMEA 4.1)
+       1 2 3 4         5 6 7 8   9      10     11 12 13
+       | | | |         | | | |   |      |      |  |  |
+$--ALF,x,x,x,hhmmss.ss,a,a,a,aaa,xxxxxx,xxxxxx,xx,x,c--c*hh
+
+1) Total number of ALF sentences for this message, 1 to 2
+2) Sentence number, 1 to 2
+3) Sequential message identifier, 0 to 9, null for a single sentence message
+4) Time of last change, UTC
+5) Alert category:
+	A = alert category A (requires immediate attention)
+	B = alert category B (no direct or immediate action)
+	C = alert category C (can't be acknowledged on the bridge)
+6) Alert priority:
+	E = emergency alarm
+	A = alarm
+	W = warning
+	C = caution
+7) Alert state:
+	V = active, unacknowledged
+	S = active, silenced
+	A = active, acknowledged
+	O = active, responsibility transferred
+	U = rectified, unacknowledged
+	N = normal state
+8) Manufacturer mnemonic code, null for standardized alerts
+9) Alert identifier
+10) Alert instance, 1 to 999999, null for a single instance
+11) Revision counter, 1 to 99
+12) Escalation counter, 0 to 9
+13) Alert title in first sentence, alert description in second sentence
+14) Checksum
+
+Fields 4 to 12 are null in the second sentence
+
+Examples:
+$IIALF,1,1,0,124304.50,A,W,A,,192,1,1,0,LOST TARGET*14
+$IIALF,2,1,3,100110.00,B,A,V,,3008,1,2,0,HEADING LOST*79
+$IIALF,2,2,3,,,,,,,,,,NO HDG REFERENCE*55
+*/
+
+// NewGPALF allocate GPALF struct for ALF sentence (Alert sentence),
+// also used for other talkers (ie: IIALF)
+func NewGPALF(m Message) *GPALF {
+	return &GPALF{Message: m}
+}
+
+// GPALF struct
+type GPALF struct {
+	Message
+
+	NbOfMessages      int
+	MessageNumber     int
+	SequentialID      *int       // Sequential message identifier, nil for a single sentence message
+	TimeUTC           *TimeOfDay // Time of last change, nil if not provided
+	Category          AlertCategory
+	Priority          AlertPriority
+	State             AlertState
+	Manufacturer      string // Manufacturer mnemonic code, empty for standardized alerts
+	AlertID           int
+	AlertInstance     *int // Alert instance, nil for a single instance
+	RevisionCounter   int
+	EscalationCounter int
+	Text              string // Alert title in first sentence, alert description in second one
+}
+
+func (m *GPALF) parse() (err error) {
+	if len(m.Fields) != 13 {
+		return m.Error(fmt.Errorf("Incomplete GPALF message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 13))
+	}
+
+	if m.NbOfMessages, err = strconv.Atoi(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse total number of messages from data field (got: %s)", m.Fields[0]))
+	}
+
+	if m.MessageNumber, err = strconv.Atoi(m.Fields[1]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse message number from data field (got: %s)", m.Fields[1]))
+	}
+
+	if len(m.Fields[2]) > 0 {
+		id, err := strconv.Atoi(m.Fields[2])
+		if err != nil {
+			return m.Error(fmt.Errorf("Unable to parse sequential message identifier from data field (got: %s)", m.Fields[2]))
+		}
+		m.SequentialID = &id
+	}
+
+	m.Text = m.Fields[12]
+
+	// Alert fields are only provided by the first sentence
+	if m.MessageNumber > 1 {
+		return nil
+	}
+
+	if len(m.Fields[3]) > 0 {
+		t, err := ParseTimeOfDay(m.Fields[3])
+		if err != nil {
+			return m.Error(fmt.Errorf("Unable to parse time UTC from data field (got: %s)", m.Fields[3]))
+		}
+		m.TimeUTC = &t
+	}
+
+	if m.Category, err = ParseAlertCategory(m.Fields[4]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse alert category from data field (got: %s)", m.Fields[4]))
+	}
+
+	if m.Priority, err = ParseAlertPriority(m.Fields[5]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse alert priority from data field (got: %s)", m.Fields[5]))
+	}
+
+	if m.State, err = ParseAlertState(m.Fields[6]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse alert state from data field (got: %s)", m.Fields[6]))
+	}
+
+	m.Manufacturer = m.Fields[7]
+
+	if m.AlertID, err = strconv.Atoi(m.Fields[8]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse alert identifier from data field (got: %s)", m.Fields[8]))
+	}
+
+	if len(m.Fields[9]) > 0 {
+		instance, err := strconv.Atoi(m.Fields[9])
+		if err != nil {
+			return m.Error(fmt.Errorf("Unable to parse alert instance from data field (got: %s)", m.Fields[9]))
+		}
+		m.AlertInstance = &instance
+	}
+
+	if m.RevisionCounter, err = strconv.Atoi(m.Fields[10]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse revision counter from data field (got: %s)", m.Fields[10]))
+	}
+
+	if m.EscalationCounter, err = strconv.Atoi(m.Fields[11]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse escalation counter from data field (got: %s)", m.Fields[11]))
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence ALF as string
+func (m GPALF) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPALF")
+	fields := make([]string, 0)
+	fields = append(fields,
+		strconv.Itoa(m.NbOfMessages),
+		strconv.Itoa(m.MessageNumber))
+
+	if m.SequentialID != nil {
+		fields = append(fields, strconv.Itoa(*m.SequentialID))
+	} else {
+		fields = append(fields, "")
+	}
+
+	if m.MessageNumber > 1 {
+		fields = append(fields, "", "", "", "", "", "", "", "", "")
+	} else {
+		if m.TimeUTC != nil {
+			fields = append(fields, m.TimeUTC.Serialize())
+		} else {
+			fields = append(fields, "")
+		}
+
+		fields = append(fields,
+			m.Category.Serialize(),
+			m.Priority.Serialize(),
+			m.State.Serialize(),
+			m.Manufacturer,
+			strconv.Itoa(m.AlertID))
+
+		if m.AlertInstance != nil {
+			fields = append(fields, strconv.Itoa(*m.AlertInstance))
+		} else {
+			fields = append(fields, "")
+		}
+
+		fields = append(fields,
+			strconv.Itoa(m.RevisionCounter),
+			strconv.Itoa(m.EscalationCounter))
+	}
+
+	fields = append(fields, m.Text)
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+// Alert struct is an alert assembled from one or two ALF sentences
+type Alert struct {
+	GPALF              // First sentence of the alert, Text is the alert title
+	Description string // Alert description from second sentence, empty if not provided
+}
+
+// ALFAssembler struct assembles groups of ALF sentences by talker and
+// sequential message identifier
+type ALFAssembler struct {
+	pending map[string]*Alert
+}
+
+// NewALFAssembler allocate ALFAssembler struct
+func NewALFAssembler() *ALFAssembler {
+	return &ALFAssembler{pending: make(map[string]*Alert)}
+}
+
+// Add feed ALFAssembler with a message, other messages than ALF are ignored.
+// Return the alert once all the sentences of a group have been received
+func (a *ALFAssembler) Add(msg NMEA) (*Alert, error) {
+	m, ok := msg.(*GPALF)
+	if !ok {
+		return nil, nil
+	}
+
+	key := m.Type.GetTypeID().Talker.Serialize()
+	if m.SequentialID != nil {
+		key += strconv.Itoa(*m.SequentialID)
+	}
+
+	if m.MessageNumber == 1 {
+		a.pending[key] = &Alert{GPALF: *m}
+	} else if a.pending[key] == nil || m.MessageNumber != 2 {
+		delete(a.pending, key)
+		return nil, m.Error(fmt.Errorf("Out of sequence ALF message (got: %d/%d)", m.MessageNumber, m.NbOfMessages))
+	} else {
+		a.pending[key].Description = m.Text
+	}
+
+	if m.MessageNumber < m.NbOfMessages {
+		return nil, nil
+	}
+
+	alert := a.pending[key]
+	delete(a.pending, key)
+	return alert, nil
+}
+
+const (
+	// AlertCategoryA is a AlertCategory type as string "A" (requires immediate attention)
+	AlertCategoryA AlertCategory = "A"
+	// AlertCategoryB is a AlertCategory type as string "B" (no direct or immediate action)
+	AlertCategoryB AlertCategory = "B"
+	// AlertCategoryC is a AlertCategory type as string "C" (can't be acknowledged on the bridge)
+	AlertCategoryC AlertCategory = "C"
+)
+
+// AlertCategory type as string
+type AlertCategory string
+
+// Serialize return AlertCategory as string
+func (c AlertCategory) Serialize() string {
+	return string(c)
+}
+
+// String return AlertCategory as human string
+func (c AlertCategory) String() string {
+	switch c {
+	case AlertCategoryA:
+		return "category A"
+	case AlertCategoryB:
+		return "category B"
+	case AlertCategoryC:
+		return "category C"
+	default:
+		return "unknow"
+	}
+}
+
+// ParseAlertCategory check AlertCategory validity, return an error
+// "unknow value" if not
+func ParseAlertCategory(raw string) (c AlertCategory, err error) {
+	c = AlertCategory(raw)
+	switch c {
+	case AlertCategoryA, AlertCategoryB, AlertCategoryC:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
+
+const (
+	// EmergencyAlarmPriority is a AlertPriority type as string "E"
+	EmergencyAlarmPriority AlertPriority = "E"
+	// AlarmPriority is a AlertPriority type as string "A"
+	AlarmPriority AlertPriority = "A"
+	// WarningPriority is a AlertPriority type as string "W"
+	WarningPriority AlertPriority = "W"
+	// CautionPriority is a AlertPriority type as string "C"
+	CautionPriority AlertPriority = "C"
+)
+
+// AlertPriority type as string
+type AlertPriority string
+
+// Serialize return AlertPriority as string
+func (p AlertPriority) Serialize() string {
+	return string(p)
+}
+
+// String return AlertPriority as human string
+func (p AlertPriority) String() string {
+	switch p {
+	case EmergencyAlarmPriority:
+		return "emergency alarm"
+	case AlarmPriority:
+		return "alarm"
+	case WarningPriority:
+		return "warning"
+	case CautionPriority:
+		return "caution"
+	default:
+		return "unknow"
+	}
+}
+
+// ParseAlertPriority check AlertPriority validity, return an error
+// "unknow value" if not
+func ParseAlertPriority(raw string) (p AlertPriority, err error) {
+	p = AlertPriority(raw)
+	switch p {
+	case EmergencyAlarmPriority, AlarmPriority, WarningPriority, CautionPriority:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
+
+const (
+	// ActiveUnacknowledgedAlert is a AlertState type as string "V"
+	ActiveUnacknowledgedAlert AlertState = "V"
+	// ActiveSilencedAlert is a AlertState type as string "S"
+	ActiveSilencedAlert AlertState = "S"
+	// ActiveAcknowledgedAlert is a AlertState type as string "A"
+	ActiveAcknowledgedAlert AlertState = "A"
+	// ActiveTransferredAlert is a AlertState type as string "O" (responsibility transferred)
+	ActiveTransferredAlert AlertState = "O"
+	// RectifiedUnacknowledgedAlert is a AlertState type as string "U"
+	RectifiedUnacknowledgedAlert AlertState = "U"
+	// NormalAlert is a AlertState type as string "N"
+	NormalAlert AlertState = "N"
+)
+
+// AlertState type as string
+type AlertState string
+
+// Serialize return AlertState as string
+func (s AlertState) Serialize() string {
+	return string(s)
+}
+
+// String return AlertState as human string
+func (s AlertState) String() string {
+	switch s {
+	case ActiveUnacknowledgedAlert:
+		return "active, unacknowledged"
+	case ActiveSilencedAlert:
+		return "active, silenced"
+	case ActiveAcknowledgedAlert:
+		return "active, acknowledged"
+	case ActiveTransferredAlert:
+		return "active, responsibility transferred"
+	case RectifiedUnacknowledgedAlert:
+		return "rectified, unacknowledged"
+	case NormalAlert:
+		return "normal"
+	default:
+		return "unknow"
+	}
+}
+
+// ParseAlertState check AlertState validity, return an error
+// "unknow value" if not
+func ParseAlertState(raw string) (s AlertState, err error) {
+	s = AlertState(raw)
+	switch s {
+	case ActiveUnacknowledgedAlert, ActiveSilencedAlert, ActiveAcknowledgedAlert,
+		ActiveTransferredAlert, RectifiedUnacknowledgedAlert, NormalAlert:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
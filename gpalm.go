@@ -0,0 +1,161 @@
+package nmea
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+/*
+ALM GPS Almanac Data
+       1 2 3  4    5  6    7  8    9    10     11     12     13     14  15  16
+       | | |  |    |  |    |  |    |    |      |      |      |      |   |   |
+$--ALM,x,x,xx,xxxx,hh,hhhh,hh,hhhh,hhhh,hhhhhh,hhhhhh,hhhhhh,hhhhhh,hhh,hhh*hh
+
+1) Total number of messages
+2) Message number
+3) Satellite PRN number, 01 to 32
+4) GPS week number
+5) SV health, bits 17-24 of each almanac page
+6) Eccentricity, e
+7) Almanac reference time, toa
+8) Inclination angle offset, δi
+9) Rate of right ascension, Ω dot
+10) Root of semi-major axis, √A
+11) Argument of perigee, ω
+12) Longitude of ascension node, Ω0
+13) Mean anomaly, M0
+14) Clock parameter af0
+15) Clock parameter af1
+16) Checksum
+
+Fields 5 to 15 are hexadecimal, scaled as defined by ICD-GPS-200
+
+Examples:
+$GPALM,1,1,15,1159,00,441d,4e,16be,fd5e,a10c9f,4a2da4,686e81,58cbe1,0a4,001*77
+$GPALM,31,1,01,1159,00,4c1a,4e,0a3a,fd2d,a10d4e,2ec9f8,a49c4d,5d9ace,ffb,000*40
+*/
+
+// NewGPALM allocate GPALM struct for ALM sentence (GPS almanac data)
+func NewGPALM(m Message) *GPALM {
+	return &GPALM{Message: m}
+}
+
+// GPALM struct, angles are expressed in semicircles as defined by ICD-GPS-200
+type GPALM struct {
+	Message
+
+	NbOfMessages             int
+	MessageNumber            int
+	PRN                      int
+	Week                     int
+	Health                   uint8   // SV health
+	Eccentricity             float64 // Eccentricity (dimensionless)
+	ReferenceTime            float64 // Almanac reference time in seconds of GPS week
+	InclinationOffset        float64 // Inclination angle offset to 0.3 semicircles
+	RateOfRightAscension     float64 // Rate of right ascension in semicircles per second
+	SqrtSemiMajorAxis        float64 // Root of semi-major axis in meters^1/2
+	ArgumentOfPerigee        float64 // Argument of perigee in semicircles
+	LongitudeOfAscendingNode float64 // Longitude of ascension node in semicircles
+	MeanAnomaly              float64 // Mean anomaly in semicircles
+	ClockBias                float64 // Clock parameter af0 in seconds
+	ClockDrift               float64 // Clock parameter af1 in seconds per second
+}
+
+// almField describe an hexadecimal almanac parameter: data field index, width
+// in hex digits, significant bits, signedness and scale factor
+type almField struct {
+	i      int
+	v      *float64
+	width  int
+	bits   uint
+	signed bool
+	scale  float64
+}
+
+// fields return the scaled hexadecimal parameters of the sentence
+func (m *GPALM) fields() []almField {
+	return []almField{
+		{5, &m.Eccentricity, 4, 16, false, math.Pow(2, -21)},
+		{6, &m.ReferenceTime, 2, 8, false, math.Pow(2, 12)},
+		{7, &m.InclinationOffset, 4, 16, true, math.Pow(2, -19)},
+		{8, &m.RateOfRightAscension, 4, 16, true, math.Pow(2, -38)},
+		{9, &m.SqrtSemiMajorAxis, 6, 24, false, math.Pow(2, -11)},
+		{10, &m.ArgumentOfPerigee, 6, 24, true, math.Pow(2, -23)},
+		{11, &m.LongitudeOfAscendingNode, 6, 24, true, math.Pow(2, -23)},
+		{12, &m.MeanAnomaly, 6, 24, true, math.Pow(2, -23)},
+		{13, &m.ClockBias, 3, 11, true, math.Pow(2, -20)},
+		{14, &m.ClockDrift, 3, 11, true, math.Pow(2, -38)},
+	}
+}
+
+// Inclination return the inclination angle in radians
+func (m GPALM) Inclination() float64 {
+	return (0.3 + m.InclinationOffset) * math.Pi
+}
+
+// SemiMajorAxis return the semi-major axis of the orbit in meters
+func (m GPALM) SemiMajorAxis() float64 {
+	return m.SqrtSemiMajorAxis * m.SqrtSemiMajorAxis
+}
+
+func (m *GPALM) parse() (err error) {
+	if len(m.Fields) != 15 {
+		return m.Error(fmt.Errorf("Incomplete GPALM message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 15))
+	}
+
+	for i, v := range map[int]*int{0: &m.NbOfMessages, 1: &m.MessageNumber, 2: &m.PRN, 3: &m.Week} {
+		if *v, err = strconv.Atoi(m.Fields[i]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse data field %d (got: %s)", i+1, m.Fields[i]))
+		}
+	}
+
+	health, err := strconv.ParseUint(m.Fields[4], 16, 8)
+	if err != nil {
+		return m.Error(fmt.Errorf("Unable to parse SV health from data field (got: %s)", m.Fields[4]))
+	}
+	m.Health = uint8(health)
+
+	for _, f := range m.fields() {
+		raw, err := strconv.ParseUint(m.Fields[f.i], 16, 32)
+		if err != nil {
+			return m.Error(fmt.Errorf("Unable to parse hexadecimal data field %d (got: %s)", f.i+1, m.Fields[f.i]))
+		}
+
+		// Keep significant bits only, some receivers sign-extend to the field width
+		n := int64(raw & (1<<f.bits - 1))
+		if f.signed && n>>(f.bits-1) == 1 {
+			n -= 1 << f.bits
+		}
+		*f.v = float64(n) * f.scale
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence ALM as string
+func (m GPALM) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPALM")
+	fields := make([]string, 0)
+	fields = append(fields,
+		strconv.Itoa(m.NbOfMessages),
+		strconv.Itoa(m.MessageNumber),
+		fmt.Sprintf("%02d", m.PRN),
+		fmt.Sprintf("%04d", m.Week),
+		fmt.Sprintf("%02x", m.Health))
+
+	for _, f := range (&m).fields() {
+		// Negative values are sign-extended to the field width
+		n := int64(math.Round(*f.v / f.scale))
+		if n < 0 {
+			n += 1 << uint(4*f.width)
+		}
+		fields = append(fields, fmt.Sprintf("%0*x", f.width, n))
+	}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -0,0 +1,89 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+ALR Set Alarm State
+       1         2   3 4 5
+       |         |   | | |
+$--ALR,hhmmss.ss,xxx,A,A,c--c*hh
+
+1) Time of alarm condition change, UTC
+2) Local alarm number (identifier)
+3) Alarm condition, A = threshold exceeded, V = not exceeded
+4) Alarm's acknowledge state, A = acknowledged, V = unacknowledged
+5) Alarm's description text
+6) Checksum
+
+Examples:
+$IIALR,142507.00,031,A,V,DEPTH ALARM*43
+$GPALR,,005,V,A,ANCHOR WATCH*36
+*/
+
+// NewGPALR allocate GPALR struct for ALR sentence (Set alarm state),
+// also used for other talkers (ie: IIALR)
+func NewGPALR(m Message) *GPALR {
+	return &GPALR{Message: m}
+}
+
+// GPALR struct
+type GPALR struct {
+	Message
+
+	TimeUTC      *TimeOfDay // Time of alarm condition change, nil if not provided
+	AlarmID      int
+	Active       DataValid // Valid when alarm threshold is exceeded
+	Acknowledged DataValid // Valid when alarm has been acknowledged
+	Description  string
+}
+
+func (m *GPALR) parse() (err error) {
+	if len(m.Fields) != 5 {
+		return m.Error(fmt.Errorf("Incomplete GPALR message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 5))
+	}
+
+	if len(m.Fields[0]) > 0 {
+		t, err := ParseTimeOfDay(m.Fields[0])
+		if err != nil {
+			return m.Error(fmt.Errorf("Unable to parse time UTC from data field (got: %s)", m.Fields[0]))
+		}
+		m.TimeUTC = &t
+	}
+
+	if m.AlarmID, err = strconv.Atoi(m.Fields[1]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse alarm number from data field (got: %s)", m.Fields[1]))
+	}
+
+	m.Active = (m.Fields[2] == "A")
+	m.Acknowledged = (m.Fields[3] == "A")
+	m.Description = m.Fields[4]
+
+	return nil
+}
+
+// Serialize return a valid sentence ALR as string
+func (m GPALR) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPALR")
+	fields := make([]string, 0)
+
+	if m.TimeUTC != nil {
+		fields = append(fields, m.TimeUTC.Serialize())
+	} else {
+		fields = append(fields, "")
+	}
+
+	fields = append(fields,
+		fmt.Sprintf("%03d", m.AlarmID),
+		m.Active.Serialize(),
+		m.Acknowledged.Serialize(),
+		m.Description)
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
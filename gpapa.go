@@ -0,0 +1,132 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+APA Autopilot Sentence "A"
+       1 2 3   4 5 6 7 8   9 10
+       | | |   | | | | |   | |
+$--APA,A,A,x.x,a,N,A,A,xxx,a,c--c*hh
+
+1) Status, A = Data valid, V = Loran-C blink or SNR warning (general warning flag)
+2) Status, A = Data valid, V = Loran-C cycle lock warning
+3) Cross track error magnitude
+4) Direction to steer, L or R
+5) Cross track units, N = Nautical miles
+6) Status, A = Arrival circle entered, V = Not entered
+7) Status, A = Perpendicular passed at waypoint, V = Not passed
+8) Bearing origin to destination
+9) M = Magnetic, T = True
+10) Destination waypoint ID
+11) Checksum
+
+Superseded by APB sentence, still expected by older autopilots
+
+Examples:
+$GPAPA,A,A,0.10,R,N,V,V,011,M,DEST*3F
+$IIAPA,A,A,0.00,L,N,A,V,214,T,EGLM*3B
+*/
+
+// NewGPAPA allocate GPAPA struct for APA sentence (Autopilot sentence A),
+// also used for other talkers (ie: IIAPA)
+func NewGPAPA(m Message) *GPAPA {
+	return &GPAPA{Message: m}
+}
+
+// GPAPA struct
+type GPAPA struct {
+	Message
+
+	IsValid                    DataValid      // General warning flag
+	IsCycleLockValid           DataValid      // Cycle lock warning flag
+	CrossTrackError            float64        // Cross track error magnitude in nautical miles
+	SteerDirection             SteerDirection // Direction to steer to return on track
+	IsArrived                  DataValid      // Arrival circle entered
+	IsPerpendicularPassed      DataValid      // Perpendicular passed at waypoint
+	BearingOriginToDestination float64        // Bearing origin to destination in degree
+	BearingOriginReference     NorthReference // Reference of bearing origin to destination, empty if bearing not provided
+	DestinationID              string         // Destination waypoint ID
+}
+
+// APA return the APA sentence matching APB sentence, for autopilots not
+// supporting APB
+func (m GPAPB) APA() GPAPA {
+	return GPAPA{
+		IsValid:                    m.IsValid,
+		IsCycleLockValid:           m.IsCycleLockValid,
+		CrossTrackError:            m.CrossTrackError,
+		SteerDirection:             m.SteerDirection,
+		IsArrived:                  m.IsArrived,
+		IsPerpendicularPassed:      m.IsPerpendicularPassed,
+		BearingOriginToDestination: m.BearingOriginToDestination,
+		BearingOriginReference:     m.BearingOriginReference,
+		DestinationID:              m.DestinationID,
+	}
+}
+
+func (m *GPAPA) parse() (err error) {
+	if len(m.Fields) != 10 {
+		return m.Error(fmt.Errorf("Incomplete GPAPA message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 10))
+	}
+
+	// Validate fixed field
+	if m.Fields[4] != "N" {
+		return m.Error(fmt.Errorf("Invalid fixed field at %d (got: %s, wanted: %s)", 5, m.Fields[4], "N"))
+	}
+
+	m.IsValid = (m.Fields[0] == "A")
+	m.IsCycleLockValid = (m.Fields[1] == "A")
+
+	if len(m.Fields[2]) > 0 {
+		if m.CrossTrackError, err = strconv.ParseFloat(m.Fields[2], 64); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse cross track error from data field (got: %s)", m.Fields[2]))
+		}
+	}
+
+	if len(m.Fields[3]) > 0 {
+		if m.SteerDirection, err = ParseSteerDirection(m.Fields[3]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse direction to steer from data field (got: %s)", m.Fields[3]))
+		}
+	}
+
+	m.IsArrived = (m.Fields[5] == "A")
+	m.IsPerpendicularPassed = (m.Fields[6] == "A")
+
+	if len(m.Fields[7]) > 0 {
+		if m.BearingOriginToDestination, err = strconv.ParseFloat(m.Fields[7], 64); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse bearing from data field (got: %s)", m.Fields[7]))
+		}
+		if m.BearingOriginReference, err = ParseNorthReference(m.Fields[8]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse bearing reference from data field (got: %s)", m.Fields[8]))
+		}
+	}
+
+	m.DestinationID = m.Fields[9]
+
+	return nil
+}
+
+// Serialize return a valid sentence APA as string
+func (m GPAPA) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPAPA")
+	fields := make([]string, 0)
+	fields = append(fields,
+		m.IsValid.Serialize(),
+		m.IsCycleLockValid.Serialize(),
+		fmt.Sprintf("%.2f", m.CrossTrackError),
+		m.SteerDirection.Serialize(),
+		"N",
+		m.IsArrived.Serialize(),
+		m.IsPerpendicularPassed.Serialize())
+	fields = append(fields, serializeBearing(m.BearingOriginToDestination, m.BearingOriginReference, "%03.0f")...)
+	fields = append(fields, m.DestinationID)
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -0,0 +1,177 @@
+package nmea
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+/*
+APB Autopilot Sentence "B"
+       1 2 3   4 5 6 7 8   9 10   11  12 13  14 15 16
+       | | |   | | | | |   | |    |   |  |   |  |  |
+$--APB,A,A,x.x,a,N,A,A,x.x,a,c--c,x.x,a,x.x,a,m*hh
+
+1) Status, A = Data valid, V = Loran-C blink or SNR warning (general warning flag)
+2) Status, A = Data valid, V = Loran-C cycle lock warning
+3) Cross track error magnitude
+4) Direction to steer, L or R
+5) Cross track units, N = Nautical miles
+6) Status, A = Arrival circle entered, V = Not entered
+7) Status, A = Perpendicular passed at waypoint, V = Not passed
+8) Bearing origin to destination
+9) M = Magnetic, T = True
+10) Destination waypoint ID
+11) Bearing, present position to destination
+12) M = Magnetic, T = True
+13) Heading to steer to destination waypoint
+14) M = Magnetic, T = True
+15) Mode indicator (NMEA 2.3, optional)
+16) Checksum
+
+Examples:
+$GPAPB,A,A,0.10,R,N,V,V,011.0,M,DEST,011.0,M,011.0,M*22
+$GPAPB,A,A,0.00,L,N,A,V,213.9,T,EGLM,214.2,T,214.2,T,A*52
+*/
+
+// NewGPAPB allocate GPAPB struct for APB sentence (Autopilot sentence B),
+// also used for other talkers (ie: IIAPB, INAPB)
+func NewGPAPB(m Message) *GPAPB {
+	return &GPAPB{Message: m}
+}
+
+// GPAPB struct
+type GPAPB struct {
+	Message
+
+	IsValid                    DataValid       // General warning flag
+	IsCycleLockValid           DataValid       // Cycle lock warning flag
+	CrossTrackError            float64         // Cross track error magnitude in nautical miles
+	SteerDirection             SteerDirection  // Direction to steer to return on track
+	IsArrived                  DataValid       // Arrival circle entered
+	IsPerpendicularPassed      DataValid       // Perpendicular passed at waypoint
+	BearingOriginToDestination float64         // Bearing origin to destination in degree
+	BearingOriginReference     NorthReference  // Reference of bearing origin to destination, empty if bearing not provided
+	DestinationID              string          // Destination waypoint ID
+	BearingToDestination       float64         // Bearing present position to destination in degree
+	BearingReference           NorthReference  // Reference of bearing present position to destination, empty if bearing not provided
+	HeadingToSteer             float64         // Heading to steer to destination waypoint in degree
+	HeadingReference           NorthReference  // Reference of heading to steer, empty if heading not provided
+	PositioningMode            PositioningMode // Mode indicator, empty if not provided
+}
+
+// APB return the APB sentence matching the navigation information of RMB
+// sentence, given the true bearing of the leg from origin to destination
+func (m GPRMB) APB(bearingOriginToDestination float64) GPAPB {
+	return GPAPB{
+		IsValid:                    m.IsValid,
+		IsCycleLockValid:           m.IsValid,
+		CrossTrackError:            m.CrossTrackError,
+		SteerDirection:             m.SteerDirection,
+		IsArrived:                  m.IsArrived,
+		IsPerpendicularPassed:      math.Abs(angleDiff(bearingOriginToDestination, m.Bearing)) > 90,
+		BearingOriginToDestination: bearingOriginToDestination,
+		BearingOriginReference:     TrueReference,
+		DestinationID:              m.DestinationID,
+		BearingToDestination:       m.Bearing,
+		BearingReference:           TrueReference,
+		HeadingToSteer:             m.Bearing,
+		HeadingReference:           TrueReference,
+		PositioningMode:            m.PositioningMode,
+	}
+}
+
+func (m *GPAPB) parse() (err error) {
+	if len(m.Fields) != 14 && len(m.Fields) != 15 {
+		return m.Error(fmt.Errorf("Incomplete GPAPB message, not enougth data fields (got: %d, wanted: %d or %d)", len(m.Fields), 14, 15))
+	}
+
+	// Validate fixed field
+	if m.Fields[4] != "N" {
+		return m.Error(fmt.Errorf("Invalid fixed field at %d (got: %s, wanted: %s)", 5, m.Fields[4], "N"))
+	}
+
+	m.IsValid = (m.Fields[0] == "A")
+	m.IsCycleLockValid = (m.Fields[1] == "A")
+
+	if len(m.Fields[2]) > 0 {
+		if m.CrossTrackError, err = strconv.ParseFloat(m.Fields[2], 64); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse cross track error from data field (got: %s)", m.Fields[2]))
+		}
+	}
+
+	if len(m.Fields[3]) > 0 {
+		if m.SteerDirection, err = ParseSteerDirection(m.Fields[3]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse direction to steer from data field (got: %s)", m.Fields[3]))
+		}
+	}
+
+	m.IsArrived = (m.Fields[5] == "A")
+	m.IsPerpendicularPassed = (m.Fields[6] == "A")
+	m.DestinationID = m.Fields[9]
+
+	for _, f := range []struct {
+		i   int
+		v   *float64
+		ref *NorthReference
+	}{
+		{7, &m.BearingOriginToDestination, &m.BearingOriginReference},
+		{10, &m.BearingToDestination, &m.BearingReference},
+		{12, &m.HeadingToSteer, &m.HeadingReference},
+	} {
+		if len(m.Fields[f.i]) == 0 {
+			continue
+		}
+		if *f.v, err = strconv.ParseFloat(m.Fields[f.i], 64); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse bearing from data field (got: %s)", m.Fields[f.i]))
+		}
+		if *f.ref, err = ParseNorthReference(m.Fields[f.i+1]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse bearing reference from data field (got: %s)", m.Fields[f.i+1]))
+		}
+	}
+
+	if len(m.Fields) == 15 {
+		if m.PositioningMode, err = ParsePositioningMode(m.Fields[14]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse mode indicator from data field (got: %s)", m.Fields[14]))
+		}
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence APB as string
+func (m GPAPB) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPAPB")
+	fields := make([]string, 0)
+	fields = append(fields,
+		m.IsValid.Serialize(),
+		m.IsCycleLockValid.Serialize(),
+		fmt.Sprintf("%.2f", m.CrossTrackError),
+		m.SteerDirection.Serialize(),
+		"N",
+		m.IsArrived.Serialize(),
+		m.IsPerpendicularPassed.Serialize())
+	fields = append(fields, serializeBearing(m.BearingOriginToDestination, m.BearingOriginReference, "%05.1f")...)
+	fields = append(fields, m.DestinationID)
+	fields = append(fields, serializeBearing(m.BearingToDestination, m.BearingReference, "%05.1f")...)
+	fields = append(fields, serializeBearing(m.HeadingToSteer, m.HeadingReference, "%05.1f")...)
+
+	if len(m.PositioningMode) > 0 {
+		fields = append(fields, m.PositioningMode.Serialize())
+	}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+// serializeBearing return bearing and reference data fields, both empty if
+// reference is not provided (ie: no active route)
+func serializeBearing(bearing float64, reference NorthReference, format string) []string {
+	if len(reference) == 0 {
+		return []string{"", ""}
+	}
+	return []string{fmt.Sprintf(format, bearing), reference.Serialize()}
+}
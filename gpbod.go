@@ -0,0 +1,82 @@
+package nmea
+
+import (
+	"fmt"
+)
+
+/*
+BOD Bearing Origin to Destination
+       1   2 3   4 5    6    7
+       |   | |   | |    |    |
+$--BOD,x.x,T,x.x,M,c--c,c--c*hh
+
+1) Bearing, degrees true
+2) T = True
+3) Bearing, degrees magnetic
+4) M = Magnetic
+5) Destination waypoint ID
+6) Origin waypoint ID
+7) Checksum
+
+Examples:
+$GPBOD,099.3,T,105.6,M,POINTB,*48
+$GPBOD,097.0,T,103.2,M,POINTB,POINTA*4A
+*/
+
+// NewGPBOD allocate GPBOD struct for BOD sentence (Bearing origin to destination),
+// also used for other talkers (ie: IIBOD, INBOD)
+func NewGPBOD(m Message) *GPBOD {
+	return &GPBOD{Message: m}
+}
+
+// GPBOD struct
+type GPBOD struct {
+	Message
+
+	BearingTrue     *float64 // Bearing origin to destination in degree (true), nil if not provided
+	BearingMagnetic *float64 // Bearing origin to destination in degree (magnetic), nil if not provided
+	DestinationID   string   // Destination waypoint ID
+	OriginID        string   // Origin waypoint ID, empty when navigating from present position
+}
+
+func (m *GPBOD) parse() (err error) {
+	if len(m.Fields) != 6 {
+		return m.Error(fmt.Errorf("Incomplete GPBOD message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 6))
+	}
+
+	// Validate fixed field
+	for i, v := range map[int]string{1: "T", 3: "M"} {
+		if m.Fields[i] != v {
+			return m.Error(fmt.Errorf("Invalid fixed field at %d (got: %s, wanted: %s)", i+1, m.Fields[i], v))
+		}
+	}
+
+	if m.BearingTrue, err = parseOptionalFloat(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse true bearing from data field (got: %s)", m.Fields[0]))
+	}
+
+	if m.BearingMagnetic, err = parseOptionalFloat(m.Fields[2]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse magnetic bearing from data field (got: %s)", m.Fields[2]))
+	}
+
+	m.DestinationID = m.Fields[4]
+	m.OriginID = m.Fields[5]
+
+	return nil
+}
+
+// Serialize return a valid sentence BOD as string
+func (m GPBOD) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPBOD")
+	fields := make([]string, 0)
+	fields = append(fields,
+		formatOptionalFloat(m.BearingTrue, "%05.1f"), "T",
+		formatOptionalFloat(m.BearingMagnetic, "%05.1f"), "M",
+		m.DestinationID,
+		m.OriginID)
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -0,0 +1,125 @@
+package nmea
+
+import (
+	"fmt"
+	"strings"
+)
+
+/*
+BWC Bearing and Distance to Waypoint, Great Circle
+       1         2       3 4        5 6   7 8   9 10  11 12  13 14
+       |         |       | |        | |   | |   | |   |  |    |  |
+$--BWC,hhmmss.ss,llll.ll,a,yyyyy.yy,a,x.x,T,x.x,M,x.x,N,c--c,m*hh
+
+1) Time (UTC)
+2) Waypoint latitude
+3) N or S (North or South)
+4) Waypoint longitude
+5) E or W (East or West)
+6) Bearing, degrees true
+7) T = True
+8) Bearing, degrees magnetic
+9) M = Magnetic
+10) Distance, nautical miles
+11) N = Nautical miles
+12) Waypoint ID
+13) Mode indicator (NMEA 2.3, optional)
+14) Checksum
+
+Examples:
+$GPBWC,081837,,,,,,T,,M,,N,*13
+$GPBWC,220516,5130.02,N,12046.34,W,213.8,T,218.0,M,4.6,N,EGLM,A*7F
+*/
+
+// NewGPBWC allocate GPBWC struct for BWC sentence (Bearing and distance to waypoint,
+// great circle), also used for other talkers (ie: IIBWC, INBWC)
+func NewGPBWC(m Message) *GPBWC {
+	return &GPBWC{Message: m}
+}
+
+// GPBWC struct
+type GPBWC struct {
+	Message
+
+	TimeUTC         TimeOfDay       // Time UTC data field, without date
+	Waypoint        Position        // Waypoint location
+	BearingTrue     *float64        // Bearing to waypoint in degree (true), nil if not provided
+	BearingMagnetic *float64        // Bearing to waypoint in degree (magnetic), nil if not provided
+	Distance        *float64        // Distance to waypoint in nautical miles, nil if not provided
+	WaypointID      string          // Waypoint ID
+	PositioningMode PositioningMode // Mode indicator, empty if not provided
+}
+
+func (m *GPBWC) parse() (err error) {
+	if len(m.Fields) != 12 && len(m.Fields) != 13 {
+		return m.Error(fmt.Errorf("Incomplete GPBWC message, not enougth data fields (got: %d, wanted: %d or %d)", len(m.Fields), 12, 13))
+	}
+
+	// Validate fixed field
+	for i, v := range map[int]string{6: "T", 8: "M", 10: "N"} {
+		if m.Fields[i] != v {
+			return m.Error(fmt.Errorf("Invalid fixed field at %d (got: %s, wanted: %s)", i+1, m.Fields[i], v))
+		}
+	}
+
+	if m.TimeUTC, err = ParseTimeOfDay(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse time UTC from data field (got: %s)", m.Fields[0]))
+	}
+
+	if latitude := strings.TrimSpace(strings.Join(m.Fields[1:3], " ")); len(latitude) > 0 {
+		if m.Waypoint.Latitude, err = NewLatLong(latitude); err != nil {
+			return m.Error(err)
+		}
+	}
+
+	if longitude := strings.TrimSpace(strings.Join(m.Fields[3:5], " ")); len(longitude) > 0 {
+		if m.Waypoint.Longitude, err = NewLatLong(longitude); err != nil {
+			return m.Error(err)
+		}
+	}
+
+	if m.BearingTrue, err = parseOptionalFloat(m.Fields[5]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse true bearing from data field (got: %s)", m.Fields[5]))
+	}
+
+	if m.BearingMagnetic, err = parseOptionalFloat(m.Fields[7]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse magnetic bearing from data field (got: %s)", m.Fields[7]))
+	}
+
+	if m.Distance, err = parseOptionalFloat(m.Fields[9]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse distance from data field (got: %s)", m.Fields[9]))
+	}
+
+	m.WaypointID = m.Fields[11]
+
+	if len(m.Fields) == 13 {
+		if m.PositioningMode, err = ParsePositioningMode(m.Fields[12]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse mode indicator from data field (got: %s)", m.Fields[12]))
+		}
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence BWC as string
+func (m GPBWC) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPBWC")
+	fields := make([]string, 0)
+	fields = append(fields, m.TimeUTC.Serialize())
+	fields = append(fields, serializeOptionalPosition(m.Waypoint.Latitude, m.Waypoint.Longitude, 2)...)
+	fields = append(fields,
+		formatOptionalFloat(m.BearingTrue, "%05.1f"), "T",
+		formatOptionalFloat(m.BearingMagnetic, "%05.1f"), "M",
+		formatOptionalFloat(m.Distance, "%.1f"), "N",
+		m.WaypointID)
+
+	if len(m.PositioningMode) > 0 {
+		fields = append(fields, m.PositioningMode.Serialize())
+	}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -0,0 +1,82 @@
+package nmea
+
+import (
+	"fmt"
+)
+
+/*
+BWW Bearing, Waypoint to Waypoint
+       1   2 3   4 5    6    7
+       |   | |   | |    |    |
+$--BWW,x.x,T,x.x,M,c--c,c--c*hh
+
+1) Bearing, degrees true
+2) T = True
+3) Bearing, degrees magnetic
+4) M = Magnetic
+5) TO waypoint ID
+6) FROM waypoint ID
+7) Checksum
+
+Examples:
+$GPBWW,097.0,T,103.2,M,POINTB,POINTA*41
+$GPBWW,,T,,M,WPT2,WPT1*4F
+*/
+
+// NewGPBWW allocate GPBWW struct for BWW sentence (Bearing waypoint to waypoint),
+// also used for other talkers (ie: IIBWW, INBWW)
+func NewGPBWW(m Message) *GPBWW {
+	return &GPBWW{Message: m}
+}
+
+// GPBWW struct
+type GPBWW struct {
+	Message
+
+	BearingTrue     *float64 // Bearing waypoint to waypoint in degree (true), nil if not provided
+	BearingMagnetic *float64 // Bearing waypoint to waypoint in degree (magnetic), nil if not provided
+	ToID            string   // TO waypoint ID
+	FromID          string   // FROM waypoint ID
+}
+
+func (m *GPBWW) parse() (err error) {
+	if len(m.Fields) != 6 {
+		return m.Error(fmt.Errorf("Incomplete GPBWW message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 6))
+	}
+
+	// Validate fixed field
+	for i, v := range map[int]string{1: "T", 3: "M"} {
+		if m.Fields[i] != v {
+			return m.Error(fmt.Errorf("Invalid fixed field at %d (got: %s, wanted: %s)", i+1, m.Fields[i], v))
+		}
+	}
+
+	if m.BearingTrue, err = parseOptionalFloat(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse true bearing from data field (got: %s)", m.Fields[0]))
+	}
+
+	if m.BearingMagnetic, err = parseOptionalFloat(m.Fields[2]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse magnetic bearing from data field (got: %s)", m.Fields[2]))
+	}
+
+	m.ToID = m.Fields[4]
+	m.FromID = m.Fields[5]
+
+	return nil
+}
+
+// Serialize return a valid sentence BWW as string
+func (m GPBWW) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPBWW")
+	fields := make([]string, 0)
+	fields = append(fields,
+		formatOptionalFloat(m.BearingTrue, "%05.1f"), "T",
+		formatOptionalFloat(m.BearingMagnetic, "%05.1f"), "M",
+		m.ToID,
+		m.FromID)
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -0,0 +1,68 @@
+package nmea
+
+import "fmt"
+
+/*
+DBK Depth Below Keel
+       1   2 3   4 5   6 7
+       |   | |   | |   | |
+$--DBK,x.x,f,x.x,M,x.x,F*hh
+
+1) Depth, feet
+2) f = feet
+3) Depth, meters
+4) M = meters
+5) Depth, Fathoms
+6) F = Fathoms
+7) Checksum
+
+Examples:
+$SDDBK,37.6,f,11.5,M,6.3,F*1B
+$IIDBK,,f,9.7,M,,F*00
+*/
+
+// NewGPDBK allocate GPDBK struct for echo-sounder sentence DBK (Depth Below Keel),
+// also used for other talkers (ie: SDDBK, IIDBK)
+func NewGPDBK(m Message) *GPDBK {
+	return &GPDBK{Message: m}
+}
+
+// GPDBK struct, depth not provided by the sentence are set to 0
+type GPDBK struct {
+	Message
+
+	DepthInFeet    float64
+	DepthInMeters  float64
+	DepthInFathoms float64
+
+	layout *depthLayout // Layout of data fields as received, nil if crafted from scratch
+}
+
+// Depth return depth in meters from the first provided data field (meters,
+// feet then fathoms), false if the sentence doesn't provide any depth
+func (m GPDBK) Depth() (float64, bool) {
+	return m.layout.depthInMeters(m.DepthInFeet, m.DepthInMeters, m.DepthInFathoms)
+}
+
+func (m *GPDBK) parse() (err error) {
+	if len(m.Fields) != 6 {
+		return m.Error(fmt.Errorf("Incomplete GPDBK message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 6))
+	}
+
+	if m.layout, err = parseDepths(m.Fields, &m.DepthInFeet, &m.DepthInMeters, &m.DepthInFathoms); err != nil {
+		return m.Error(err)
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence DBK as string
+func (m GPDBK) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPDBK")
+	fields := m.layout.serialize(m.DepthInFeet, m.DepthInMeters, m.DepthInFathoms)
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -0,0 +1,68 @@
+package nmea
+
+import "fmt"
+
+/*
+DBS Depth Below Surface
+       1   2 3   4 5   6 7
+       |   | |   | |   | |
+$--DBS,x.x,f,x.x,M,x.x,F*hh
+
+1) Depth, feet
+2) f = feet
+3) Depth, meters
+4) M = meters
+5) Depth, Fathoms
+6) F = Fathoms
+7) Checksum
+
+Examples:
+$SDDBS,44.2,f,13.5,M,7.4,F*07
+$IIDBS,,f,23.8,M,,F*2F
+*/
+
+// NewGPDBS allocate GPDBS struct for echo-sounder sentence DBS (Depth Below Surface),
+// also used for other talkers (ie: SDDBS, IIDBS)
+func NewGPDBS(m Message) *GPDBS {
+	return &GPDBS{Message: m}
+}
+
+// GPDBS struct, depth not provided by the sentence are set to 0
+type GPDBS struct {
+	Message
+
+	DepthInFeet    float64
+	DepthInMeters  float64
+	DepthInFathoms float64
+
+	layout *depthLayout // Layout of data fields as received, nil if crafted from scratch
+}
+
+// Depth return depth in meters from the first provided data field (meters,
+// feet then fathoms), false if the sentence doesn't provide any depth
+func (m GPDBS) Depth() (float64, bool) {
+	return m.layout.depthInMeters(m.DepthInFeet, m.DepthInMeters, m.DepthInFathoms)
+}
+
+func (m *GPDBS) parse() (err error) {
+	if len(m.Fields) != 6 {
+		return m.Error(fmt.Errorf("Incomplete GPDBS message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 6))
+	}
+
+	if m.layout, err = parseDepths(m.Fields, &m.DepthInFeet, &m.DepthInMeters, &m.DepthInFathoms); err != nil {
+		return m.Error(err)
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence DBS as string
+func (m GPDBS) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPDBS")
+	fields := m.layout.serialize(m.DepthInFeet, m.DepthInMeters, m.DepthInFathoms)
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -3,6 +3,7 @@ package nmea
 import (
 	"fmt"
 	"strconv"
+	"strings"
 )
 
 /*
@@ -23,18 +24,27 @@ import (
  $MXDBT,108.34,f,33.02,M,18.06,F,*09
 */
 
-// NewGPDBT allocate GPDBT struct for echo-sounder sentence DBT (Depth Below Transducer)
+// NewGPDBT allocate GPDBT struct for echo-sounder sentence DBT (Depth Below Transducer),
+// also used for other talkers (ie: SDDBT, IIDBT, INDBT)
 func NewGPDBT(m Message) *GPDBT {
 	return &GPDBT{Message: m}
 }
 
-// GPDBT struct
+// GPDBT struct, depth not provided by the sentence are set to 0
 type GPDBT struct {
 	Message
 
 	DepthInFeet    float64
 	DepthInMeters  float64
 	DepthInFathoms float64
+
+	layout *depthLayout // Layout of data fields as received, nil if crafted from scratch
+}
+
+// Depth return depth in meters from the first provided data field (meters,
+// feet then fathoms), false if the sentence doesn't provide any depth
+func (m GPDBT) Depth() (float64, bool) {
+	return m.layout.depthInMeters(m.DepthInFeet, m.DepthInMeters, m.DepthInFathoms)
 }
 
 func (m *GPDBT) parse() (err error) {
@@ -42,23 +52,8 @@ func (m *GPDBT) parse() (err error) {
 		return m.Error(fmt.Errorf("Incomplete GPDBT message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 6))
 	}
 
-	// Validate fixed field
-	for i, v := range map[int]string{1: "f", 3: "M", 5: "F"} {
-		if m.Fields[i] != v {
-			return m.Error(fmt.Errorf("Invalid fixed field at %d (got: %s, wanted: %s)", i+1, m.Fields[i], v))
-		}
-	}
-
-	if m.DepthInFeet, err = strconv.ParseFloat(m.Fields[0], 64); err != nil {
-		return m.Error(fmt.Errorf("Unable to parse depth in feet from data field (got: %s)", m.Fields[0]))
-	}
-
-	if m.DepthInMeters, err = strconv.ParseFloat(m.Fields[2], 64); err != nil {
-		return m.Error(fmt.Errorf("Unable to parse depth in meters from data field (got: %s)", m.Fields[4]))
-	}
-
-	if m.DepthInFathoms, err = strconv.ParseFloat(m.Fields[4], 64); err != nil {
-		return m.Error(fmt.Errorf("Unable to parse depth in fathoms from data field (got: %s)", m.Fields[6]))
+	if m.layout, err = parseDepths(m.Fields, &m.DepthInFeet, &m.DepthInMeters, &m.DepthInFathoms); err != nil {
+		return m.Error(err)
 	}
 
 	return nil
@@ -67,14 +62,93 @@ func (m *GPDBT) parse() (err error) {
 // Serialize return a valid sentence DBT as string
 func (m GPDBT) Serialize() string { // Implement NMEA interface
 
-	hdr := TypeIDs["GPDBT"]
-	fields := make([]string, 0)
-	fields = append(fields,
-		fmt.Sprintf("%03.1f", m.DepthInFeet), "f",
-		fmt.Sprintf("%03.1f", m.DepthInMeters), "M",
-		fmt.Sprintf("%03.1f", m.DepthInFathoms), "F")
+	hdr := m.header("GPDBT")
+	fields := m.layout.serialize(m.DepthInFeet, m.DepthInMeters, m.DepthInFathoms)
 	msg := Message{Type: hdr, Fields: fields}
 	msg.Checksum = msg.ComputeChecksum()
 
 	return msg.Serialize()
 }
+
+// depthLayout struct keeps how depth data fields of DBT, DBS and DBK
+// sentences are provided, to serialize them back identically
+type depthLayout struct {
+	formats [3]string // Format of feet, meters and fathoms data fields, empty if not provided
+	units   [3]bool   // Unit data field provided
+}
+
+var depthUnits = [3]DepthUnit{Feet, Meters, Fathoms}
+
+// parseDepths parse feet, meters and fathoms data fields with their units,
+// depth not provided are set to 0
+func parseDepths(fields []string, depths ...*float64) (*depthLayout, error) {
+	layout := &depthLayout{}
+	for i, unit := range depthUnits {
+		value, u := fields[2*i], fields[2*i+1]
+
+		// Validate fixed field, unit may be omitted with its value
+		if u != unit.Serialize() && (len(u) > 0 || len(value) > 0) {
+			return nil, fmt.Errorf("Invalid fixed field at %d (got: %s, wanted: %s)", 2*i+2, u, unit.Serialize())
+		}
+		layout.units[i] = len(u) > 0
+
+		if len(value) == 0 {
+			continue
+		}
+
+		var err error
+		if *depths[i], err = strconv.ParseFloat(value, 64); err != nil {
+			return nil, fmt.Errorf("Unable to parse depth in %s from data field (got: %s)", unit, value)
+		}
+
+		decimals := 0
+		if dot := strings.Index(value, "."); dot >= 0 {
+			decimals = len(value) - dot - 1
+		}
+		layout.formats[i] = fmt.Sprintf("%%0%d.%df", len(value), decimals)
+	}
+	return layout, nil
+}
+
+// provided return true if depth at index i (feet, meters then fathoms) is
+// provided, a message crafted from scratch provides depth not set to 0
+func (l *depthLayout) provided(i int, depth float64) bool {
+	if l == nil {
+		return depth != 0
+	}
+	return len(l.formats[i]) > 0
+}
+
+// depthInMeters return depth in meters from the first provided depth (meters,
+// feet then fathoms), false if none is provided
+func (l *depthLayout) depthInMeters(feet, meters, fathoms float64) (float64, bool) {
+	switch {
+	case l.provided(1, meters):
+		return meters, true
+	case l.provided(0, feet):
+		return Feet.ToMeters(feet), true
+	case l.provided(2, fathoms):
+		return Fathoms.ToMeters(fathoms), true
+	}
+	return 0, false
+}
+
+// serialize return feet, meters and fathoms data fields with their units,
+// empty if not provided
+func (l *depthLayout) serialize(depths ...float64) []string {
+	fields := make([]string, 0)
+	for i, unit := range depthUnits {
+		value, u := "", unit.Serialize()
+		switch {
+		case l == nil && depths[i] != 0:
+			value = strconv.FormatFloat(depths[i], 'f', -1, 64)
+		case l != nil && len(l.formats[i]) > 0:
+			value = fmt.Sprintf(l.formats[i], depths[i])
+		}
+		if l != nil && !l.units[i] {
+			u = ""
+		}
+		fields = append(fields, value, u)
+	}
+	return fields
+}
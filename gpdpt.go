@@ -0,0 +1,99 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+DPT Depth of Water
+       1   2   3   4
+       |   |   |   |
+$--DPT,x.x,x.x,x.x*hh
+
+1) Water depth relative to transducer, meters
+2) Offset from transducer, meters, positive = distance from transducer to waterline,
+negative = distance from transducer to keel
+3) Maximum range scale in use, meters (NMEA 3.0, optional)
+4) Checksum
+
+Examples:
+$SDDPT,12.6,-1.2*4C
+$IIDPT,4.1,0.5,100.0*43
+*/
+
+// NewGPDPT allocate GPDPT struct for DPT sentence (Depth of water),
+// also used for other talkers (ie: SDDPT, IIDPT)
+func NewGPDPT(m Message) *GPDPT {
+	return &GPDPT{Message: m}
+}
+
+// GPDPT struct
+type GPDPT struct {
+	Message
+
+	Depth    float64  // Water depth relative to transducer in meters
+	Offset   float64  // Offset from transducer in meters, positive to waterline, negative to keel
+	MaxRange *float64 // Maximum range scale in use in meters, nil if not provided
+}
+
+// DepthBelowKeel return water depth below keel in meters, false if offset
+// isn't relative to keel
+func (m GPDPT) DepthBelowKeel() (float64, bool) {
+	if m.Offset >= 0 {
+		return 0, false
+	}
+	return m.Depth + m.Offset, true
+}
+
+// DepthBelowSurface return water depth below surface in meters, false if offset
+// isn't relative to waterline
+func (m GPDPT) DepthBelowSurface() (float64, bool) {
+	if m.Offset <= 0 {
+		return 0, false
+	}
+	return m.Depth + m.Offset, true
+}
+
+func (m *GPDPT) parse() (err error) {
+	if len(m.Fields) != 2 && len(m.Fields) != 3 {
+		return m.Error(fmt.Errorf("Incomplete GPDPT message, not enougth data fields (got: %d, wanted: %d or %d)", len(m.Fields), 2, 3))
+	}
+
+	if m.Depth, err = strconv.ParseFloat(m.Fields[0], 64); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse depth from data field (got: %s)", m.Fields[0]))
+	}
+
+	if len(m.Fields[1]) > 0 {
+		if m.Offset, err = strconv.ParseFloat(m.Fields[1], 64); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse offset from data field (got: %s)", m.Fields[1]))
+		}
+	}
+
+	if len(m.Fields) == 3 {
+		if m.MaxRange, err = parseOptionalFloat(m.Fields[2]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse maximum range from data field (got: %s)", m.Fields[2]))
+		}
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence DPT as string
+func (m GPDPT) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPDPT")
+	fields := make([]string, 0)
+	fields = append(fields,
+		fmt.Sprintf("%.1f", m.Depth),
+		fmt.Sprintf("%.1f", m.Offset))
+
+	if m.MaxRange != nil {
+		fields = append(fields, fmt.Sprintf("%.1f", *m.MaxRange))
+	}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
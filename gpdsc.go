@@ -0,0 +1,430 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+DSC Digital Selective Calling Information
+       1  2          3  4  5  6          7    8          9  10 11
+       |  |          |  |  |  |          |    |          |  | |
+$--DSC,xx,xxxxxxxxxx,xx,xx,xx,xxxxxxxxxx,hhmm,xxxxxxxxxx,xx,a,a*hh
+
+1) Format specifier:
+	02 = geographic area call
+	12 = distress call
+	14 = group call
+	16 = all ships call
+	20 = individual station call
+	23 = individual station automatic call
+2) Address, MMSI followed by a trailing 0 or geographic area
+3) Category, 00 = routine, 08 = safety, 10 = urgency, 12 = distress
+4) Nature of distress or first telecommand
+5) Type of communication or second telecommand
+6) Position (quadrant, latitude ddmm, longitude dddmm) or channel/frequency
+7) Time (hhmm, UTC) or telephone number
+8) MMSI of ship in distress, for distress relays
+9) Nature of distress, for distress relays
+10) Acknowledgement, R = acknowledge request, B = able to acknowledge, S = end of sequence
+11) Expansion indicator, E = followed by a DSE sentence, null otherwise
+12) Checksum
+
+Examples:
+$CDDSC,12,3380400790,12,06,00,1423108312,2019,,,S,E*6A
+$CDDSC,20,2320001230,00,21,26,0000000016,,,,R,*2C
+*/
+
+// NewGPDSC allocate GPDSC struct for DSC sentence (Digital selective calling information),
+// also used for other talkers (ie: CDDSC)
+func NewGPDSC(m Message) *GPDSC {
+	return &GPDSC{Message: m}
+}
+
+// GPDSC struct
+type GPDSC struct {
+	Message
+
+	Format            DSCFormat
+	Address           string // MMSI followed by a trailing 0, or geographic area for area calls
+	Category          DSCCategory
+	FirstTelecommand  string // Nature of distress for distress calls
+	SecondTelecommand string // Type of communication for distress calls
+	PositionOrChannel string // Encoded position for distress calls, channel or frequency otherwise
+	TimeOrPhone       string // Time (hhmm) for distress calls, telephone number otherwise
+	DistressMMSI      string // MMSI of ship in distress for distress relays, empty otherwise
+	DistressNature    string // Nature of distress for distress relays, empty otherwise
+	Acknowledgement   DSCAcknowledgement
+	HasExpansion      bool // True when a DSE expansion sentence follows
+}
+
+func (m *GPDSC) parse() (err error) {
+	if len(m.Fields) != 11 {
+		return m.Error(fmt.Errorf("Incomplete GPDSC message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 11))
+	}
+
+	if m.Format, err = ParseDSCFormat(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse format specifier from data field (got: %s)", m.Fields[0]))
+	}
+
+	m.Address = m.Fields[1]
+
+	if len(m.Fields[2]) > 0 {
+		if m.Category, err = ParseDSCCategory(m.Fields[2]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse category from data field (got: %s)", m.Fields[2]))
+		}
+	}
+
+	m.FirstTelecommand = m.Fields[3]
+	m.SecondTelecommand = m.Fields[4]
+	m.PositionOrChannel = m.Fields[5]
+	m.TimeOrPhone = m.Fields[6]
+	m.DistressMMSI = m.Fields[7]
+	m.DistressNature = m.Fields[8]
+
+	if len(m.Fields[9]) > 0 {
+		if m.Acknowledgement, err = ParseDSCAcknowledgement(m.Fields[9]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse acknowledgement from data field (got: %s)", m.Fields[9]))
+		}
+	}
+
+	switch m.Fields[10] {
+	case "E":
+		m.HasExpansion = true
+	case "":
+	default:
+		return m.Error(fmt.Errorf("Unable to parse expansion indicator from data field (got: %s)", m.Fields[10]))
+	}
+
+	return nil
+}
+
+// IsDistress return true for distress alerts and distress relays
+func (m GPDSC) IsDistress() bool {
+	return m.Format == DistressCall || m.Category == DistressCategory
+}
+
+// MMSI return the MMSI of the called or calling station, return false for
+// geographic area calls or a malformed address
+func (m GPDSC) MMSI() (int, bool) {
+	if m.Format == GeographicAreaCall || len(m.Address) != 10 {
+		return 0, false
+	}
+	mmsi, err := strconv.Atoi(m.Address[:9])
+	if err != nil {
+		return 0, false
+	}
+	return mmsi, true
+}
+
+// NatureOfDistress return the nature of distress of a distress call or relay,
+// return false if not provided
+func (m GPDSC) NatureOfDistress() (DistressNature, bool) {
+	raw := m.DistressNature
+	if m.Format == DistressCall {
+		raw = m.FirstTelecommand
+	}
+	nature, err := ParseDistressNature(raw)
+	return nature, err == nil
+}
+
+// Position return the position encoded in a distress call (quadrant,
+// latitude ddmm and longitude dddmm), return false if not available
+func (m GPDSC) Position() (Position, bool) {
+	raw := m.PositionOrChannel
+	if !m.IsDistress() || len(raw) != 10 || raw == "9999999999" {
+		return Position{}, false
+	}
+
+	v, err := strconv.Atoi(raw)
+	if err != nil || v < 0 {
+		return Position{}, false
+	}
+
+	quadrant := raw[0]
+	latDeg, _ := strconv.Atoi(raw[1:3])
+	latMin, _ := strconv.Atoi(raw[3:5])
+	lonDeg, _ := strconv.Atoi(raw[5:8])
+	lonMin, _ := strconv.Atoi(raw[8:10])
+	if quadrant > '3' || latMin > 59 || lonMin > 59 || float64(latDeg) > MaxLat || float64(lonDeg) > MaxLong {
+		return Position{}, false
+	}
+
+	p := Position{
+		Latitude:  LatLong(float64(latDeg) + float64(latMin)/60),
+		Longitude: LatLong(float64(lonDeg) + float64(lonMin)/60),
+	}
+	// Quadrant: 0 = NE, 1 = NW, 2 = SE, 3 = SW
+	if quadrant == '2' || quadrant == '3' {
+		p.Latitude = -p.Latitude
+	}
+	if quadrant == '1' || quadrant == '3' {
+		p.Longitude = -p.Longitude
+	}
+	return p, true
+}
+
+// Time return the UTC time of the position of a distress call, return false
+// if not available
+func (m GPDSC) Time() (TimeOfDay, bool) {
+	raw := m.TimeOrPhone
+	if !m.IsDistress() || len(raw) != 4 || raw == "8888" {
+		return TimeOfDay{}, false
+	}
+	t, err := ParseTimeOfDay(raw + "00")
+	return t, err == nil
+}
+
+// Serialize return a valid sentence DSC as string
+func (m GPDSC) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPDSC")
+	fields := make([]string, 0)
+	fields = append(fields,
+		m.Format.Serialize(),
+		m.Address,
+		m.Category.Serialize(),
+		m.FirstTelecommand,
+		m.SecondTelecommand,
+		m.PositionOrChannel,
+		m.TimeOrPhone,
+		m.DistressMMSI,
+		m.DistressNature,
+		m.Acknowledgement.Serialize())
+
+	if m.HasExpansion {
+		fields = append(fields, "E")
+	} else {
+		fields = append(fields, "")
+	}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+const (
+	// GeographicAreaCall is a DSCFormat type as string "02"
+	GeographicAreaCall DSCFormat = "02"
+	// DistressCall is a DSCFormat type as string "12"
+	DistressCall DSCFormat = "12"
+	// GroupCall is a DSCFormat type as string "14"
+	GroupCall DSCFormat = "14"
+	// AllShipsCall is a DSCFormat type as string "16"
+	AllShipsCall DSCFormat = "16"
+	// IndividualCall is a DSCFormat type as string "20"
+	IndividualCall DSCFormat = "20"
+	// AutomaticCall is a DSCFormat type as string "23" (individual station automatic call)
+	AutomaticCall DSCFormat = "23"
+)
+
+// DSCFormat type as string, format specifier of a DSC call
+type DSCFormat string
+
+// Serialize return DSCFormat as string
+func (f DSCFormat) Serialize() string {
+	return string(f)
+}
+
+// String return DSCFormat as human string
+func (f DSCFormat) String() string {
+	switch f {
+	case GeographicAreaCall:
+		return "geographic area"
+	case DistressCall:
+		return "distress"
+	case GroupCall:
+		return "group"
+	case AllShipsCall:
+		return "all ships"
+	case IndividualCall:
+		return "individual"
+	case AutomaticCall:
+		return "automatic"
+	default:
+		return "unknow"
+	}
+}
+
+// ParseDSCFormat check DSCFormat validity, return an error
+// "unknow value" if not
+func ParseDSCFormat(raw string) (f DSCFormat, err error) {
+	f = DSCFormat(raw)
+	switch f {
+	case GeographicAreaCall, DistressCall, GroupCall, AllShipsCall, IndividualCall, AutomaticCall:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
+
+const (
+	// RoutineCategory is a DSCCategory type as string "00"
+	RoutineCategory DSCCategory = "00"
+	// SafetyCategory is a DSCCategory type as string "08"
+	SafetyCategory DSCCategory = "08"
+	// UrgencyCategory is a DSCCategory type as string "10"
+	UrgencyCategory DSCCategory = "10"
+	// DistressCategory is a DSCCategory type as string "12"
+	DistressCategory DSCCategory = "12"
+)
+
+// DSCCategory type as string, category of a DSC call
+type DSCCategory string
+
+// Serialize return DSCCategory as string
+func (c DSCCategory) Serialize() string {
+	return string(c)
+}
+
+// String return DSCCategory as human string
+func (c DSCCategory) String() string {
+	switch c {
+	case RoutineCategory:
+		return "routine"
+	case SafetyCategory:
+		return "safety"
+	case UrgencyCategory:
+		return "urgency"
+	case DistressCategory:
+		return "distress"
+	default:
+		return "unknow"
+	}
+}
+
+// ParseDSCCategory check DSCCategory validity, return an error
+// "unknow value" if not
+func ParseDSCCategory(raw string) (c DSCCategory, err error) {
+	c = DSCCategory(raw)
+	switch c {
+	case RoutineCategory, SafetyCategory, UrgencyCategory, DistressCategory:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
+
+const (
+	// FireExplosion is a DistressNature type as string "00"
+	FireExplosion DistressNature = "00"
+	// Flooding is a DistressNature type as string "01"
+	Flooding DistressNature = "01"
+	// Collision is a DistressNature type as string "02"
+	Collision DistressNature = "02"
+	// Grounding is a DistressNature type as string "03"
+	Grounding DistressNature = "03"
+	// Listing is a DistressNature type as string "04" (in danger of capsizing)
+	Listing DistressNature = "04"
+	// Sinking is a DistressNature type as string "05"
+	Sinking DistressNature = "05"
+	// DisabledAdrift is a DistressNature type as string "06"
+	DisabledAdrift DistressNature = "06"
+	// UndesignatedDistress is a DistressNature type as string "07"
+	UndesignatedDistress DistressNature = "07"
+	// AbandoningShip is a DistressNature type as string "08"
+	AbandoningShip DistressNature = "08"
+	// Piracy is a DistressNature type as string "09" (piracy or armed robbery attack)
+	Piracy DistressNature = "09"
+	// ManOverboard is a DistressNature type as string "10"
+	ManOverboard DistressNature = "10"
+	// EPIRBEmission is a DistressNature type as string "12"
+	EPIRBEmission DistressNature = "12"
+)
+
+// DistressNature type as string, nature of distress of a DSC distress call
+type DistressNature string
+
+// Serialize return DistressNature as string
+func (n DistressNature) Serialize() string {
+	return string(n)
+}
+
+// String return DistressNature as human string
+func (n DistressNature) String() string {
+	switch n {
+	case FireExplosion:
+		return "fire, explosion"
+	case Flooding:
+		return "flooding"
+	case Collision:
+		return "collision"
+	case Grounding:
+		return "grounding"
+	case Listing:
+		return "listing, in danger of capsizing"
+	case Sinking:
+		return "sinking"
+	case DisabledAdrift:
+		return "disabled and adrift"
+	case UndesignatedDistress:
+		return "undesignated distress"
+	case AbandoningShip:
+		return "abandoning ship"
+	case Piracy:
+		return "piracy or armed robbery attack"
+	case ManOverboard:
+		return "man overboard"
+	case EPIRBEmission:
+		return "EPIRB emission"
+	default:
+		return "unknow"
+	}
+}
+
+// ParseDistressNature check DistressNature validity, return an error
+// "unknow value" if not
+func ParseDistressNature(raw string) (n DistressNature, err error) {
+	n = DistressNature(raw)
+	switch n {
+	case FireExplosion, Flooding, Collision, Grounding, Listing, Sinking, DisabledAdrift,
+		UndesignatedDistress, AbandoningShip, Piracy, ManOverboard, EPIRBEmission:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
+
+const (
+	// AcknowledgeRequest is a DSCAcknowledgement type as string "R"
+	AcknowledgeRequest DSCAcknowledgement = "R"
+	// AbleToAcknowledge is a DSCAcknowledgement type as string "B"
+	AbleToAcknowledge DSCAcknowledgement = "B"
+	// EndOfSequence is a DSCAcknowledgement type as string "S"
+	EndOfSequence DSCAcknowledgement = "S"
+)
+
+// DSCAcknowledgement type as string
+type DSCAcknowledgement string
+
+// Serialize return DSCAcknowledgement as string
+func (a DSCAcknowledgement) Serialize() string {
+	return string(a)
+}
+
+// String return DSCAcknowledgement as human string
+func (a DSCAcknowledgement) String() string {
+	switch a {
+	case AcknowledgeRequest:
+		return "acknowledge request"
+	case AbleToAcknowledge:
+		return "able to acknowledge"
+	case EndOfSequence:
+		return "end of sequence"
+	default:
+		return "unknow"
+	}
+}
+
+// ParseDSCAcknowledgement check DSCAcknowledgement validity, return an error
+// "unknow value" if not
+func ParseDSCAcknowledgement(raw string) (a DSCAcknowledgement, err error) {
+	a = DSCAcknowledgement(raw)
+	switch a {
+	case AcknowledgeRequest, AbleToAcknowledge, EndOfSequence:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
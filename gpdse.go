@@ -0,0 +1,308 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+DSE Expanded Digital Selective Calling
+       1 2 3 4          5  6    7  8
+       | | | |          |  |    |  |
+$--DSE,x,x,a,xxxxxxxxxx,xx,c--c,..,..*hh
+
+1) Total number of sentences, 1 to 9
+2) Sentence number
+3) Query/reply flag, Q = query, R = reply, A = automatic
+4) Vessel MMSI followed by a trailing 0
+5) Code field:
+	00 = enhanced position resolution
+	01 = source and datum of position
+	02 = current speed of vessel
+	03 = current course of vessel
+	04 = additional station identification
+	05 = enhanced geographic area
+	06 = number of persons on board
+6) Data field
+7) and 8) Other code and data pairs
+9) Checksum
+
+Enhanced position resolution data are the ten-thousandths of minutes of
+latitude (4 digits) followed by those of longitude (4 digits), as a
+complement of the position of the preceding DSC sentence
+
+Examples:
+$CDDSE,1,1,A,3380400790,00,45894494*1B
+$CDDSE,2,1,R,2320001230,00,12345678,01,0001*0F
+$CDDSE,2,2,R,2320001230,06,0012*01
+*/
+
+// NewGPDSE allocate GPDSE struct for DSE sentence (Expanded digital selective calling),
+// also used for other talkers (ie: CDDSE)
+func NewGPDSE(m Message) *GPDSE {
+	return &GPDSE{Message: m}
+}
+
+// GPDSE struct
+type GPDSE struct {
+	Message
+
+	NbOfMessages  int
+	MessageNumber int
+	Flag          DSEFlag
+	Address       string // MMSI followed by a trailing 0
+	Expansions    []DSEExpansion
+}
+
+// DSEExpansion struct is a code and data pair of an expanded DSC call
+type DSEExpansion struct {
+	Code DSECode
+	Data string
+}
+
+func (m *GPDSE) parse() (err error) {
+	if len(m.Fields) < 6 || len(m.Fields)%2 != 0 {
+		return m.Error(fmt.Errorf("Incomplete GPDSE message, not enougth data fields (got: %d, wanted: even number from %d)", len(m.Fields), 6))
+	}
+
+	if m.NbOfMessages, err = strconv.Atoi(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse total number of messages from data field (got: %s)", m.Fields[0]))
+	}
+
+	if m.MessageNumber, err = strconv.Atoi(m.Fields[1]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse message number from data field (got: %s)", m.Fields[1]))
+	}
+
+	if m.Flag, err = ParseDSEFlag(m.Fields[2]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse query/reply flag from data field (got: %s)", m.Fields[2]))
+	}
+
+	m.Address = m.Fields[3]
+
+	m.Expansions = make([]DSEExpansion, 0)
+	for i := 4; i < len(m.Fields); i += 2 {
+		e := DSEExpansion{Data: m.Fields[i+1]}
+		if e.Code, err = ParseDSECode(m.Fields[i]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse expansion code from data field (got: %s)", m.Fields[i]))
+		}
+		m.Expansions = append(m.Expansions, e)
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence DSE as string
+func (m GPDSE) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPDSE")
+	fields := make([]string, 0)
+	fields = append(fields,
+		strconv.Itoa(m.NbOfMessages),
+		strconv.Itoa(m.MessageNumber),
+		m.Flag.Serialize(),
+		m.Address)
+
+	for _, e := range m.Expansions {
+		fields = append(fields, e.Code.Serialize(), e.Data)
+	}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+// DSCCall struct is a DSC sentence completed with the expansions of the
+// following DSE sentences, if any
+type DSCCall struct {
+	GPDSC
+	Expansions []DSEExpansion
+}
+
+// Expansion return the data of the expansion code, return false if not provided
+func (c DSCCall) Expansion(code DSECode) (string, bool) {
+	for _, e := range c.Expansions {
+		if e.Code == code {
+			return e.Data, true
+		}
+	}
+	return "", false
+}
+
+// Position return the position of a distress call, with enhanced resolution
+// when provided by DSE sentence, return false if not available
+func (c DSCCall) Position() (Position, bool) {
+	p, ok := c.GPDSC.Position()
+	if !ok {
+		return p, false
+	}
+
+	data, ok := c.Expansion(EnhancedPositionCode)
+	if !ok || len(data) != 8 {
+		return p, true
+	}
+	lat, err1 := strconv.Atoi(data[:4])
+	lon, err2 := strconv.Atoi(data[4:])
+	if err1 != nil || err2 != nil {
+		return p, true
+	}
+
+	// Add fractional minutes away from equator and prime meridian
+	dLat, dLon := float64(lat)/10000/60, float64(lon)/10000/60
+	if p.Latitude < 0 {
+		dLat = -dLat
+	}
+	if p.Longitude < 0 {
+		dLon = -dLon
+	}
+	p.Latitude += LatLong(dLat)
+	p.Longitude += LatLong(dLon)
+	return p, true
+}
+
+// DSCAssembler struct pairs DSC sentences with their following DSE sentences
+type DSCAssembler struct {
+	pending *DSCCall
+	seq     int
+}
+
+// NewDSCAssembler allocate DSCAssembler struct
+func NewDSCAssembler() *DSCAssembler {
+	return &DSCAssembler{}
+}
+
+// Add feed DSCAssembler with a message, other messages than DSC and DSE are
+// ignored. Return the call once DSC sentence and all its DSE sentences have
+// been received
+func (a *DSCAssembler) Add(msg NMEA) (*DSCCall, error) {
+	switch m := msg.(type) {
+	case *GPDSC:
+		if a.pending != nil {
+			a.pending = nil
+			return nil, m.Error(fmt.Errorf("Missing DSE message before DSC message"))
+		}
+		call := &DSCCall{GPDSC: *m, Expansions: make([]DSEExpansion, 0)}
+		if !m.HasExpansion {
+			return call, nil
+		}
+		a.pending, a.seq = call, 0
+	case *GPDSE:
+		if a.pending == nil || m.MessageNumber != a.seq+1 || m.Address != a.pending.Address {
+			a.pending = nil
+			return nil, m.Error(fmt.Errorf("Out of sequence DSE message (got: %d/%d)", m.MessageNumber, m.NbOfMessages))
+		}
+		a.seq = m.MessageNumber
+		a.pending.Expansions = append(a.pending.Expansions, m.Expansions...)
+
+		if m.MessageNumber < m.NbOfMessages {
+			return nil, nil
+		}
+		call := a.pending
+		a.pending = nil
+		return call, nil
+	}
+	return nil, nil
+}
+
+const (
+	// QueryFlag is a DSEFlag type as string "Q"
+	QueryFlag DSEFlag = "Q"
+	// ReplyFlag is a DSEFlag type as string "R"
+	ReplyFlag DSEFlag = "R"
+	// AutomaticFlag is a DSEFlag type as string "A"
+	AutomaticFlag DSEFlag = "A"
+)
+
+// DSEFlag type as string, query/reply flag of a DSE sentence
+type DSEFlag string
+
+// Serialize return DSEFlag as string
+func (f DSEFlag) Serialize() string {
+	return string(f)
+}
+
+// String return DSEFlag as human string
+func (f DSEFlag) String() string {
+	switch f {
+	case QueryFlag:
+		return "query"
+	case ReplyFlag:
+		return "reply"
+	case AutomaticFlag:
+		return "automatic"
+	default:
+		return "unknow"
+	}
+}
+
+// ParseDSEFlag check DSEFlag validity, return an error
+// "unknow value" if not
+func ParseDSEFlag(raw string) (f DSEFlag, err error) {
+	f = DSEFlag(raw)
+	switch f {
+	case QueryFlag, ReplyFlag, AutomaticFlag:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
+
+const (
+	// EnhancedPositionCode is a DSECode type as string "00"
+	EnhancedPositionCode DSECode = "00"
+	// PositionSourceCode is a DSECode type as string "01" (source and datum of position)
+	PositionSourceCode DSECode = "01"
+	// SpeedCode is a DSECode type as string "02"
+	SpeedCode DSECode = "02"
+	// CourseCode is a DSECode type as string "03"
+	CourseCode DSECode = "03"
+	// StationIDCode is a DSECode type as string "04" (additional station identification)
+	StationIDCode DSECode = "04"
+	// EnhancedAreaCode is a DSECode type as string "05"
+	EnhancedAreaCode DSECode = "05"
+	// PersonsOnBoardCode is a DSECode type as string "06"
+	PersonsOnBoardCode DSECode = "06"
+)
+
+// DSECode type as string, code of an expanded DSC data
+type DSECode string
+
+// Serialize return DSECode as string
+func (c DSECode) Serialize() string {
+	return string(c)
+}
+
+// String return DSECode as human string
+func (c DSECode) String() string {
+	switch c {
+	case EnhancedPositionCode:
+		return "enhanced position"
+	case PositionSourceCode:
+		return "position source"
+	case SpeedCode:
+		return "speed"
+	case CourseCode:
+		return "course"
+	case StationIDCode:
+		return "station identification"
+	case EnhancedAreaCode:
+		return "enhanced geographic area"
+	case PersonsOnBoardCode:
+		return "persons on board"
+	default:
+		return "unknow"
+	}
+}
+
+// ParseDSECode check DSECode validity, return an error
+// "unknow value" if not
+func ParseDSECode(raw string) (c DSECode, err error) {
+	c = DSECode(raw)
+	switch c {
+	case EnhancedPositionCode, PositionSourceCode, SpeedCode, CourseCode,
+		StationIDCode, EnhancedAreaCode, PersonsOnBoardCode:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
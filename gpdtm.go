@@ -0,0 +1,140 @@
+package nmea
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+/*
+DTM Datum Reference
+       1   2 3   4 5   6 7   8
+       |   | |   | |   | |   |
+$--DTM,ccc,a,x.x,a,x.x,a,x.x,ccc*hh
+
+1) Local datum code (W84, W72, S85, P90, 999 - user defined, or IHO datum code)
+2) Local datum subdivision code
+3) Latitude offset, minutes
+4) N or S (North or South)
+5) Longitude offset, minutes
+6) E or W (East or West)
+7) Altitude offset, meters
+8) Reference datum code (W84, W72, S85, P90)
+9) Checksum
+
+Examples:
+$GPDTM,999,,0.08,N,0.07,E,-47.7,W84*1B
+$GPDTM,W84,,0.00,N,0.00,E,0.0,W84*6F
+*/
+
+const (
+	// DatumWGS84 is the datum code of WGS 84
+	DatumWGS84 = "W84"
+	// DatumWGS72 is the datum code of WGS 72
+	DatumWGS72 = "W72"
+	// DatumSGS85 is the datum code of SGS 85
+	DatumSGS85 = "S85"
+	// DatumPE90 is the datum code of PE 90
+	DatumPE90 = "P90"
+	// DatumUserDefined is the datum code of user defined datum
+	DatumUserDefined = "999"
+)
+
+// NewGPDTM allocate GPDTM struct for DTM sentence (Datum reference),
+// also used for other talkers (ie: GNDTM)
+func NewGPDTM(m Message) *GPDTM {
+	return &GPDTM{Message: m}
+}
+
+// GPDTM struct
+type GPDTM struct {
+	Message
+
+	LocalDatum      string
+	Subdivision     string
+	LatitudeOffset  float64 // In minutes, negative = South
+	LongitudeOffset float64 // In minutes, negative = West
+	AltitudeOffset  float64 // In meters
+	ReferenceDatum  string
+}
+
+func (m *GPDTM) parse() (err error) {
+	if len(m.Fields) != 8 {
+		return m.Error(fmt.Errorf("Incomplete GPDTM message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 8))
+	}
+
+	m.LocalDatum, m.Subdivision, m.ReferenceDatum = m.Fields[0], m.Fields[1], m.Fields[7]
+
+	if m.LatitudeOffset, err = parseSignedOffset(m.Fields[2], m.Fields[3], North, South); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse latitude offset from data field (got: %s,%s)", m.Fields[2], m.Fields[3]))
+	}
+
+	if m.LongitudeOffset, err = parseSignedOffset(m.Fields[4], m.Fields[5], East, West); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse longitude offset from data field (got: %s,%s)", m.Fields[4], m.Fields[5]))
+	}
+
+	if len(m.Fields[6]) > 0 {
+		if m.AltitudeOffset, err = strconv.ParseFloat(m.Fields[6], 64); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse altitude offset from data field (got: %s)", m.Fields[6]))
+		}
+	}
+
+	return nil
+}
+
+// IsWGS84 return true if positions are provided in WGS 84 datum without offset
+func (m GPDTM) IsWGS84() bool {
+	return m.LocalDatum == DatumWGS84 && m.LatitudeOffset == 0 && m.LongitudeOffset == 0 && m.AltitudeOffset == 0
+}
+
+// Shift return position p (provided in local datum) shifted into reference datum
+func (m GPDTM) Shift(p Position) Position {
+	return Position{
+		Latitude:  p.Latitude + LatLong(m.LatitudeOffset/60),
+		Longitude: p.Longitude + LatLong(m.LongitudeOffset/60),
+	}
+}
+
+// Serialize return a valid sentence DTM as string
+func (m GPDTM) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPDTM")
+	latDir, lonDir := North, East
+	if m.LatitudeOffset < 0 {
+		latDir = South
+	}
+	if m.LongitudeOffset < 0 {
+		lonDir = West
+	}
+
+	fields := make([]string, 0)
+	fields = append(fields, m.LocalDatum, m.Subdivision,
+		fmt.Sprintf("%.2f", math.Abs(m.LatitudeOffset)), latDir.String(),
+		fmt.Sprintf("%.2f", math.Abs(m.LongitudeOffset)), lonDir.String(),
+		fmt.Sprintf("%.1f", m.AltitudeOffset),
+		m.ReferenceDatum)
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+// parseSignedOffset return value signed according to its direction field
+func parseSignedOffset(value, dir string, positive, negative CardinalPoint) (float64, error) {
+	if len(value) == 0 {
+		return 0, nil
+	}
+
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	switch CardinalPoint(dir) {
+	case positive:
+		return v, nil
+	case negative:
+		return -v, nil
+	}
+	return 0, fmt.Errorf("Wrong direction (got: %s)", dir)
+}
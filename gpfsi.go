@@ -0,0 +1,246 @@
+package nmea
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+/*
+FSI Frequency Set Information
+       1      2      3 4 5
+       |      |      | | |
+$--FSI,xxxxxx,xxxxxx,c,x,a*hh
+
+1) Transmitting frequency, 100 Hz
+2) Receiving frequency, 100 Hz
+3) Mode of operation:
+	d = F3E/G3E simplex, telephone
+	e = F3E/G3E duplex, telephone
+	m = J3E, telephone
+	o = H3E, telephone
+	q = F1B/J2B FEC NBDP, telex/teleprinter
+	s = F1B/J2B ARQ NBDP, telex/teleprinter
+	t = F1B/J2B receive only, teleprinter/DSC
+	w = F1B/J2B, teleprinter/DSC
+	x = A1A Morse, tape recorder
+	{ = A1A Morse, morse key/head set
+	| = F1C/F2C/F3C, FAX-machine
+4) Power level, 0 = standby, 1 = lowest to 9 = highest
+5) Sentence status flag (NMEA 3.0 and later):
+	R = report of current settings
+	C = configuration command to change settings
+6) Checksum
+
+Examples:
+$CTFSI,020230,026140,m,0*14
+$CTFSI,021820,021820,d,5,R*64
+$GPFSI,,021750,|,9,C*60
+*/
+
+// NewGPFSI allocate GPFSI struct for FSI sentence (frequency set information),
+// also used for other talkers (ie: CTFSI)
+func NewGPFSI(m Message) *GPFSI {
+	return &GPFSI{Message: m}
+}
+
+// GPFSI struct
+type GPFSI struct {
+	Message
+
+	TransmitFrequency *float64 // Transmitting frequency in kHz, nil if not provided
+	ReceiveFrequency  *float64 // Receiving frequency in kHz, nil if not provided
+	Mode              OperationMode
+	PowerLevel        int            // 0 for standby, 1 (lowest) to 9 (highest)
+	Status            SentenceStatus // Empty if not provided (before NMEA 3.0)
+}
+
+func (m *GPFSI) parse() (err error) {
+	if len(m.Fields) != 4 && len(m.Fields) != 5 {
+		return m.Error(fmt.Errorf("Incomplete GPFSI message, not enougth data fields (got: %d, wanted: %d or %d)", len(m.Fields), 4, 5))
+	}
+
+	if m.TransmitFrequency, err = parseFrequency(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse transmitting frequency from data field (got: %s)", m.Fields[0]))
+	}
+
+	if m.ReceiveFrequency, err = parseFrequency(m.Fields[1]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse receiving frequency from data field (got: %s)", m.Fields[1]))
+	}
+
+	if m.Mode, err = ParseOperationMode(m.Fields[2]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse mode of operation from data field (got: %s)", m.Fields[2]))
+	}
+
+	if m.PowerLevel, err = strconv.Atoi(m.Fields[3]); err != nil || m.PowerLevel < 0 || m.PowerLevel > 9 {
+		return m.Error(fmt.Errorf("Unable to parse power level from data field (got: %s)", m.Fields[3]))
+	}
+
+	if len(m.Fields) == 5 && len(m.Fields[4]) > 0 {
+		if m.Status, err = ParseSentenceStatus(m.Fields[4]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse sentence status flag from data field (got: %s)", m.Fields[4]))
+		}
+	}
+
+	return nil
+}
+
+// parseFrequency return frequency in kHz from data field in units of 100 Hz,
+// nil for an empty data field
+func parseFrequency(raw string) (*float64, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	v, err := strconv.ParseUint(raw, 10, 32)
+	if err != nil {
+		return nil, err
+	}
+	khz := float64(v) / 10
+	return &khz, nil
+}
+
+// formatFrequency return frequency in kHz as data field in units of 100 Hz,
+// an empty data field for nil value
+func formatFrequency(khz *float64) string {
+	if khz == nil {
+		return ""
+	}
+	return fmt.Sprintf("%06d", int64(math.Round(*khz*10)))
+}
+
+// Serialize return a valid sentence FSI as string
+func (m GPFSI) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPFSI")
+	fields := make([]string, 0)
+	fields = append(fields,
+		formatFrequency(m.TransmitFrequency),
+		formatFrequency(m.ReceiveFrequency),
+		m.Mode.Serialize(),
+		strconv.Itoa(m.PowerLevel))
+
+	if len(m.Status) > 0 {
+		fields = append(fields, m.Status.Serialize())
+	}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+const (
+	// SimplexTelephone is a OperationMode type as string "d" (F3E/G3E simplex)
+	SimplexTelephone OperationMode = "d"
+	// DuplexTelephone is a OperationMode type as string "e" (F3E/G3E duplex)
+	DuplexTelephone OperationMode = "e"
+	// SSBTelephone is a OperationMode type as string "m" (J3E)
+	SSBTelephone OperationMode = "m"
+	// AMTelephone is a OperationMode type as string "o" (H3E)
+	AMTelephone OperationMode = "o"
+	// FECTelex is a OperationMode type as string "q" (F1B/J2B FEC NBDP)
+	FECTelex OperationMode = "q"
+	// ARQTelex is a OperationMode type as string "s" (F1B/J2B ARQ NBDP)
+	ARQTelex OperationMode = "s"
+	// ReceiveOnlyTeleprinter is a OperationMode type as string "t" (F1B/J2B receive only)
+	ReceiveOnlyTeleprinter OperationMode = "t"
+	// Teleprinter is a OperationMode type as string "w" (F1B/J2B)
+	Teleprinter OperationMode = "w"
+	// MorseTapeRecorder is a OperationMode type as string "x" (A1A)
+	MorseTapeRecorder OperationMode = "x"
+	// MorseKey is a OperationMode type as string "{" (A1A)
+	MorseKey OperationMode = "{"
+	// Facsimile is a OperationMode type as string "|" (F1C/F2C/F3C)
+	Facsimile OperationMode = "|"
+)
+
+// OperationMode type as string, mode of operation of a radio
+type OperationMode string
+
+// Serialize return OperationMode as string
+func (o OperationMode) Serialize() string {
+	return string(o)
+}
+
+// String return OperationMode as human string
+func (o OperationMode) String() string {
+	switch o {
+	case SimplexTelephone:
+		return "simplex telephone"
+	case DuplexTelephone:
+		return "duplex telephone"
+	case SSBTelephone:
+		return "SSB telephone"
+	case AMTelephone:
+		return "AM telephone"
+	case FECTelex:
+		return "FEC telex"
+	case ARQTelex:
+		return "ARQ telex"
+	case ReceiveOnlyTeleprinter:
+		return "receive only teleprinter"
+	case Teleprinter:
+		return "teleprinter"
+	case MorseTapeRecorder:
+		return "morse tape recorder"
+	case MorseKey:
+		return "morse key"
+	case Facsimile:
+		return "facsimile"
+	default:
+		return "unknow"
+	}
+}
+
+// ParseOperationMode check OperationMode validity, return an error
+// "unknow value" if not
+func ParseOperationMode(raw string) (o OperationMode, err error) {
+	o = OperationMode(raw)
+	switch o {
+	case SimplexTelephone, DuplexTelephone, SSBTelephone, AMTelephone, FECTelex, ARQTelex,
+		ReceiveOnlyTeleprinter, Teleprinter, MorseTapeRecorder, MorseKey, Facsimile:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
+
+const (
+	// ReportStatus is a SentenceStatus type as string "R"
+	ReportStatus SentenceStatus = "R"
+	// CommandStatus is a SentenceStatus type as string "C"
+	CommandStatus SentenceStatus = "C"
+)
+
+// SentenceStatus type as string, tell if a sentence reports current settings
+// or commands a change of settings
+type SentenceStatus string
+
+// Serialize return SentenceStatus as string
+func (s SentenceStatus) Serialize() string {
+	return string(s)
+}
+
+// String return SentenceStatus as human string
+func (s SentenceStatus) String() string {
+	switch s {
+	case ReportStatus:
+		return "report"
+	case CommandStatus:
+		return "command"
+	default:
+		return "unknow"
+	}
+}
+
+// ParseSentenceStatus check SentenceStatus validity, return an error
+// "unknow value" if not
+func ParseSentenceStatus(raw string) (s SentenceStatus, err error) {
+	s = SentenceStatus(raw)
+	switch s {
+	case ReportStatus, CommandStatus:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
@@ -0,0 +1,98 @@
+package nmea
+
+import "fmt"
+
+/*
+GBS GNSS Satellite Fault Detection (RAIM)
+       1         2   3   4   5  6   7   8   9 10
+       |         |   |   |   |  |   |   |   | |
+$--GBS,hhmmss.ss,x.x,x.x,x.x,xx,x.x,x.x,x.x,h,h*hh
+
+1) Time (UTC)
+2) Expected error in latitude, meters
+3) Expected error in longitude, meters
+4) Expected error in altitude, meters
+5) ID number of most likely failed satellite
+6) Probability of missed detection for most likely failed satellite
+7) Estimate of bias on most likely failed satellite, meters
+8) Standard deviation of bias estimate
+9) System ID (NMEA 4.1, optional)
+10) Signal ID (NMEA 4.1, optional)
+11) Checksum
+
+Examples:
+$GPGBS,015509.00,-0.031,-0.186,0.219,19,0.000,-0.354,6.972*4D
+$GNGBS,170556.00,3.023,2.296,4.533,,,,,1,1*53
+*/
+
+// NewGPGBS allocate GPGBS struct for GBS sentence (GNSS satellite fault detection),
+// also used for other talkers (ie: GNGBS)
+func NewGPGBS(m Message) *GPGBS {
+	return &GPGBS{Message: m}
+}
+
+// GPGBS struct
+type GPGBS struct {
+	Message
+
+	TimeUTC         TimeOfDay // Time UTC data field, without date
+	LatitudeError   *float64  // Expected error in latitude in meters
+	LongitudeError  *float64  // Expected error in longitude in meters
+	AltitudeError   *float64  // Expected error in altitude in meters
+	FailedSatellite string    // ID of most likely failed satellite, empty if none
+	MissProbability *float64  // Probability of missed detection for most likely failed satellite
+	Bias            *float64  // Estimate of bias on most likely failed satellite in meters
+	BiasStdDev      *float64  // Standard deviation of bias estimate
+	SystemID        string    // NMEA 4.1, empty if not provided
+	SignalID        string    // NMEA 4.1, empty if not provided
+
+	hasSystemSignalID bool // NMEA 4.1 fields are present, even if empty
+}
+
+func (m *GPGBS) parse() (err error) {
+	if len(m.Fields) != 8 && len(m.Fields) != 10 {
+		return m.Error(fmt.Errorf("Incomplete GPGBS message, not enougth data fields (got: %d, wanted: %d or %d)", len(m.Fields), 8, 10))
+	}
+
+	if m.TimeUTC, err = ParseTimeOfDay(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse time UTC from data field (got: %s)", m.Fields[0]))
+	}
+
+	for i, v := range map[int]**float64{1: &m.LatitudeError, 2: &m.LongitudeError, 3: &m.AltitudeError, 5: &m.MissProbability, 6: &m.Bias, 7: &m.BiasStdDev} {
+		if *v, err = parseOptionalFloat(m.Fields[i]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse data field %d (got: %s)", i+1, m.Fields[i]))
+		}
+	}
+
+	m.FailedSatellite = m.Fields[4]
+
+	if len(m.Fields) == 10 {
+		m.SystemID, m.SignalID, m.hasSystemSignalID = m.Fields[8], m.Fields[9], true
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence GBS as string
+func (m GPGBS) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPGBS")
+	fields := make([]string, 0)
+	fields = append(fields, m.TimeUTC.Serialize(),
+		formatOptionalFloat(m.LatitudeError, "%.3f"),
+		formatOptionalFloat(m.LongitudeError, "%.3f"),
+		formatOptionalFloat(m.AltitudeError, "%.3f"),
+		m.FailedSatellite,
+		formatOptionalFloat(m.MissProbability, "%.3f"),
+		formatOptionalFloat(m.Bias, "%.3f"),
+		formatOptionalFloat(m.BiasStdDev, "%.3f"))
+
+	if m.hasSystemSignalID || len(m.SystemID) > 0 || len(m.SignalID) > 0 {
+		fields = append(fields, m.SystemID, m.SignalID)
+	}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
-	"time"
 )
 
 /*
@@ -50,7 +49,7 @@ func NewGPGGA(m Message) *GPGGA {
 type GPGGA struct {
 	Message
 
-	TimeUTC            time.Time // Aggregation of TimeUTC data field
+	TimeUTC            TimeOfDay // Time UTC data field, without date
 	Latitude           LatLong   // In decimal format
 	Longitude          LatLong   // In decimal format
 	QualityIndicator   QualityIndicator
@@ -78,7 +77,7 @@ func (m *GPGGA) parse() (err error) {
 		}
 	}
 
-	if m.TimeUTC, err = time.Parse("150405.000", m.Fields[0]); err != nil {
+	if m.TimeUTC, err = ParseTimeOfDay(m.Fields[0]); err != nil {
 		return m.Error(fmt.Errorf("Unable to parse time UTC from data field (got: %s)", m.Fields[0]))
 	}
 
@@ -153,7 +152,7 @@ func (m GPGGA) Serialize() string { // Implement NMEA interface
 	fields := make([]string, 0)
 	////////
 	//fmt.Printf("Lat: %s Lon: %s\n", m.Latitude.ToDM(), m.Longitude.ToDM())
-	fields = append(fields, m.TimeUTC.Format("150405.000"),
+	fields = append(fields, m.TimeUTC.Serialize(),
 		strings.Trim(m.Latitude.ToDM(), "0"), m.Latitude.CardinalPoint(true).String(),
 		strings.Trim(m.Longitude.ToDM(), "0"), m.Longitude.CardinalPoint(false).String(),
 		strconv.Itoa(int(m.QualityIndicator)),
@@ -215,6 +214,18 @@ const (
 	GNSSS
 	// DGPS const as 2
 	DGPS
+	// PPS const as 3
+	PPS
+	// RTKFixed const as 4
+	RTKFixed
+	// RTKFloat const as 5
+	RTKFloat
+	// Estimated const as 6 (dead reckoning)
+	Estimated
+	// ManualInput const as 7
+	ManualInput
+	// Simulation const as 8
+	Simulation
 )
 
 // QualityIndicator type as int
@@ -229,12 +240,44 @@ func (s QualityIndicator) String() string {
 		return "GNSS fix"
 	case DGPS:
 		return "DGPS fix"
+	case PPS:
+		return "PPS fix"
+	case RTKFixed:
+		return "RTK fixed"
+	case RTKFloat:
+		return "RTK float"
+	case Estimated:
+		return "estimated"
+	case ManualInput:
+		return "manual input"
+	case Simulation:
+		return "simulation"
 	default:
 		return "unknow"
 
 	}
 }
 
+// Rank return QualityIndicator rank to compare accuracy of fixes, higher is better
+func (s QualityIndicator) Rank() int {
+	switch s {
+	case RTKFixed:
+		return 6
+	case RTKFloat:
+		return 5
+	case DGPS, PPS:
+		return 4
+	case GNSSS:
+		return 3
+	case Estimated:
+		return 2
+	case ManualInput, Simulation:
+		return 1
+	default:
+		return 0
+	}
+}
+
 // ParseQualityIndicator check QualityIndicator validity, return an error
 // "unknow value" if not
 func ParseQualityIndicator(raw string) (qi QualityIndicator, err error) {
@@ -245,7 +288,7 @@ func ParseQualityIndicator(raw string) (qi QualityIndicator, err error) {
 
 	qi = QualityIndicator(i)
 	switch qi {
-	case InvalidIndicator, GNSSS, DGPS:
+	case InvalidIndicator, GNSSS, DGPS, PPS, RTKFixed, RTKFloat, Estimated, ManualInput, Simulation:
 	default:
 		err = fmt.Errorf("unknow value")
 	}
@@ -0,0 +1,155 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+GLC Geographic Position, Loran-C
+       1    2   3 4   5 6   7 8   9 10  11 12  13 14
+       |    |   | |   | |   | |   | |   |  |   |  |
+$--GLC,xxxx,x.x,a,x.x,a,x.x,a,x.x,a,x.x,a,x.x,a*hh
+
+1) GRI, Group Repetition Interval, tens of microseconds
+2) Master TOA, Time Of Arrival, microseconds
+3) Master signal status
+4) Time difference 1, microseconds
+5) Signal status 1
+6) Time difference 2, microseconds
+7) Signal status 2
+8) Time difference 3, microseconds
+9) Signal status 3
+10) Time difference 4, microseconds
+11) Signal status 4
+12) Time difference 5, microseconds
+13) Signal status 5
+14) Checksum
+
+Signal status: B = Blink warning, C = Cycle warning, S = SNR warning, A = Valid
+
+Examples:
+$LCGLC,9960,15920.5,A,26745.7,A,42011.3,B,,,,,,*0B
+$LCGLC,7980,14162.8,A,42011.3,C,58993.1,S,12345.6,A,23456.7,A,34567.8,A*72
+*/
+
+// NewGPGLC allocate GPGLC struct for GLC sentence (Geographic position, Loran-C),
+// also used for other talkers (ie: LCGLC)
+func NewGPGLC(m Message) *GPGLC {
+	return &GPGLC{Message: m}
+}
+
+// GPGLC struct
+type GPGLC struct {
+	Message
+
+	GRI             int            // Group repetition interval in tens of microseconds
+	Master          LoranSignal    // Master time of arrival
+	TimeDifferences [5]LoranSignal // Time differences of secondaries
+}
+
+// LoranSignal struct is a Loran-C measure in microseconds with its signal status
+type LoranSignal struct {
+	Value  *float64          // Measure in microseconds, nil if not provided
+	Status LoranSignalStatus // Empty if not provided
+}
+
+func (m *GPGLC) parse() (err error) {
+	if len(m.Fields) != 13 {
+		return m.Error(fmt.Errorf("Incomplete GPGLC message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 13))
+	}
+
+	if m.GRI, err = strconv.Atoi(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse GRI from data field (got: %s)", m.Fields[0]))
+	}
+
+	if m.Master, err = parseLoranSignal(m.Fields[1], m.Fields[2]); err != nil {
+		return m.Error(err)
+	}
+
+	for i := range m.TimeDifferences {
+		if m.TimeDifferences[i], err = parseLoranSignal(m.Fields[3+2*i], m.Fields[4+2*i]); err != nil {
+			return m.Error(err)
+		}
+	}
+
+	return nil
+}
+
+func parseLoranSignal(value, status string) (s LoranSignal, err error) {
+	if s.Value, err = parseOptionalFloat(value); err != nil {
+		return s, fmt.Errorf("Unable to parse time difference from data field (got: %s)", value)
+	}
+
+	if len(status) > 0 {
+		if s.Status, err = ParseLoranSignalStatus(status); err != nil {
+			return s, fmt.Errorf("Unable to parse signal status from data field (got: %s)", status)
+		}
+	}
+
+	return s, nil
+}
+
+// Serialize return a valid sentence GLC as string
+func (m GPGLC) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPGLC")
+	fields := make([]string, 0)
+	fields = append(fields, fmt.Sprintf("%04d", m.GRI))
+
+	for _, s := range append([]LoranSignal{m.Master}, m.TimeDifferences[:]...) {
+		fields = append(fields, formatOptionalFloat(s.Value, "%.1f"), s.Status.Serialize())
+	}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+const (
+	// SignalValid is a LoranSignalStatus type as string "A"
+	SignalValid LoranSignalStatus = "A"
+	// SignalBlinkWarning is a LoranSignalStatus type as string "B"
+	SignalBlinkWarning LoranSignalStatus = "B"
+	// SignalCycleWarning is a LoranSignalStatus type as string "C"
+	SignalCycleWarning LoranSignalStatus = "C"
+	// SignalSNRWarning is a LoranSignalStatus type as string "S"
+	SignalSNRWarning LoranSignalStatus = "S"
+)
+
+// LoranSignalStatus type as string
+type LoranSignalStatus string
+
+// Serialize return LoranSignalStatus as string
+func (s LoranSignalStatus) Serialize() string {
+	return string(s)
+}
+
+// String return LoranSignalStatus as human string
+func (s LoranSignalStatus) String() string {
+	switch s {
+	case SignalValid:
+		return "valid"
+	case SignalBlinkWarning:
+		return "blink warning"
+	case SignalCycleWarning:
+		return "cycle warning"
+	case SignalSNRWarning:
+		return "SNR warning"
+	default:
+		return "unknow"
+	}
+}
+
+// ParseLoranSignalStatus check LoranSignalStatus validity, return an error
+// "unknow value" if not
+func ParseLoranSignalStatus(raw string) (s LoranSignalStatus, err error) {
+	s = LoranSignalStatus(raw)
+	switch s {
+	case SignalValid, SignalBlinkWarning, SignalCycleWarning, SignalSNRWarning:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
@@ -3,7 +3,6 @@ package nmea
 import (
 	"fmt"
 	"strings"
-	"time"
 )
 
 /*
@@ -34,7 +33,7 @@ func NewGPGLL(m Message) *GPGLL {
 type GPGLL struct {
 	Message
 
-	TimeUTC             time.Time // Aggregation of TimeUTC data field
+	TimeUTC             TimeOfDay // Time UTC data field, without date
 	Latitude, Longitude LatLong   // In decimal format
 	IsValid             DataValid
 	PositioningMode     PositioningMode
@@ -57,7 +56,7 @@ func (m *GPGLL) parse() (err error) {
 		}
 	}
 
-	if m.TimeUTC, err = time.Parse("150405.000", m.Fields[4]); err != nil {
+	if m.TimeUTC, err = ParseTimeOfDay(m.Fields[4]); err != nil {
 		return m.Error(fmt.Errorf("Unable to parse time UTC from data field (got: %s)", m.Fields[4]))
 	}
 
@@ -76,10 +75,11 @@ func (m GPGLL) Serialize() string { // Implement NMEA interface
 	hdr := TypeIDs["GPGLL"]
 	fields := make([]string, 0)
 	fields = append(fields,
-		m.TimeUTC.Format("150405.000"),
 		strings.Trim(m.Latitude.ToDM(), "0"), m.Latitude.CardinalPoint(true).String(),
 		strings.Trim(m.Longitude.ToDM(), "0"), m.Longitude.CardinalPoint(false).String(),
-		string(m.IsValid.Serialize()))
+		m.TimeUTC.Serialize(),
+		m.IsValid.Serialize(),
+		m.PositioningMode.Serialize())
 	msg := Message{Type: hdr, Fields: fields}
 	msg.Checksum = msg.ComputeChecksum()
 
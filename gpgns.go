@@ -0,0 +1,209 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+/*
+GNS GNSS fix data. Time, Position and fix related data for single or combined
+satellite navigation systems
+       1         2       3 4        5 6    7  8   9   10  11  12   13
+       |         |       | |        | |    |  |   |   |   |   |    |
+$--GNS,hhmmss.ss,llll.ll,a,yyyyy.yy,a,c--c,xx,x.x,x.x,x.x,x.x,xxxx,a*hh
+
+1) Time (UTC)
+2) Latitude
+3) N or S (North or South)
+4) Longitude
+5) E or W (East or West)
+6) Mode indicator, one character per constellation (GPS, GLONASS, Galileo, BeiDou...)
+   N - No fix, A - Autonomous, D - Differential, P - Precise, R - RTK fixed,
+   F - RTK float, E - Estimated, M - Manual input, S - Simulator
+7) Total number of satellites in use, 00-99
+8) HDOP
+9) Antenna altitude, meters, re: mean-sea-level (geoid)
+10) Geoidal separation, meters
+11) Age of differential data
+12) Differential reference station ID
+13) Navigational status (NMEA 4.1, optional): S - Safe, C - Caution, U - Unsafe, V - Not valid
+14) Checksum
+
+Examples:
+$GNGNS,014035.00,4332.69262,S,17235.48549,E,RR,13,0.9,25.63,11.24,,*70
+$GNGNS,112257.00,3844.24011,N,00908.43828,W,AN,03,10.5,,,,,V*33
+*/
+
+// NewGPGNS allocate GPGNS struct for GNS sentence (GNSS fix data),
+// also used for other talkers (ie: GNGNS)
+func NewGPGNS(m Message) *GPGNS {
+	return &GPGNS{Message: m}
+}
+
+// GPGNS struct
+type GPGNS struct {
+	Message
+
+	TimeUTC            TimeOfDay         // Time UTC data field, without date
+	Latitude           LatLong           // In decimal format
+	Longitude          LatLong           // In decimal format
+	Modes              []PositioningMode // One mode by constellation
+	NbOfSatellitesUsed int
+	HDOP               *float64
+	Altitude           *float64
+	GeoIDSep           *float64
+	DGPSAge            *float64
+	DGPSStationID      string
+	NavigationalStatus NavigationalStatus // Empty if not provided (before NMEA 4.1)
+}
+
+func (m *GPGNS) parse() (err error) {
+	if len(m.Fields) != 12 && len(m.Fields) != 13 {
+		return m.Error(fmt.Errorf("Incomplete GPGNS message, not enougth data fields (got: %d, wanted: %d or %d)", len(m.Fields), 12, 13))
+	}
+
+	if m.TimeUTC, err = ParseTimeOfDay(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse time UTC from data field (got: %s)", m.Fields[0]))
+	}
+
+	if latitude := strings.TrimSpace(strings.Join(m.Fields[1:3], " ")); len(latitude) > 0 {
+		if m.Latitude, err = NewLatLong(latitude); err != nil {
+			return m.Error(err)
+		}
+	}
+
+	if longitude := strings.TrimSpace(strings.Join(m.Fields[3:5], " ")); len(longitude) > 0 {
+		if m.Longitude, err = NewLatLong(longitude); err != nil {
+			return m.Error(err)
+		}
+	}
+
+	m.Modes = make([]PositioningMode, 0, len(m.Fields[5]))
+	for _, c := range m.Fields[5] {
+		mode, err := ParsePositioningMode(string(c))
+		if err != nil {
+			return m.Error(fmt.Errorf("Unable to parse mode indicator from data field (got: %s)", m.Fields[5]))
+		}
+		m.Modes = append(m.Modes, mode)
+	}
+
+	if m.NbOfSatellitesUsed, err = strconv.Atoi(m.Fields[6]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse number of satellites from data field (got: %s)", m.Fields[6]))
+	}
+
+	for i, v := range map[int]**float64{7: &m.HDOP, 8: &m.Altitude, 9: &m.GeoIDSep, 10: &m.DGPSAge} {
+		if len(m.Fields[i]) > 0 {
+			f, err := strconv.ParseFloat(m.Fields[i], 64)
+			if err != nil {
+				return m.Error(fmt.Errorf("Unable to parse data field %d (got: %s)", i+1, m.Fields[i]))
+			}
+			*v = &f
+		}
+	}
+
+	m.DGPSStationID = m.Fields[11]
+
+	if len(m.Fields) == 13 {
+		if m.NavigationalStatus, err = ParseNavigationalStatus(m.Fields[12]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse navigational status from data field (got: %s)", m.Fields[12]))
+		}
+	}
+
+	return nil
+}
+
+// IsValid return true if at least one constellation provides a fix
+func (m GPGNS) IsValid() bool {
+	for _, mode := range m.Modes {
+		if mode != NoFixMode {
+			return true
+		}
+	}
+	return false
+}
+
+// Serialize return a valid sentence GNS as string
+func (m GPGNS) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPGNS")
+	fields := make([]string, 0)
+
+	modes := ""
+	for _, mode := range m.Modes {
+		modes += mode.Serialize()
+	}
+
+	fields = append(fields, m.TimeUTC.Serialize())
+	fields = append(fields, serializeOptionalPosition(m.Latitude, m.Longitude, 5)...) // Empty without fix
+	fields = append(fields,
+		modes,
+		fmt.Sprintf("%02d", m.NbOfSatellitesUsed),
+	)
+
+	for _, v := range []*float64{m.HDOP, m.Altitude, m.GeoIDSep, m.DGPSAge} {
+		if v != nil {
+			fields = append(fields, strconv.FormatFloat(*v, 'f', -1, 64))
+		} else {
+			fields = append(fields, "")
+		}
+	}
+
+	fields = append(fields, m.DGPSStationID)
+
+	if len(m.NavigationalStatus) > 0 {
+		fields = append(fields, m.NavigationalStatus.Serialize())
+	}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+const (
+	// NavStatusSafe is a NavigationalStatus as string "S"
+	NavStatusSafe NavigationalStatus = "S"
+	// NavStatusCaution is a NavigationalStatus as string "C"
+	NavStatusCaution NavigationalStatus = "C"
+	// NavStatusUnsafe is a NavigationalStatus as string "U"
+	NavStatusUnsafe NavigationalStatus = "U"
+	// NavStatusNotValid is a NavigationalStatus as string "V"
+	NavStatusNotValid NavigationalStatus = "V"
+)
+
+// NavigationalStatus type as string
+type NavigationalStatus string
+
+// Serialize return NavigationalStatus as string
+func (s NavigationalStatus) Serialize() string {
+	return string(s)
+}
+
+// String return NavigationalStatus as human string
+func (s NavigationalStatus) String() string {
+	switch s {
+	case NavStatusSafe:
+		return "safe"
+	case NavStatusCaution:
+		return "caution"
+	case NavStatusUnsafe:
+		return "unsafe"
+	case NavStatusNotValid:
+		return "not valid"
+	default:
+		return "unknow"
+	}
+}
+
+// ParseNavigationalStatus check NavigationalStatus validity, return an error
+// "unknow value" if not
+func ParseNavigationalStatus(raw string) (s NavigationalStatus, err error) {
+	s = NavigationalStatus(raw)
+	switch s {
+	case NavStatusSafe, NavStatusCaution, NavStatusUnsafe, NavStatusNotValid:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
@@ -0,0 +1,136 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+GRS GNSS Range Residuals
+       1         2 3   4   5   6   7   8   9   10  11  12  13  14  15 16
+       |         | |   |   |   |   |   |   |   |   |   |   |   |   |  |
+$--GRS,hhmmss.ss,d,x.x,x.x,x.x,x.x,x.x,x.x,x.x,x.x,x.x,x.x,x.x,x.x,h,h*hh
+
+1) Time (UTC) of associated GGA fix
+2) Mode:
+   0 - Residuals were used to calculate the position given in the matching GGA sentence
+   1 - Residuals were recomputed after the GGA position was computed
+3) ... 14) Range residuals in meters for satellites used in navigation,
+   order matches order of satellite ID numbers in GSA
+15) System ID (NMEA 4.11, optional)
+16) Signal ID (NMEA 4.11, optional)
+17) Checksum
+
+Examples:
+$GPGRS,220320.0,0,-0.8,-0.2,-0.1,-0.2,0.8,0.6,,,,,,*79
+$GNGRS,170556.00,1,0.5,-1.2,0.3,2.1,,,,,,,,,1,1*7B
+*/
+
+// NewGPGRS allocate GPGRS struct for GRS sentence (GNSS range residuals),
+// also used for other talkers (ie: GNGRS)
+func NewGPGRS(m Message) *GPGRS {
+	return &GPGRS{Message: m}
+}
+
+// GPGRS struct
+type GPGRS struct {
+	Message
+
+	TimeUTC   TimeOfDay    // Time UTC of associated GGA fix
+	Mode      int          // 0: residuals used to calculate position, 1: recomputed after position
+	Residuals [12]*float64 // Range residuals in meters, same order as satellites in GSA
+	SystemID  string       // NMEA 4.11, empty if not provided
+	SignalID  string       // NMEA 4.11, empty if not provided
+
+	hasSystemSignalID bool // NMEA 4.11 fields are present, even if empty
+}
+
+func (m *GPGRS) parse() (err error) {
+	if len(m.Fields) != 14 && len(m.Fields) != 16 {
+		return m.Error(fmt.Errorf("Incomplete GPGRS message, not enougth data fields (got: %d, wanted: %d or %d)", len(m.Fields), 14, 16))
+	}
+
+	if m.TimeUTC, err = ParseTimeOfDay(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse time UTC from data field (got: %s)", m.Fields[0]))
+	}
+
+	if m.Mode, err = strconv.Atoi(m.Fields[1]); err != nil || (m.Mode != 0 && m.Mode != 1) {
+		return m.Error(fmt.Errorf("Unable to parse mode from data field (got: %s)", m.Fields[1]))
+	}
+
+	for i := range m.Residuals {
+		if m.Residuals[i], err = parseOptionalFloat(m.Fields[i+2]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse range residual from data field %d (got: %s)", i+3, m.Fields[i+2]))
+		}
+	}
+
+	if len(m.Fields) == 16 {
+		m.SystemID, m.SignalID, m.hasSystemSignalID = m.Fields[14], m.Fields[15], true
+	}
+
+	return nil
+}
+
+// gnssSystemIDs are NMEA 4.11 system IDs by talker of single constellation
+var gnssSystemIDs = map[TalkerID]string{
+	TalkerIDGPS: "1",
+	TalkerIDGL:  "2",
+	TalkerIDGA:  "3",
+	TalkerIDGB:  "4",
+	TalkerIDBD:  "4",
+	TalkerIDQZ:  "5",
+}
+
+// systemID return NMEA 4.11 system ID of talker, empty if unknown (ie: GN for combined systems)
+func systemID(h Header) string {
+	if h == nil {
+		return ""
+	}
+	return gnssSystemIDs[h.GetTypeID().Talker]
+}
+
+// MapToSatellites return range residuals by satellite ID, according to the
+// satellites used for fix listed in the GSA sentence of the same epoch.
+// Return nil if gsa is nil or belongs to another system than residuals
+func (m GPGRS) MapToSatellites(gsa *GPGSA) map[int]float64 {
+	if gsa == nil {
+		return nil
+	}
+
+	system := m.SystemID
+	if len(system) == 0 {
+		system = systemID(m.Type)
+	}
+	if other := systemID(gsa.Type); len(system) > 0 && len(other) > 0 && system != other {
+		return nil
+	}
+
+	residuals := make(map[int]float64)
+	for i, r := range m.Residuals {
+		if id := gsa.SatelliteUsedOnChannel[i+1]; r != nil && id != 0 {
+			residuals[id] = *r
+		}
+	}
+	return residuals
+}
+
+// Serialize return a valid sentence GRS as string
+func (m GPGRS) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPGRS")
+	fields := make([]string, 0)
+	fields = append(fields, m.TimeUTC.Serialize(), strconv.Itoa(m.Mode))
+
+	for _, r := range m.Residuals {
+		fields = append(fields, formatOptionalFloat(r, "%.1f"))
+	}
+
+	if m.hasSystemSignalID || len(m.SystemID) > 0 || len(m.SignalID) > 0 {
+		fields = append(fields, m.SystemID, m.SignalID)
+	}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -0,0 +1,80 @@
+package nmea
+
+import "fmt"
+
+/*
+GST GNSS Pseudorange Error Statistics
+       1         2   3   4   5   6   7   8
+       |         |   |   |   |   |   |   |
+$--GST,hhmmss.ss,x.x,x.x,x.x,x.x,x.x,x.x,x.x*hh
+
+1) Time (UTC)
+2) RMS value of the standard deviation of the range inputs
+3) Standard deviation of semi-major axis of error ellipse, meters
+4) Standard deviation of semi-minor axis of error ellipse, meters
+5) Orientation of semi-major axis of error ellipse, degrees from true north
+6) Standard deviation of latitude error, meters
+7) Standard deviation of longitude error, meters
+8) Standard deviation of altitude error, meters
+9) Checksum
+
+Example:
+$GPGST,172814.0,0.006,0.023,0.020,273.6,0.023,0.020,0.031*6A
+*/
+
+// NewGPGST allocate GPGST struct for GST sentence (GNSS pseudorange error statistics),
+// also used for other talkers (ie: GNGST)
+func NewGPGST(m Message) *GPGST {
+	return &GPGST{Message: m}
+}
+
+// GPGST struct
+type GPGST struct {
+	Message
+
+	TimeUTC        TimeOfDay // Time UTC data field, without date
+	RMS            *float64  // RMS value of the standard deviation of the range inputs
+	SemiMajorError *float64  // Standard deviation of semi-major axis of error ellipse in meters
+	SemiMinorError *float64  // Standard deviation of semi-minor axis of error ellipse in meters
+	Orientation    *float64  // Orientation of semi-major axis in degree from true north
+	LatitudeError  *float64  // Standard deviation of latitude error in meters
+	LongitudeError *float64  // Standard deviation of longitude error in meters
+	AltitudeError  *float64  // Standard deviation of altitude error in meters
+}
+
+func (m *GPGST) parse() (err error) {
+	if len(m.Fields) != 8 {
+		return m.Error(fmt.Errorf("Incomplete GPGST message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 8))
+	}
+
+	if m.TimeUTC, err = ParseTimeOfDay(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse time UTC from data field (got: %s)", m.Fields[0]))
+	}
+
+	for i, v := range []**float64{&m.RMS, &m.SemiMajorError, &m.SemiMinorError, &m.Orientation, &m.LatitudeError, &m.LongitudeError, &m.AltitudeError} {
+		if *v, err = parseOptionalFloat(m.Fields[i+1]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse data field %d (got: %s)", i+2, m.Fields[i+1]))
+		}
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence GST as string
+func (m GPGST) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPGST")
+	fields := make([]string, 0)
+	fields = append(fields, m.TimeUTC.Serialize(),
+		formatOptionalFloat(m.RMS, "%.3f"),
+		formatOptionalFloat(m.SemiMajorError, "%.3f"),
+		formatOptionalFloat(m.SemiMinorError, "%.3f"),
+		formatOptionalFloat(m.Orientation, "%.1f"),
+		formatOptionalFloat(m.LatitudeError, "%.3f"),
+		formatOptionalFloat(m.LongitudeError, "%.3f"),
+		formatOptionalFloat(m.AltitudeError, "%.3f"))
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -19,15 +19,18 @@ $--GSV,x,x,x,x,x,x,x,...*hh
 6) azimuth in degrees to true
 7) SNR in dB
 more satellite infos like 4)-7)
+n-1) Signal ID (NMEA 4.11, optional)
 n) Checksum
 
  Examples:
  $GPGSV,3,1,12,01,05,060,18,02,17,259,43,04,56,287,28,09,08,277,28*77
  $GPGSV,3,2,12,10,34,195,46,13,08,125,45,17,67,014,,20,32,048,24*74
  $GPGSV,3,3,12,23,13,094,48,24,04,292,24,28,49,178,46,32,06,037,22*7D
+ $GLGSV,1,1,02,65,32,109,33,66,42,040,31,1*70
 */
 
-// NewGPGSV allocate GPGSV struct for GSV sentence (Satellites in view)
+// NewGPGSV allocate GPGSV struct for GSV sentence (Satellites in view),
+// also used for other talkers (ie: GLGSV, GAGSV)
 func NewGPGSV(m Message) *GPGSV {
 	return &GPGSV{Message: m}
 }
@@ -35,10 +38,11 @@ func NewGPGSV(m Message) *GPGSV {
 // GPGSV struct
 type GPGSV struct {
 	Message
-	NbOfMessage      int // Number of messages, total number of GPGSV messages being output (1 ~ 3)
-	SequenceNumber   int // Sequence number of this entry (1 ~ 3)
+	NbOfMessage      int // Number of messages, total number of GPGSV messages being output (1 ~ 9)
+	SequenceNumber   int // Sequence number of this entry (1 ~ 9)
 	SatellitesInView int
 	Satellites       []Satellite
+	SignalID         string // Signal ID in hex (NMEA 4.11), empty if not provided
 }
 
 // Satellite struct
@@ -87,15 +91,25 @@ func newSatelliteFromFields(f []string) (s Satellite, err error) {
 
 func (m *GPGSV) parse() (err error) {
 	//log.Printf("GSV: %d fields\n", len(m.Fields))
-	if len(m.Fields) < 3 || (len(m.Fields)-3)%4 != 0 {
+	if len(m.Fields) < 3 || (len(m.Fields)-3)%4 > 1 {
 		return m.Error(fmt.Errorf("Invalid message size (got: %d)", len(m.Fields)))
 	}
 
+	// Trailing signal ID field (NMEA 4.11)
+	fields := m.Fields
+	if (len(m.Fields)-3)%4 == 1 {
+		m.SignalID = m.Fields[len(m.Fields)-1]
+		if _, err = strconv.ParseUint(m.SignalID, 16, 8); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse signal ID from data field (got: %s)", m.SignalID))
+		}
+		fields = m.Fields[:len(m.Fields)-1]
+	}
+
 	if m.NbOfMessage, err = strconv.Atoi(m.Fields[0]); err != nil {
 		return m.Error(err)
 	}
 
-	if m.NbOfMessage < 1 || m.NbOfMessage > 3 {
+	if m.NbOfMessage < 1 || m.NbOfMessage > 9 {
 		return m.Error(fmt.Errorf("Number of messages out of range (got: %d)", m.NbOfMessage))
 	}
 
@@ -103,7 +117,7 @@ func (m *GPGSV) parse() (err error) {
 		return m.Error(err)
 	}
 
-	if m.SequenceNumber < 1 || m.SequenceNumber > 3 {
+	if m.SequenceNumber < 1 || m.SequenceNumber > m.NbOfMessage {
 		return m.Error(fmt.Errorf("Sequence number out of range (got: %d)", m.SequenceNumber))
 	}
 
@@ -116,12 +130,12 @@ func (m *GPGSV) parse() (err error) {
 		padding := 4
 		m.Satellites = make([]Satellite, 0)
 
-		for len(m.Fields[offset:]) != 0 {
-			if len(m.Fields[offset:]) < padding {
-				return m.Error(fmt.Errorf("Wrong number of satellite data (got: %d)", len(m.Fields[offset:])))
+		for len(fields[offset:]) != 0 {
+			if len(fields[offset:]) < padding {
+				return m.Error(fmt.Errorf("Wrong number of satellite data (got: %d)", len(fields[offset:])))
 			}
 
-			sat, err := newSatelliteFromFields(m.Fields[offset : offset+padding])
+			sat, err := newSatelliteFromFields(fields[offset : offset+padding])
 			if err != nil {
 				return m.Error(err)
 			}
@@ -141,7 +155,7 @@ func (m *GPGSV) parse() (err error) {
 
 // Serialize return a valid sentence GSV as string
 func (m GPGSV) Serialize() string { // Implement NMEA interface
-	hdr := TypeIDs["GPGSV"]
+	hdr := m.header("GPGSV")
 	fields := make([]string, 0)
 
 	fields = append(fields,
@@ -172,8 +186,66 @@ func (m GPGSV) Serialize() string { // Implement NMEA interface
 		}
 	}
 
+	if len(m.SignalID) > 0 {
+		fields = append(fields, m.SignalID)
+	}
+
 	msg := Message{Type: hdr, Fields: fields}
 	msg.Checksum = msg.ComputeChecksum()
 
 	return msg.Serialize()
 }
+
+// SkyView struct is the complete list of satellites in view assembled
+// from a group of GSV sentences
+type SkyView struct {
+	Talker           TalkerID
+	SignalID         string
+	SatellitesInView int
+	Satellites       []Satellite
+}
+
+// GSVAssembler struct assembles groups of GSV sentences by talker and signal ID
+type GSVAssembler struct {
+	pending map[string]*SkyView
+	seq     map[string]int
+}
+
+// NewGSVAssembler allocate GSVAssembler struct
+func NewGSVAssembler() *GSVAssembler {
+	return &GSVAssembler{pending: make(map[string]*SkyView), seq: make(map[string]int)}
+}
+
+// Add feed GSVAssembler with a message, other messages than GSV and PUBX,03 are ignored.
+// Return the sky view once all the sentences of a group have been received
+func (a *GSVAssembler) Add(msg NMEA) (*SkyView, error) {
+	if ubx, ok := msg.(*PUBX03); ok {
+		return ubx.SkyView(), nil // Complete in a single sentence
+	}
+
+	m, ok := msg.(*GPGSV)
+	if !ok {
+		return nil, nil
+	}
+
+	talker := m.header("GPGSV").GetTypeID().Talker
+	key := talker.Serialize() + m.SignalID
+
+	if m.SequenceNumber == 1 {
+		a.pending[key] = &SkyView{Talker: talker, SignalID: m.SignalID, SatellitesInView: m.SatellitesInView}
+	} else if a.pending[key] == nil || a.seq[key] != m.SequenceNumber-1 {
+		delete(a.pending, key)
+		return nil, m.Error(fmt.Errorf("Out of sequence GSV message (got: %d/%d)", m.SequenceNumber, m.NbOfMessage))
+	}
+	a.seq[key] = m.SequenceNumber
+
+	view := a.pending[key]
+	view.Satellites = append(view.Satellites, m.Satellites...)
+
+	if m.SequenceNumber < m.NbOfMessage {
+		return nil, nil
+	}
+
+	delete(a.pending, key)
+	return view, nil
+}
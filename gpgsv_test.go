@@ -0,0 +1,49 @@
+package nmea
+
+import "testing"
+
+func TestGSVAssembler(t *testing.T) {
+	nmeas := []string{
+		"$GPGSV,3,1,12,01,05,060,18,02,17,259,43,04,56,287,28,09,08,277,28*77",
+		"$GLGSV,1,1,02,65,32,109,33,66,42,040,31,1*70",
+		"$GPGSV,3,2,12,10,34,195,46,13,08,125,45,17,67,014,,20,32,048,24*74",
+		"$GPGSV,3,3,12,23,13,094,48,24,04,292,24,28,49,178,46,32,06,037,22*7D",
+	}
+
+	a := NewGSVAssembler()
+	views := make([]*SkyView, 0)
+	for _, raw := range nmeas {
+		msg, err := Parse(raw)
+		if err != nil {
+			t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+		}
+		view, err := a.Add(msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if view != nil {
+			views = append(views, view)
+		}
+	}
+
+	if len(views) != 2 {
+		t.Fatalf("Wrong number of assembled sky views (got: %d)", len(views))
+	}
+
+	if views[0].Talker != TalkerIDGL || views[0].SignalID != "1" || len(views[0].Satellites) != 2 {
+		t.Fatalf("Wrong GLONASS sky view (got: %+v)", views[0])
+	}
+
+	if views[1].Talker != TalkerIDGPS || len(views[1].Satellites) != views[1].SatellitesInView {
+		t.Fatalf("Wrong GPS sky view (got: %+v)", views[1])
+	}
+}
+
+func TestGSVAssemblerFromScratch(t *testing.T) {
+	// GSV crafted from scratch has no type, GPS talker is assumed
+	a := NewGSVAssembler()
+	view, err := a.Add(&GPGSV{SequenceNumber: 1, NbOfMessage: 1, SatellitesInView: 0})
+	if err != nil || view == nil || view.Talker != TalkerIDGPS {
+		t.Fatalf("Wrong sky view (got: %+v, err: %v)", view, err)
+	}
+}
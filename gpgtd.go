@@ -0,0 +1,65 @@
+package nmea
+
+import (
+	"fmt"
+)
+
+/*
+GTD Geographic Location in Time Differences
+       1   2   3   4   5   6
+       |   |   |   |   |   |
+$--GTD,x.x,x.x,x.x,x.x,x.x*hh
+
+1) Time difference 1, microseconds
+2) Time difference 2, microseconds
+3) Time difference 3, microseconds
+4) Time difference 4, microseconds
+5) Time difference 5, microseconds
+6) Checksum
+
+Examples:
+$LCGTD,15920.5,26745.7,42011.3,,*50
+$LCGTD,14162.8,42011.3,58993.1,12345.6,23456.7*5E
+*/
+
+// NewGPGTD allocate GPGTD struct for GTD sentence (Geographic location in time
+// differences), also used for other talkers (ie: LCGTD)
+func NewGPGTD(m Message) *GPGTD {
+	return &GPGTD{Message: m}
+}
+
+// GPGTD struct
+type GPGTD struct {
+	Message
+
+	TimeDifferences [5]*float64 // Time differences in microseconds, nil if not provided
+}
+
+func (m *GPGTD) parse() (err error) {
+	if len(m.Fields) != 5 {
+		return m.Error(fmt.Errorf("Incomplete GPGTD message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 5))
+	}
+
+	for i := range m.TimeDifferences {
+		if m.TimeDifferences[i], err = parseOptionalFloat(m.Fields[i]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse time difference from data field (got: %s)", m.Fields[i]))
+		}
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence GTD as string
+func (m GPGTD) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPGTD")
+	fields := make([]string, 0)
+	for _, v := range m.TimeDifferences {
+		fields = append(fields, formatOptionalFloat(v, "%.1f"))
+	}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -0,0 +1,74 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+/*
+HBT Heartbeat Supervision Sentence
+       1   2 3
+       |   | |
+$--HBT,x.x,A,x*hh
+
+1) Configured repeat interval, seconds
+2) Equipment status, A = normal, V = system fail
+3) Sequential sequence identifier, 0 to 9
+4) Checksum
+
+Examples:
+$IIHBT,30.0,A,3*1D
+$GPHBT,5.0,V,0*28
+*/
+
+// NewGPHBT allocate GPHBT struct for HBT sentence (Heartbeat supervision),
+// also used for other talkers (ie: IIHBT)
+func NewGPHBT(m Message) *GPHBT {
+	return &GPHBT{Message: m}
+}
+
+// GPHBT struct
+type GPHBT struct {
+	Message
+
+	Interval       time.Duration // Configured repeat interval
+	IsNormal       DataValid     // Equipment status
+	SequenceNumber int           // 0 to 9
+}
+
+func (m *GPHBT) parse() (err error) {
+	if len(m.Fields) != 3 {
+		return m.Error(fmt.Errorf("Incomplete GPHBT message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 3))
+	}
+
+	seconds, err := strconv.ParseFloat(m.Fields[0], 64)
+	if err != nil || seconds < 0 {
+		return m.Error(fmt.Errorf("Unable to parse repeat interval from data field (got: %s)", m.Fields[0]))
+	}
+	m.Interval = time.Duration(seconds * float64(time.Second))
+
+	m.IsNormal = (m.Fields[1] == "A")
+
+	if m.SequenceNumber, err = strconv.Atoi(m.Fields[2]); err != nil || m.SequenceNumber < 0 || m.SequenceNumber > 9 {
+		return m.Error(fmt.Errorf("Unable to parse sequence identifier from data field (got: %s)", m.Fields[2]))
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence HBT as string
+func (m GPHBT) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPHBT")
+	fields := make([]string, 0)
+	fields = append(fields,
+		fmt.Sprintf("%.1f", m.Interval.Seconds()),
+		m.IsNormal.Serialize(),
+		strconv.Itoa(m.SequenceNumber))
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -0,0 +1,105 @@
+package nmea
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+/*
+HDG Heading - Deviation & Variation
+       1   2   3 4   5 6
+       |   |   | |   | |
+$--HDG,x.x,x.x,a,x.x,a*hh
+
+1) Magnetic sensor heading, degrees
+2) Magnetic deviation, degrees
+3) Magnetic deviation direction, E = Easterly, W = Westerly
+4) Magnetic variation, degrees
+5) Magnetic variation direction, E = Easterly, W = Westerly
+6) Checksum
+
+Examples:
+$HCHDG,98.3,0.0,E,12.6,W*57
+$HCHDG,101.1,,,7.1,W*3C
+*/
+
+// NewGPHDG allocate GPHDG struct for HDG sentence (Heading, deviation and variation),
+// also used for other talkers (ie: HCHDG)
+func NewGPHDG(m Message) *GPHDG {
+	return &GPHDG{Message: m}
+}
+
+// GPHDG struct
+type GPHDG struct {
+	Message
+
+	Heading   float64  // Magnetic sensor heading in degree
+	Deviation *float64 // Magnetic deviation in degree (negative = West), nil if not provided
+	Variation *float64 // Magnetic variation in degree (negative = West), nil if not provided
+}
+
+func (m *GPHDG) parse() (err error) {
+	if len(m.Fields) != 5 {
+		return m.Error(fmt.Errorf("Incomplete GPHDG message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 5))
+	}
+
+	if m.Heading, err = strconv.ParseFloat(m.Fields[0], 64); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse magnetic sensor heading from data field (got: %s)", m.Fields[0]))
+	}
+
+	if len(m.Fields[1]) > 0 {
+		deviation, err := parseSignedOffset(m.Fields[1], m.Fields[2], East, West)
+		if err != nil {
+			return m.Error(fmt.Errorf("Unable to parse magnetic deviation from data field (got: %s,%s)", m.Fields[1], m.Fields[2]))
+		}
+		m.Deviation = &deviation
+	}
+
+	if len(m.Fields[3]) > 0 {
+		variation, err := parseSignedOffset(m.Fields[3], m.Fields[4], East, West)
+		if err != nil {
+			return m.Error(fmt.Errorf("Unable to parse magnetic variation from data field (got: %s,%s)", m.Fields[3], m.Fields[4]))
+		}
+		m.Variation = &variation
+	}
+
+	return nil
+}
+
+// True return true heading in degree derived from magnetic sensor heading,
+// deviation and variation (missing values are considered as 0)
+func (m GPHDG) True() float64 {
+	heading := m.Heading
+	if m.Deviation != nil {
+		heading += *m.Deviation
+	}
+	if m.Variation != nil {
+		heading += *m.Variation
+	}
+	return normalizeDegrees(heading)
+}
+
+// Serialize return a valid sentence HDG as string
+func (m GPHDG) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPHDG")
+	fields := make([]string, 0)
+	fields = append(fields, fmt.Sprintf("%.1f", m.Heading))
+
+	for _, v := range []*float64{m.Deviation, m.Variation} {
+		switch {
+		case v == nil:
+			fields = append(fields, "", "")
+		case *v < 0:
+			fields = append(fields, fmt.Sprintf("%.1f", math.Abs(*v)), West.String())
+		default:
+			fields = append(fields, fmt.Sprintf("%.1f", *v), East.String())
+		}
+	}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -0,0 +1,67 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+HDM Heading - Magnetic
+       1   2 3
+       |   | |
+$--HDM,x.x,M*hh
+
+1) Heading, degrees magnetic
+2) M = Magnetic
+3) Checksum
+
+Examples:
+$HCHDM,238.5,M*25
+*/
+
+// NewGPHDM allocate GPHDM struct for HDM sentence (Heading magnetic) from magnetic compass,
+// also used for other talkers (ie: HCHDM)
+func NewGPHDM(m Message) *GPHDM {
+	return &GPHDM{Message: m}
+}
+
+// GPHDM struct
+type GPHDM struct {
+	Message
+
+	Heading float64 // Heading in degree (magnetic)
+}
+
+func (m *GPHDM) parse() (err error) {
+	if len(m.Fields) != 2 {
+		return m.Error(fmt.Errorf("Incomplete GPHDM message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 2))
+	}
+
+	if m.Fields[1] != "M" {
+		return m.Error(fmt.Errorf("Invalid fixed field at %d (got: %s, wanted: %s)", 2, m.Fields[1], "M"))
+	}
+
+	if m.Heading, err = strconv.ParseFloat(m.Fields[0], 64); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse heading from data field (got: %s)", m.Fields[0]))
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence HDM as string
+func (m GPHDM) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPHDM")
+	fields := make([]string, 0)
+	fields = append(fields, fmt.Sprintf("%.1f", m.Heading), "M")
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+// True return true heading in degree from magnetic heading corrected with
+// deviation and variation in degree (negative = West)
+func (m GPHDM) True(deviation, variation float64) float64 {
+	return normalizeDegrees(m.Heading + deviation + variation)
+}
@@ -0,0 +1,61 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+HDT Heading - True
+       1   2 3
+       |   | |
+$--HDT,x.x,T*hh
+
+1) Heading, degrees true
+2) T = True
+3) Checksum
+
+Examples:
+$HEHDT,123.4,T*2B
+*/
+
+// NewGPHDT allocate GPHDT struct for HDT sentence (Heading true) from gyro or satellite compass,
+// also used for other talkers (ie: HEHDT)
+func NewGPHDT(m Message) *GPHDT {
+	return &GPHDT{Message: m}
+}
+
+// GPHDT struct
+type GPHDT struct {
+	Message
+
+	Heading float64 // Heading in degree (true)
+}
+
+func (m *GPHDT) parse() (err error) {
+	if len(m.Fields) != 2 {
+		return m.Error(fmt.Errorf("Incomplete GPHDT message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 2))
+	}
+
+	if m.Fields[1] != "T" {
+		return m.Error(fmt.Errorf("Invalid fixed field at %d (got: %s, wanted: %s)", 2, m.Fields[1], "T"))
+	}
+
+	if m.Heading, err = strconv.ParseFloat(m.Fields[0], 64); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse heading from data field (got: %s)", m.Fields[0]))
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence HDT as string
+func (m GPHDT) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPHDT")
+	fields := make([]string, 0)
+	fields = append(fields, fmt.Sprintf("%.1f", m.Heading), "T")
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -0,0 +1,100 @@
+package nmea
+
+import (
+	"fmt"
+)
+
+/*
+HSC Heading Steering Command
+       1   2 3   4 5
+       |   | |   | |
+$--HSC,x.x,T,x.x,M,a*hh
+
+1) Commanded heading, degrees true
+2) T = True
+3) Commanded heading, degrees magnetic
+4) M = Magnetic
+5) Sentence status flag (NMEA 4.1 and later):
+	R = report of current settings
+	C = configuration command to change settings
+6) Checksum
+
+Examples:
+$GPHSC,128.0,T,133.5,M*59
+$INHSC,045.2,T,,M,C*04
+*/
+
+// NewGPHSC allocate GPHSC struct for HSC sentence (Heading steering command),
+// also used for other talkers (ie: INHSC)
+func NewGPHSC(m Message) *GPHSC {
+	return &GPHSC{Message: m}
+}
+
+// GPHSC struct
+type GPHSC struct {
+	Message
+
+	HeadingTrue     *float64       // Commanded heading in degree (true), nil if not provided
+	HeadingMagnetic *float64       // Commanded heading in degree (magnetic), nil if not provided
+	Status          SentenceStatus // Empty if not provided (before NMEA 4.1)
+}
+
+// NewHeadingSteeringCommand return the HSC sentence commanding heading (degree
+// true), the magnetic heading is computed from the variation (degree, east is positive)
+func NewHeadingSteeringCommand(heading, variation float64) GPHSC {
+	headingTrue := normalizeDegrees(heading)
+	headingMagnetic := normalizeDegrees(heading - variation)
+	return GPHSC{
+		HeadingTrue:     &headingTrue,
+		HeadingMagnetic: &headingMagnetic,
+		Status:          CommandStatus,
+	}
+}
+
+func (m *GPHSC) parse() (err error) {
+	if len(m.Fields) != 4 && len(m.Fields) != 5 {
+		return m.Error(fmt.Errorf("Incomplete GPHSC message, not enougth data fields (got: %d, wanted: %d or %d)", len(m.Fields), 4, 5))
+	}
+
+	// Validate fixed field
+	for i, v := range map[int]string{1: "T", 3: "M"} {
+		if m.Fields[i] != v {
+			return m.Error(fmt.Errorf("Invalid fixed field at %d (got: %s, wanted: %s)", i+1, m.Fields[i], v))
+		}
+	}
+
+	if m.HeadingTrue, err = parseOptionalFloat(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse true heading from data field (got: %s)", m.Fields[0]))
+	}
+
+	if m.HeadingMagnetic, err = parseOptionalFloat(m.Fields[2]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse magnetic heading from data field (got: %s)", m.Fields[2]))
+	}
+
+	if len(m.Fields) == 5 && len(m.Fields[4]) > 0 {
+		if m.Status, err = ParseSentenceStatus(m.Fields[4]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse sentence status flag from data field (got: %s)", m.Fields[4]))
+		}
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence HSC as string
+func (m GPHSC) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPHSC")
+	fields := make([]string, 0)
+	fields = append(fields,
+		formatOptionalFloat(m.HeadingTrue, "%05.1f"), "T",
+		formatOptionalFloat(m.HeadingMagnetic, "%05.1f"), "M")
+
+	if len(m.Status) > 0 {
+		fields = append(fields, m.Status.Serialize())
+	}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -0,0 +1,277 @@
+package nmea
+
+import (
+	"fmt"
+)
+
+/*
+HTC Heading/Track Control Command
+       1 2   3 4 5 6   7   8   9   10  11  12  13 14
+       | |   | | | |   |   |   |   |   |   |   |  |
+$--HTC,A,x.x,a,a,a,x.x,x.x,x.x,x.x,x.x,x.x,x.x,a,a*hh
+
+1) Override, A = in use, V = not in use
+2) Commanded rudder angle, degrees
+3) Commanded rudder direction, L = port, R = starboard
+4) Selected steering mode:
+	M = manual steering
+	S = stand-alone (heading control)
+	H = heading control, external source
+	T = track control
+	R = direct rudder control
+5) Turn mode:
+	R = radius controlled
+	T = turn rate controlled
+	N = turn is not controlled
+6) Commanded rudder limit, degrees
+7) Commanded off-heading limit, degrees
+8) Commanded radius of turn for heading changes, nautical miles
+9) Commanded rate of turn for heading changes, degrees/minute
+10) Commanded heading-to-steer, degrees
+11) Commanded off-track limit, nautical miles
+12) Commanded track, degrees
+13) Heading reference in use, T = true, M = magnetic
+14) Sentence status flag (NMEA 4.1 and later):
+	R = report of current settings
+	C = configuration command to change settings
+15) Checksum
+
+Examples:
+$AGHTC,V,10.0,L,H,N,30.0,15.0,0.50,60.0,128.5,0.10,130.0,T*35
+$INHTC,A,,,T,R,35.0,10.0,1.20,,045.0,0.25,047.5,M,C*15
+*/
+
+// NewGPHTC allocate GPHTC struct for HTC sentence (Heading/track control command),
+// also used for other talkers (ie: AGHTC, INHTC)
+func NewGPHTC(m Message) *GPHTC {
+	return &GPHTC{Message: m}
+}
+
+// GPHTC struct
+type GPHTC struct {
+	Message
+	HeadingTrackControl
+
+	Status SentenceStatus // Empty if not provided (before NMEA 4.1)
+}
+
+// HeadingTrackControl struct holds the settings of a heading/track controller
+// shared by HTC and HTD sentences
+type HeadingTrackControl struct {
+	Override         DataValid // Valid when override is in use
+	RudderAngle      *float64  // Rudder angle in degree, nil if not provided
+	RudderDirection  SteerDirection
+	SteeringMode     SteeringMode
+	TurnMode         TurnMode
+	RudderLimit      *float64 // Rudder limit in degree, nil if not provided
+	OffHeadingLimit  *float64 // Off-heading limit in degree, nil if not provided
+	TurnRadius       *float64 // Radius of turn for heading changes in nautical miles, nil if not provided
+	TurnRate         *float64 // Rate of turn for heading changes in degree per minute, nil if not provided
+	HeadingToSteer   *float64 // Heading-to-steer in degree, nil if not provided
+	OffTrackLimit    *float64 // Off-track limit in nautical miles, nil if not provided
+	Track            *float64 // Track in degree, nil if not provided
+	HeadingReference NorthReference
+}
+
+// parse decode the 13 first data fields of HTC and HTD sentences
+func (c *HeadingTrackControl) parse(fields []string) (err error) {
+	switch fields[0] {
+	case "A":
+		c.Override = Valid
+	case "V":
+		c.Override = Invalid
+	default:
+		return fmt.Errorf("Unable to parse override from data field (got: %s)", fields[0])
+	}
+
+	if len(fields[2]) > 0 {
+		switch SteerDirection(fields[2]) {
+		case SteerLeft, SteerRight:
+			c.RudderDirection = SteerDirection(fields[2])
+		default:
+			return fmt.Errorf("Unable to parse rudder direction from data field (got: %s)", fields[2])
+		}
+	}
+
+	if len(fields[3]) > 0 {
+		if c.SteeringMode, err = ParseSteeringMode(fields[3]); err != nil {
+			return fmt.Errorf("Unable to parse steering mode from data field (got: %s)", fields[3])
+		}
+	}
+
+	if len(fields[4]) > 0 {
+		if c.TurnMode, err = ParseTurnMode(fields[4]); err != nil {
+			return fmt.Errorf("Unable to parse turn mode from data field (got: %s)", fields[4])
+		}
+	}
+
+	for i, v := range map[int]**float64{
+		1:  &c.RudderAngle,
+		5:  &c.RudderLimit,
+		6:  &c.OffHeadingLimit,
+		7:  &c.TurnRadius,
+		8:  &c.TurnRate,
+		9:  &c.HeadingToSteer,
+		10: &c.OffTrackLimit,
+		11: &c.Track,
+	} {
+		if *v, err = parseOptionalFloat(fields[i]); err != nil {
+			return fmt.Errorf("Unable to parse data field %d (got: %s)", i+1, fields[i])
+		}
+	}
+
+	if len(fields[12]) > 0 {
+		if c.HeadingReference, err = ParseNorthReference(fields[12]); err != nil {
+			return fmt.Errorf("Unable to parse heading reference from data field (got: %s)", fields[12])
+		}
+	}
+
+	return nil
+}
+
+// fields return the 13 first data fields of HTC and HTD sentences
+func (c HeadingTrackControl) fields() []string {
+	return []string{
+		c.Override.Serialize(),
+		formatOptionalFloat(c.RudderAngle, "%.1f"),
+		string(c.RudderDirection),
+		c.SteeringMode.Serialize(),
+		c.TurnMode.Serialize(),
+		formatOptionalFloat(c.RudderLimit, "%.1f"),
+		formatOptionalFloat(c.OffHeadingLimit, "%.1f"),
+		formatOptionalFloat(c.TurnRadius, "%.2f"),
+		formatOptionalFloat(c.TurnRate, "%.1f"),
+		formatOptionalFloat(c.HeadingToSteer, "%05.1f"),
+		formatOptionalFloat(c.OffTrackLimit, "%.2f"),
+		formatOptionalFloat(c.Track, "%05.1f"),
+		c.HeadingReference.Serialize(),
+	}
+}
+
+func (m *GPHTC) parse() (err error) {
+	if len(m.Fields) != 13 && len(m.Fields) != 14 {
+		return m.Error(fmt.Errorf("Incomplete GPHTC message, not enougth data fields (got: %d, wanted: %d or %d)", len(m.Fields), 13, 14))
+	}
+
+	if err = m.HeadingTrackControl.parse(m.Fields); err != nil {
+		return m.Error(err)
+	}
+
+	if len(m.Fields) == 14 && len(m.Fields[13]) > 0 {
+		if m.Status, err = ParseSentenceStatus(m.Fields[13]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse sentence status flag from data field (got: %s)", m.Fields[13]))
+		}
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence HTC as string
+func (m GPHTC) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPHTC")
+	fields := m.HeadingTrackControl.fields()
+
+	if len(m.Status) > 0 {
+		fields = append(fields, m.Status.Serialize())
+	}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+const (
+	// ManualSteering is a SteeringMode type as string "M"
+	ManualSteering SteeringMode = "M"
+	// StandAloneSteering is a SteeringMode type as string "S" (heading control)
+	StandAloneSteering SteeringMode = "S"
+	// HeadingControlSteering is a SteeringMode type as string "H" (heading control, external source)
+	HeadingControlSteering SteeringMode = "H"
+	// TrackControlSteering is a SteeringMode type as string "T"
+	TrackControlSteering SteeringMode = "T"
+	// RudderControlSteering is a SteeringMode type as string "R" (direct rudder control)
+	RudderControlSteering SteeringMode = "R"
+)
+
+// SteeringMode type as string
+type SteeringMode string
+
+// Serialize return SteeringMode as string
+func (s SteeringMode) Serialize() string {
+	return string(s)
+}
+
+// String return SteeringMode as human string
+func (s SteeringMode) String() string {
+	switch s {
+	case ManualSteering:
+		return "manual"
+	case StandAloneSteering:
+		return "stand-alone"
+	case HeadingControlSteering:
+		return "heading control"
+	case TrackControlSteering:
+		return "track control"
+	case RudderControlSteering:
+		return "rudder control"
+	default:
+		return "unknow"
+	}
+}
+
+// ParseSteeringMode check SteeringMode validity, return an error
+// "unknow value" if not
+func ParseSteeringMode(raw string) (s SteeringMode, err error) {
+	s = SteeringMode(raw)
+	switch s {
+	case ManualSteering, StandAloneSteering, HeadingControlSteering, TrackControlSteering, RudderControlSteering:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
+
+const (
+	// RadiusControlledTurn is a TurnMode type as string "R"
+	RadiusControlledTurn TurnMode = "R"
+	// RateControlledTurn is a TurnMode type as string "T"
+	RateControlledTurn TurnMode = "T"
+	// UncontrolledTurn is a TurnMode type as string "N"
+	UncontrolledTurn TurnMode = "N"
+)
+
+// TurnMode type as string
+type TurnMode string
+
+// Serialize return TurnMode as string
+func (t TurnMode) Serialize() string {
+	return string(t)
+}
+
+// String return TurnMode as human string
+func (t TurnMode) String() string {
+	switch t {
+	case RadiusControlledTurn:
+		return "radius controlled"
+	case RateControlledTurn:
+		return "turn rate controlled"
+	case UncontrolledTurn:
+		return "not controlled"
+	default:
+		return "unknow"
+	}
+}
+
+// ParseTurnMode check TurnMode validity, return an error
+// "unknow value" if not
+func ParseTurnMode(raw string) (t TurnMode, err error) {
+	t = TurnMode(raw)
+	switch t {
+	case RadiusControlledTurn, RateControlledTurn, UncontrolledTurn:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
@@ -0,0 +1,92 @@
+package nmea
+
+import (
+	"fmt"
+)
+
+/*
+HTD Heading/Track Control Data
+       1 2   3 4 5 6   7   8   9   10  11  12  13 14 15 16 17
+       | |   | | | |   |   |   |   |   |   |   |  |  |  |  |
+$--HTD,A,x.x,a,a,a,x.x,x.x,x.x,x.x,x.x,x.x,x.x,a,a,a,a,x.x*hh
+
+1) to 13) Same as HTC, settings in use by the heading/track controller
+14) Rudder status, A = within limits, V = limit reached or exceeded
+15) Off-heading status, A = within limits, V = limit reached or exceeded
+16) Off-track status, A = within limits, V = limit reached or exceeded
+17) Vessel heading, degrees
+18) Checksum
+
+Examples:
+$AGHTD,V,10.0,L,H,N,30.0,15.0,0.50,60.0,128.5,0.10,130.0,T,A,A,A,127.3*5A
+$AGHTD,A,5.0,R,T,T,35.0,10.0,,20.0,045.0,0.25,047.5,M,V,A,V,052.1*6B
+*/
+
+// NewGPHTD allocate GPHTD struct for HTD sentence (Heading/track control data),
+// also used for other talkers (ie: AGHTD)
+func NewGPHTD(m Message) *GPHTD {
+	return &GPHTD{Message: m}
+}
+
+// GPHTD struct
+type GPHTD struct {
+	Message
+	HeadingTrackControl
+
+	RudderStatus     DataValid // Valid when rudder is within limits
+	OffHeadingStatus DataValid // Valid when heading is within limits
+	OffTrackStatus   DataValid // Valid when track is within limits
+	VesselHeading    *float64  // Vessel heading in degree, nil if not provided
+}
+
+func (m *GPHTD) parse() (err error) {
+	if len(m.Fields) != 17 {
+		return m.Error(fmt.Errorf("Incomplete GPHTD message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 17))
+	}
+
+	if err = m.HeadingTrackControl.parse(m.Fields); err != nil {
+		return m.Error(err)
+	}
+
+	m.RudderStatus = (m.Fields[13] == "A")
+	m.OffHeadingStatus = (m.Fields[14] == "A")
+	m.OffTrackStatus = (m.Fields[15] == "A")
+
+	if m.VesselHeading, err = parseOptionalFloat(m.Fields[16]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse vessel heading from data field (got: %s)", m.Fields[16]))
+	}
+
+	return nil
+}
+
+// IsWithinLimits return true when rudder, heading and track are within the limits
+func (m GPHTD) IsWithinLimits() bool {
+	return m.RudderStatus == Valid && m.OffHeadingStatus == Valid && m.OffTrackStatus == Valid
+}
+
+// HeadingError return the signed difference in degree between the vessel
+// heading and the heading-to-steer (positive when vessel heads to starboard),
+// return false if one of them is not provided
+func (m GPHTD) HeadingError() (float64, bool) {
+	if m.VesselHeading == nil || m.HeadingToSteer == nil {
+		return 0, false
+	}
+	return angleDiff(*m.HeadingToSteer, *m.VesselHeading), true
+}
+
+// Serialize return a valid sentence HTD as string
+func (m GPHTD) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPHTD")
+	fields := m.HeadingTrackControl.fields()
+	fields = append(fields,
+		m.RudderStatus.Serialize(),
+		m.OffHeadingStatus.Serialize(),
+		m.OffTrackStatus.Serialize(),
+		formatOptionalFloat(m.VesselHeading, "%05.1f"))
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
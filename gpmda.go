@@ -0,0 +1,129 @@
+package nmea
+
+import (
+	"fmt"
+)
+
+/*
+MDA Meteorological Composite
+       1   2 3   4 5   6 7   8 9   10  11  12 13 14 15 16 17 18 19 20 21
+       |   | |   | |   | |   | |   |   |   |  |  |  |  |  |  |  |  |  |
+$--MDA,x.x,I,x.x,B,x.x,C,x.x,C,x.x,x.x,x.x,C,x.x,T,x.x,M,x.x,N,x.x,M*hh
+
+1) Barometric pressure, inches of mercury
+2) I = Inches of mercury
+3) Barometric pressure, bars
+4) B = Bars
+5) Air temperature, degrees Celsius
+6) C = Celsius
+7) Water temperature, degrees Celsius
+8) C = Celsius
+9) Relative humidity, percent
+10) Absolute humidity, percent
+11) Dew point, degrees Celsius
+12) C = Celsius
+13) Wind direction, degrees true
+14) T = True
+15) Wind direction, degrees magnetic
+16) M = Magnetic
+17) Wind speed, knots
+18) N = Knots
+19) Wind speed, meters/second
+20) M = Meters/second
+21) Checksum
+
+Examples:
+$WIMDA,30.2269,I,1.0236,B,17.7,C,,C,42.3,,5.0,C,223.7,T,226.1,M,5.8,N,3.0,M*1C
+$WIMDA,,I,,B,,C,14.2,C,,,,C,,T,,M,,N,,M*1D
+*/
+
+// NewGPMDA allocate GPMDA struct for MDA sentence (Meteorological composite),
+// also used for other talkers (ie: IIMDA, WIMDA)
+func NewGPMDA(m Message) *GPMDA {
+	return &GPMDA{Message: m}
+}
+
+// GPMDA struct, all quantities are nil if not provided
+type GPMDA struct {
+	Message
+
+	PressureInches        *float64 // Barometric pressure in inches of mercury
+	PressureBars          *float64 // Barometric pressure in bars
+	AirTemperature        *float64 // Air temperature in degree Celsius
+	WaterTemperature      *float64 // Water temperature in degree Celsius
+	RelativeHumidity      *float64 // Relative humidity in percent
+	AbsoluteHumidity      *float64 // Absolute humidity in percent
+	DewPoint              *float64 // Dew point in degree Celsius
+	WindDirectionTrue     *float64 // Direction the wind blows from in degree (true)
+	WindDirectionMagnetic *float64 // Direction the wind blows from in degree (magnetic)
+	WindSpeedKnots        *float64 // Wind speed in knots
+	WindSpeedMeters       *float64 // Wind speed in meters per second
+}
+
+// Pressure return barometric pressure in the unit, false if not provided
+func (m GPMDA) Pressure(unit PressureUnit) (float64, bool) {
+	return pressureIn(unit, m.PressureBars, m.PressureInches)
+}
+
+// mdaField describe a quantity of MDA sentence: data field index, value,
+// fixed unit field (empty for quantities without unit field) and format
+type mdaField struct {
+	i      int
+	v      **float64
+	unit   string
+	format string
+}
+
+// fields return the quantities of the sentence
+func (m *GPMDA) fields() []mdaField {
+	return []mdaField{
+		{0, &m.PressureInches, InchesOfMercury.Serialize(), "%.4f"},
+		{2, &m.PressureBars, Bars.Serialize(), "%.4f"},
+		{4, &m.AirTemperature, "C", "%.1f"},
+		{6, &m.WaterTemperature, "C", "%.1f"},
+		{8, &m.RelativeHumidity, "", "%.1f"},
+		{9, &m.AbsoluteHumidity, "", "%.1f"},
+		{10, &m.DewPoint, "C", "%.1f"},
+		{12, &m.WindDirectionTrue, "T", "%.1f"},
+		{14, &m.WindDirectionMagnetic, "M", "%.1f"},
+		{16, &m.WindSpeedKnots, "N", "%.1f"},
+		{18, &m.WindSpeedMeters, "M", "%.1f"},
+	}
+}
+
+func (m *GPMDA) parse() (err error) {
+	if len(m.Fields) != 20 {
+		return m.Error(fmt.Errorf("Incomplete GPMDA message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 20))
+	}
+
+	for _, f := range m.fields() {
+		if *f.v, err = parseOptionalFloat(m.Fields[f.i]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse data field %d (got: %s)", f.i+1, m.Fields[f.i]))
+		}
+
+		// Validate fixed field, unit may be omitted with its value
+		if len(f.unit) > 0 && m.Fields[f.i+1] != f.unit && (*f.v != nil || len(m.Fields[f.i+1]) > 0) {
+			return m.Error(fmt.Errorf("Invalid fixed field at %d (got: %s, wanted: %s)", f.i+2, m.Fields[f.i+1], f.unit))
+		}
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence MDA as string
+func (m GPMDA) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPMDA")
+	fields := make([]string, 0)
+	for _, f := range m.fields() {
+		fields = append(fields, formatOptionalFloat(*f.v, f.format))
+		if len(f.unit) > 0 {
+			fields = append(fields, f.unit)
+		}
+	}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -0,0 +1,77 @@
+package nmea
+
+import (
+	"fmt"
+)
+
+/*
+MHU Humidity
+       1   2   3   4 5
+       |   |   |   | |
+$--MHU,x.x,x.x,x.x,C*hh
+
+1) Relative humidity, percent
+2) Absolute humidity, percent
+3) Dew point, degrees Celsius
+4) C = Celsius
+5) Checksum
+
+Examples:
+$WIMHU,42.3,,5.0,C*3D
+$IIMHU,87.5,12.1,15.6,C*07
+*/
+
+// NewGPMHU allocate GPMHU struct for MHU sentence (Humidity),
+// also used for other talkers (ie: IIMHU, WIMHU)
+func NewGPMHU(m Message) *GPMHU {
+	return &GPMHU{Message: m}
+}
+
+// GPMHU struct
+type GPMHU struct {
+	Message
+
+	RelativeHumidity *float64 // Relative humidity in percent, nil if not provided
+	AbsoluteHumidity *float64 // Absolute humidity in percent, nil if not provided
+	DewPoint         *float64 // Dew point in degree Celsius, nil if not provided
+}
+
+func (m *GPMHU) parse() (err error) {
+	if len(m.Fields) != 4 {
+		return m.Error(fmt.Errorf("Incomplete GPMHU message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 4))
+	}
+
+	// Validate fixed field
+	if m.Fields[3] != "C" {
+		return m.Error(fmt.Errorf("Invalid fixed field at %d (got: %s, wanted: %s)", 4, m.Fields[3], "C"))
+	}
+
+	if m.RelativeHumidity, err = parseOptionalFloat(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse relative humidity from data field (got: %s)", m.Fields[0]))
+	}
+
+	if m.AbsoluteHumidity, err = parseOptionalFloat(m.Fields[1]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse absolute humidity from data field (got: %s)", m.Fields[1]))
+	}
+
+	if m.DewPoint, err = parseOptionalFloat(m.Fields[2]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse dew point from data field (got: %s)", m.Fields[2]))
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence MHU as string
+func (m GPMHU) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPMHU")
+	fields := make([]string, 0)
+	fields = append(fields,
+		formatOptionalFloat(m.RelativeHumidity, "%.1f"),
+		formatOptionalFloat(m.AbsoluteHumidity, "%.1f"),
+		formatOptionalFloat(m.DewPoint, "%.1f"), "C")
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -0,0 +1,89 @@
+package nmea
+
+import (
+	"fmt"
+)
+
+/*
+MMB Barometer
+       1   2 3   4 5
+       |   | |   | |
+$--MMB,x.x,I,x.x,B*hh
+
+1) Barometric pressure, inches of mercury
+2) I = Inches of mercury
+3) Barometric pressure, bars
+4) B = Bars
+5) Checksum
+
+Examples:
+$WIMMB,30.2269,I,1.0236,B*6D
+$IIMMB,29.9213,I,1.0132,B*7A
+*/
+
+// NewGPMMB allocate GPMMB struct for MMB sentence (Barometer),
+// also used for other talkers (ie: IIMMB, WIMMB)
+func NewGPMMB(m Message) *GPMMB {
+	return &GPMMB{Message: m}
+}
+
+// GPMMB struct
+type GPMMB struct {
+	Message
+
+	PressureInches *float64 // Barometric pressure in inches of mercury, nil if not provided
+	PressureBars   *float64 // Barometric pressure in bars, nil if not provided
+}
+
+// Pressure return barometric pressure in the unit, false if not provided
+func (m GPMMB) Pressure(unit PressureUnit) (float64, bool) {
+	return pressureIn(unit, m.PressureBars, m.PressureInches)
+}
+
+// pressureIn return pressure in the unit, from the value in bars or else in inches of mercury
+func pressureIn(unit PressureUnit, bars, inches *float64) (float64, bool) {
+	switch {
+	case bars != nil:
+		return unit.FromBars(*bars), true
+	case inches != nil:
+		return unit.FromBars(InchesOfMercury.ToBars(*inches)), true
+	}
+	return 0, false
+}
+
+func (m *GPMMB) parse() (err error) {
+	if len(m.Fields) != 4 {
+		return m.Error(fmt.Errorf("Incomplete GPMMB message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 4))
+	}
+
+	// Validate fixed field
+	for i, v := range map[int]string{1: InchesOfMercury.Serialize(), 3: Bars.Serialize()} {
+		if m.Fields[i] != v {
+			return m.Error(fmt.Errorf("Invalid fixed field at %d (got: %s, wanted: %s)", i+1, m.Fields[i], v))
+		}
+	}
+
+	if m.PressureInches, err = parseOptionalFloat(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse pressure from data field (got: %s)", m.Fields[0]))
+	}
+
+	if m.PressureBars, err = parseOptionalFloat(m.Fields[2]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse pressure from data field (got: %s)", m.Fields[2]))
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence MMB as string
+func (m GPMMB) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPMMB")
+	fields := make([]string, 0)
+	fields = append(fields,
+		formatOptionalFloat(m.PressureInches, "%.4f"), InchesOfMercury.Serialize(),
+		formatOptionalFloat(m.PressureBars, "%.4f"), Bars.Serialize())
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -0,0 +1,292 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/*
+MOB Man Over Board Notification (NMEA 4.11)
+       1     2 3         4 5      6         7       8 9        10 11  12  13        14
+       |     | |         | |      |         |       | |        |  |   |   |         |
+$--MOB,hhhhh,a,hhmmss.ss,x,xxxxxx,hhmmss.ss,llll.ll,a,yyyyy.yy,a,x.x,x.x,xxxxxxxxx,x*hh
+
+1) Emitter ID, 5 hexadecimal digits
+2) MOB status:
+	A = MOB activated
+	T = test mode
+	M = manual button
+	V = MOB not in use
+	E = error
+3) Time of MOB activation, UTC
+4) Position source, 0 = estimated by the vessel, 1 = reported by MOB emitter
+5) Date of position, ddmmyy
+6) Time of position, UTC
+7) Latitude
+8) N or S
+9) Longitude
+10) E or W
+11) Course over ground, degrees true
+12) Speed over ground, knots
+13) MMSI of vessel
+14) Battery status, 0 = good, 1 = low
+15) Checksum
+
+Examples:
+$IIMOB,12A3F,A,135407.60,0,131218,135608.13,5106.25,N,12149.76,E,231.7,1.2,538007213,0*3A
+$IIMOB,12A3F,V,,,,,,,,,,,,*21
+*/
+
+// NewGPMOB allocate GPMOB struct for MOB sentence (Man over board notification),
+// also used for other talkers (ie: IIMOB)
+func NewGPMOB(m Message) *GPMOB {
+	return &GPMOB{Message: m}
+}
+
+// GPMOB struct
+type GPMOB struct {
+	Message
+
+	EmitterID      string // 5 hexadecimal digits
+	Status         MOBStatus
+	ActivationTime *TimeOfDay // Time UTC of MOB activation, nil if not provided
+	PositionSource MOBPositionSource
+	PositionTime   time.Time // Aggregation of date and time of position, zero if not provided
+	Position       Position
+	COG            *float64 // Course over ground in degree, nil if not provided
+	SOG            *float64 // Speed over ground in knots, nil if not provided
+	MMSI           int      // MMSI of vessel, 0 if not provided
+	LowBattery     bool
+}
+
+// NewManOverboard return the MOB sentence notifying the activation of the
+// emitter at time t, from the fix of the vessel
+func NewManOverboard(emitterID string, t TimeOfDay, f Fix, mmsi int) GPMOB {
+	cog, sog := f.COG, f.Speed
+	return GPMOB{
+		EmitterID:      emitterID,
+		Status:         MOBActivated,
+		ActivationTime: &t,
+		PositionSource: EstimatedPosition,
+		PositionTime:   f.Time,
+		Position:       f.Position,
+		COG:            &cog,
+		SOG:            &sog,
+		MMSI:           mmsi,
+	}
+}
+
+func (m *GPMOB) parse() (err error) {
+	if len(m.Fields) != 14 {
+		return m.Error(fmt.Errorf("Incomplete GPMOB message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 14))
+	}
+
+	if len(m.Fields[0]) > 0 {
+		if _, err = strconv.ParseUint(m.Fields[0], 16, 32); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse emitter ID from data field (got: %s)", m.Fields[0]))
+		}
+	}
+	m.EmitterID = m.Fields[0]
+
+	if m.Status, err = ParseMOBStatus(m.Fields[1]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse MOB status from data field (got: %s)", m.Fields[1]))
+	}
+
+	if len(m.Fields[2]) > 0 {
+		t, err := ParseTimeOfDay(m.Fields[2])
+		if err != nil {
+			return m.Error(fmt.Errorf("Unable to parse activation time from data field (got: %s)", m.Fields[2]))
+		}
+		m.ActivationTime = &t
+	}
+
+	if len(m.Fields[3]) > 0 {
+		if m.PositionSource, err = ParseMOBPositionSource(m.Fields[3]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse position source from data field (got: %s)", m.Fields[3]))
+		}
+	}
+
+	if len(m.Fields[4]) > 0 || len(m.Fields[5]) > 0 {
+		datetime := fmt.Sprintf("%s %s", m.Fields[4], m.Fields[5])
+		if m.PositionTime, err = time.Parse("020106 150405", datetime); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse position datetime from data field (got: %s)", datetime))
+		}
+		// Apply century policy on two-digit year
+		m.PositionTime = m.PositionTime.AddDate(ExpandYear(m.PositionTime.Year()%100, DefaultCenturyPivot)-m.PositionTime.Year(), 0, 0)
+	}
+
+	if latitude := strings.TrimSpace(strings.Join(m.Fields[6:8], " ")); len(latitude) > 0 {
+		if m.Position.Latitude, err = NewLatLong(latitude); err != nil {
+			return m.Error(err)
+		}
+	}
+
+	if longitude := strings.TrimSpace(strings.Join(m.Fields[8:10], " ")); len(longitude) > 0 {
+		if m.Position.Longitude, err = NewLatLong(longitude); err != nil {
+			return m.Error(err)
+		}
+	}
+
+	if m.COG, err = parseOptionalFloat(m.Fields[10]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse course over ground from data field (got: %s)", m.Fields[10]))
+	}
+
+	if m.SOG, err = parseOptionalFloat(m.Fields[11]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse speed over ground from data field (got: %s)", m.Fields[11]))
+	}
+
+	if len(m.Fields[12]) > 0 {
+		if m.MMSI, err = strconv.Atoi(m.Fields[12]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse MMSI from data field (got: %s)", m.Fields[12]))
+		}
+	}
+
+	switch m.Fields[13] {
+	case "0", "":
+	case "1":
+		m.LowBattery = true
+	default:
+		return m.Error(fmt.Errorf("Unable to parse battery status from data field (got: %s)", m.Fields[13]))
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence MOB as string
+func (m GPMOB) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPMOB")
+	fields := make([]string, 0)
+	fields = append(fields, m.EmitterID, m.Status.Serialize())
+
+	if m.ActivationTime != nil {
+		fields = append(fields, m.ActivationTime.Serialize())
+	} else {
+		fields = append(fields, "")
+	}
+
+	fields = append(fields, m.PositionSource.Serialize())
+
+	if !m.PositionTime.IsZero() {
+		fields = append(fields, m.PositionTime.Format("020106"), m.PositionTime.Format("150405.00"))
+	} else {
+		fields = append(fields, "", "")
+	}
+
+	fields = append(fields, serializeOptionalPosition(m.Position.Latitude, m.Position.Longitude, 2)...)
+	fields = append(fields,
+		formatOptionalFloat(m.COG, "%.1f"),
+		formatOptionalFloat(m.SOG, "%.1f"))
+
+	if m.MMSI > 0 {
+		fields = append(fields, fmt.Sprintf("%09d", m.MMSI))
+	} else {
+		fields = append(fields, "")
+	}
+
+	switch {
+	case m.LowBattery:
+		fields = append(fields, "1")
+	case m.Status == MOBNotInUse:
+		fields = append(fields, "")
+	default:
+		fields = append(fields, "0")
+	}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+const (
+	// MOBActivated is a MOBStatus type as string "A"
+	MOBActivated MOBStatus = "A"
+	// MOBTest is a MOBStatus type as string "T"
+	MOBTest MOBStatus = "T"
+	// MOBManual is a MOBStatus type as string "M" (manual button)
+	MOBManual MOBStatus = "M"
+	// MOBNotInUse is a MOBStatus type as string "V"
+	MOBNotInUse MOBStatus = "V"
+	// MOBError is a MOBStatus type as string "E"
+	MOBError MOBStatus = "E"
+)
+
+// MOBStatus type as string
+type MOBStatus string
+
+// Serialize return MOBStatus as string
+func (s MOBStatus) Serialize() string {
+	return string(s)
+}
+
+// String return MOBStatus as human string
+func (s MOBStatus) String() string {
+	switch s {
+	case MOBActivated:
+		return "activated"
+	case MOBTest:
+		return "test"
+	case MOBManual:
+		return "manual button"
+	case MOBNotInUse:
+		return "not in use"
+	case MOBError:
+		return "error"
+	default:
+		return "unknow"
+	}
+}
+
+// ParseMOBStatus check MOBStatus validity, return an error
+// "unknow value" if not
+func ParseMOBStatus(raw string) (s MOBStatus, err error) {
+	s = MOBStatus(raw)
+	switch s {
+	case MOBActivated, MOBTest, MOBManual, MOBNotInUse, MOBError:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
+
+const (
+	// EstimatedPosition is a MOBPositionSource type as string "0" (estimated by the vessel)
+	EstimatedPosition MOBPositionSource = "0"
+	// ReportedPosition is a MOBPositionSource type as string "1" (reported by MOB emitter)
+	ReportedPosition MOBPositionSource = "1"
+)
+
+// MOBPositionSource type as string
+type MOBPositionSource string
+
+// Serialize return MOBPositionSource as string
+func (s MOBPositionSource) Serialize() string {
+	return string(s)
+}
+
+// String return MOBPositionSource as human string
+func (s MOBPositionSource) String() string {
+	switch s {
+	case EstimatedPosition:
+		return "estimated by vessel"
+	case ReportedPosition:
+		return "reported by emitter"
+	default:
+		return "unknow"
+	}
+}
+
+// ParseMOBPositionSource check MOBPositionSource validity, return an error
+// "unknow value" if not
+func ParseMOBPositionSource(raw string) (s MOBPositionSource, err error) {
+	s = MOBPositionSource(raw)
+	switch s {
+	case EstimatedPosition, ReportedPosition:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
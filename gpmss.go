@@ -0,0 +1,93 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+MSS MSK Receiver Signal Status
+       1   2   3   4   5   6
+       |   |   |   |   |   |
+$--MSS,x.x,x.x,x.x,x.x,xxx*hh
+
+1) Signal strength, dB/1uV per meter
+2) Signal-to-noise ratio, dB
+3) Beacon frequency, 283.5 to 325.0 kHz
+4) Beacon bit rate, 25, 50, 100 or 200 bits per second
+5) Channel number (NMEA 2.3 and later)
+6) Checksum
+
+Examples:
+$GPMSS,55,27,318.0,100,1*57
+$GPMSS,48,15,307.5,200*4F
+*/
+
+// NewGPMSS allocate GPMSS struct for MSS sentence (beacon receiver signal status)
+func NewGPMSS(m Message) *GPMSS {
+	return &GPMSS{Message: m}
+}
+
+// GPMSS struct
+type GPMSS struct {
+	Message
+
+	SignalStrength  float64 // Signal strength in dB/1uV per meter
+	SNR             float64 // Signal-to-noise ratio in dB
+	BeaconFrequency float64 // Beacon frequency in kHz
+	BitRate         int     // Beacon bit rate in bits per second
+	Channel         *int    // Channel number, nil if not provided (before NMEA 2.3)
+}
+
+func (m *GPMSS) parse() (err error) {
+	if len(m.Fields) != 4 && len(m.Fields) != 5 {
+		return m.Error(fmt.Errorf("Incomplete GPMSS message, not enougth data fields (got: %d, wanted: %d or %d)", len(m.Fields), 4, 5))
+	}
+
+	if m.SignalStrength, err = strconv.ParseFloat(m.Fields[0], 64); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse signal strength from data field (got: %s)", m.Fields[0]))
+	}
+
+	if m.SNR, err = strconv.ParseFloat(m.Fields[1], 64); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse signal-to-noise ratio from data field (got: %s)", m.Fields[1]))
+	}
+
+	if m.BeaconFrequency, err = strconv.ParseFloat(m.Fields[2], 64); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse beacon frequency from data field (got: %s)", m.Fields[2]))
+	}
+
+	if m.BitRate, err = strconv.Atoi(m.Fields[3]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse beacon bit rate from data field (got: %s)", m.Fields[3]))
+	}
+
+	if len(m.Fields) == 5 && len(m.Fields[4]) > 0 {
+		channel, err := strconv.Atoi(m.Fields[4])
+		if err != nil {
+			return m.Error(fmt.Errorf("Unable to parse channel number from data field (got: %s)", m.Fields[4]))
+		}
+		m.Channel = &channel
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence MSS as string
+func (m GPMSS) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPMSS")
+	fields := make([]string, 0)
+	fields = append(fields,
+		strconv.FormatFloat(m.SignalStrength, 'f', -1, 64),
+		strconv.FormatFloat(m.SNR, 'f', -1, 64),
+		fmt.Sprintf("%.1f", m.BeaconFrequency),
+		strconv.Itoa(m.BitRate))
+
+	if m.Channel != nil {
+		fields = append(fields, strconv.Itoa(*m.Channel))
+	}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -0,0 +1,73 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+MTA Air Temperature
+       1   2 3
+       |   | |
+$--MTA,x.x,C*hh
+
+1) Temperature, degrees Celsius
+2) C = Celsius
+3) Checksum
+
+Examples:
+$WIMTA,21.3,C*1B
+$IIMTA,-3.2,C*19
+*/
+
+// Plausible range of air temperature in degree Celsius, based on records
+const (
+	MinAirTemperature = -90.0
+	MaxAirTemperature = 60.0
+)
+
+// NewGPMTA allocate GPMTA struct for MTA sentence (Air temperature),
+// also used for other talkers (ie: IIMTA, WIMTA)
+func NewGPMTA(m Message) *GPMTA {
+	return &GPMTA{Message: m}
+}
+
+// GPMTA struct
+type GPMTA struct {
+	Message
+
+	Temperature float64 // Air temperature in degree Celsius
+}
+
+func (m *GPMTA) parse() (err error) {
+	if len(m.Fields) != 2 {
+		return m.Error(fmt.Errorf("Incomplete GPMTA message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 2))
+	}
+
+	// Validate fixed field
+	if m.Fields[1] != "C" {
+		return m.Error(fmt.Errorf("Invalid fixed field at %d (got: %s, wanted: %s)", 2, m.Fields[1], "C"))
+	}
+
+	if m.Temperature, err = strconv.ParseFloat(m.Fields[0], 64); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse temperature from data field (got: %s)", m.Fields[0]))
+	}
+
+	if m.Temperature < MinAirTemperature || m.Temperature > MaxAirTemperature {
+		return m.Error(fmt.Errorf("Invalid air temperature, out of range [%.0f, %.0f] (got: %s)", MinAirTemperature, MaxAirTemperature, m.Fields[0]))
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence MTA as string
+func (m GPMTA) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPMTA")
+	fields := make([]string, 0)
+	fields = append(fields, fmt.Sprintf("%.1f", m.Temperature), "C")
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
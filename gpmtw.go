@@ -0,0 +1,63 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+MTW Mean Temperature of Water
+       1   2 3
+       |   | |
+$--MTW,x.x,C*hh
+
+1) Temperature, degrees Celsius
+2) C = Celsius
+3) Checksum
+
+Examples:
+$IIMTW,17.8,C*1D
+$GPMTW,-1.5,C*1D
+*/
+
+// NewGPMTW allocate GPMTW struct for MTW sentence (Mean temperature of water),
+// also used for other talkers (ie: IIMTW)
+func NewGPMTW(m Message) *GPMTW {
+	return &GPMTW{Message: m}
+}
+
+// GPMTW struct
+type GPMTW struct {
+	Message
+
+	Temperature float64 // Water temperature in degree Celsius
+}
+
+func (m *GPMTW) parse() (err error) {
+	if len(m.Fields) != 2 {
+		return m.Error(fmt.Errorf("Incomplete GPMTW message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 2))
+	}
+
+	// Validate fixed field
+	if m.Fields[1] != "C" {
+		return m.Error(fmt.Errorf("Invalid fixed field at %d (got: %s, wanted: %s)", 2, m.Fields[1], "C"))
+	}
+
+	if m.Temperature, err = strconv.ParseFloat(m.Fields[0], 64); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse temperature from data field (got: %s)", m.Fields[0]))
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence MTW as string
+func (m GPMTW) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPMTW")
+	fields := make([]string, 0)
+	fields = append(fields, fmt.Sprintf("%.1f", m.Temperature), "C")
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
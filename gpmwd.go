@@ -0,0 +1,94 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+MWD Wind Direction and Speed
+       1   2 3   4 5   6 7   8 9
+       |   | |   | |   | |   | |
+$--MWD,x.x,T,x.x,M,x.x,N,x.x,M*hh
+
+1) Wind direction, degrees true
+2) T = True
+3) Wind direction, degrees magnetic
+4) M = Magnetic
+5) Wind speed, knots
+6) N = Knots
+7) Wind speed, meters/second
+8) M = Meters/second
+9) Checksum
+
+Examples:
+$WIMWD,186.7,T,190.4,M,9.7,N,5.0,M*55
+$IIMWD,,T,,M,12.4,N,6.4,M*71
+*/
+
+// NewGPMWD allocate GPMWD struct for MWD sentence (Wind direction and speed),
+// also used for other talkers (ie: IIMWD, WIMWD)
+func NewGPMWD(m Message) *GPMWD {
+	return &GPMWD{Message: m}
+}
+
+// GPMWD struct
+type GPMWD struct {
+	Message
+
+	DirectionTrue     *float64 // Direction the wind blows from in degree (true), nil if not provided
+	DirectionMagnetic *float64 // Direction the wind blows from in degree (magnetic), nil if not provided
+	SpeedKnots        float64  // Wind speed in knots
+	SpeedMeters       float64  // Wind speed in meters per second
+}
+
+func (m *GPMWD) parse() (err error) {
+	if len(m.Fields) != 8 {
+		return m.Error(fmt.Errorf("Incomplete GPMWD message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 8))
+	}
+
+	// Validate fixed field
+	for i, v := range map[int]string{1: "T", 3: "M", 5: "N", 7: "M"} {
+		if m.Fields[i] != v {
+			return m.Error(fmt.Errorf("Invalid fixed field at %d (got: %s, wanted: %s)", i+1, m.Fields[i], v))
+		}
+	}
+
+	if m.DirectionTrue, err = parseOptionalFloat(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse true wind direction from data field (got: %s)", m.Fields[0]))
+	}
+
+	if m.DirectionMagnetic, err = parseOptionalFloat(m.Fields[2]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse magnetic wind direction from data field (got: %s)", m.Fields[2]))
+	}
+
+	if len(m.Fields[4]) > 0 {
+		if m.SpeedKnots, err = strconv.ParseFloat(m.Fields[4], 64); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse wind speed from data field (got: %s)", m.Fields[4]))
+		}
+	}
+
+	if len(m.Fields[6]) > 0 {
+		if m.SpeedMeters, err = strconv.ParseFloat(m.Fields[6], 64); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse wind speed from data field (got: %s)", m.Fields[6]))
+		}
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence MWD as string
+func (m GPMWD) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPMWD")
+	fields := make([]string, 0)
+	fields = append(fields,
+		formatOptionalFloat(m.DirectionTrue, "%.1f"), "T",
+		formatOptionalFloat(m.DirectionMagnetic, "%.1f"), "M",
+		fmt.Sprintf("%.1f", m.SpeedKnots), "N",
+		fmt.Sprintf("%.1f", m.SpeedMeters), "M")
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
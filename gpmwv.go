@@ -0,0 +1,132 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+MWV Wind Speed and Angle
+       1   2 3   4 5 6
+       |   | |   | | |
+$--MWV,x.x,a,x.x,a,A*hh
+
+1) Wind angle, 0 to 359 degrees
+2) Reference, R = Relative, T = Theoretical (true)
+3) Wind speed
+4) Wind speed units, K = km/h, M = m/s, N = Knots
+5) Status, A = Data valid, V = Invalid
+6) Checksum
+
+Examples:
+$WIMWV,214.8,R,0.1,K,A*28
+$IIMWV,041.0,T,12.4,N,A*09
+*/
+
+// NewGPMWV allocate GPMWV struct for MWV sentence (Wind speed and angle),
+// also used for other talkers (ie: IIMWV, WIMWV)
+func NewGPMWV(m Message) *GPMWV {
+	return &GPMWV{Message: m}
+}
+
+// GPMWV struct
+type GPMWV struct {
+	Message
+
+	Angle     float64       // Wind angle relative to the bow in degree (0 ~ 360)
+	Reference WindReference // Relative (apparent) or theoretical (true) wind
+	Speed     float64       // Wind speed in SpeedUnit
+	SpeedUnit SpeedUnit
+	IsValid   DataValid
+}
+
+// SpeedKnots return wind speed in knots
+func (m GPMWV) SpeedKnots() float64 {
+	return m.SpeedUnit.ToKnots(m.Speed)
+}
+
+func (m *GPMWV) parse() (err error) {
+	if len(m.Fields) != 5 {
+		return m.Error(fmt.Errorf("Incomplete GPMWV message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 5))
+	}
+
+	if len(m.Fields[0]) > 0 {
+		if m.Angle, err = strconv.ParseFloat(m.Fields[0], 64); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse wind angle from data field (got: %s)", m.Fields[0]))
+		}
+	}
+
+	if m.Reference, err = ParseWindReference(m.Fields[1]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse wind reference from data field (got: %s)", m.Fields[1]))
+	}
+
+	if len(m.Fields[2]) > 0 {
+		if m.Speed, err = strconv.ParseFloat(m.Fields[2], 64); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse wind speed from data field (got: %s)", m.Fields[2]))
+		}
+	}
+
+	if m.SpeedUnit, err = ParseSpeedUnit(m.Fields[3]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse wind speed unit from data field (got: %s)", m.Fields[3]))
+	}
+
+	m.IsValid = (m.Fields[4] == "A")
+
+	return nil
+}
+
+// Serialize return a valid sentence MWV as string
+func (m GPMWV) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPMWV")
+	fields := make([]string, 0)
+	fields = append(fields,
+		fmt.Sprintf("%05.1f", m.Angle),
+		m.Reference.Serialize(),
+		fmt.Sprintf("%.1f", m.Speed),
+		m.SpeedUnit.Serialize(),
+		m.IsValid.Serialize())
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+const (
+	// RelativeWind is a WindReference type as string "R"
+	RelativeWind WindReference = "R"
+	// TheoreticalWind is a WindReference type as string "T"
+	TheoreticalWind WindReference = "T"
+)
+
+// WindReference type as string
+type WindReference string
+
+// Serialize return WindReference as string
+func (r WindReference) Serialize() string {
+	return string(r)
+}
+
+// String return WindReference as human string
+func (r WindReference) String() string {
+	switch r {
+	case RelativeWind:
+		return "relative"
+	case TheoreticalWind:
+		return "theoretical"
+	default:
+		return "unknow"
+	}
+}
+
+// ParseWindReference check WindReference validity, return an error
+// "unknow value" if not
+func ParseWindReference(raw string) (r WindReference, err error) {
+	r = WindReference(raw)
+	switch r {
+	case RelativeWind, TheoreticalWind:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
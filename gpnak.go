@@ -0,0 +1,144 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+NAK Negative Acknowledgement
+       1  2   3    4   5
+       |  |   |    |   |
+$--NAK,cc,ccc,c--c,x.x,c--c*hh
+
+1) Talker identifier of the affected sentence
+2) Affected sentence formatter
+3) Unique identifier, null if not used
+4) Reason code:
+	0 = query functionality not supported
+	1 = sentence formatter not supported
+	2 = sentence formatter supported, but not enabled
+	3 = sentence formatter supported and enabled, but temporarily unavailable
+	4 = sentence formatter supported, but query for this sentence is not supported
+	5 = access denied, for sentence formatter requested
+	6 = sentence not accepted due to bad checksum
+	7 = sentence not accepted due to listener processing issue
+	49 = other reason as described in data field 5
+5) Descriptive text
+6) Checksum
+
+Examples:
+$IINAK,GP,GGA,,1,Formatter not supported*54
+$GPNAK,AG,HTC,01,6,Bad checksum*7B
+*/
+
+// NewGPNAK allocate GPNAK struct for NAK sentence (Negative acknowledgement),
+// also used for other talkers (ie: IINAK)
+func NewGPNAK(m Message) *GPNAK {
+	return &GPNAK{Message: m}
+}
+
+// GPNAK struct
+type GPNAK struct {
+	Message
+
+	Talker      TalkerID // Talker of the affected sentence
+	Formatter   string   // Formatter of the affected sentence
+	UniqueID    string   // Unique identifier, empty if not used
+	Reason      NAKReason
+	Description string
+}
+
+// NewNAK return the NAK sentence rejecting the sentence with header hdr
+func NewNAK(hdr Header, reason NAKReason, description string) GPNAK {
+	id := hdr.GetTypeID()
+	return GPNAK{Talker: id.Talker, Formatter: id.Code, Reason: reason, Description: description}
+}
+
+func (m *GPNAK) parse() (err error) {
+	if len(m.Fields) != 5 {
+		return m.Error(fmt.Errorf("Incomplete GPNAK message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 5))
+	}
+
+	m.Talker = TalkerID(m.Fields[0])
+	m.Formatter = m.Fields[1]
+	m.UniqueID = m.Fields[2]
+
+	reason, err := strconv.Atoi(m.Fields[3])
+	if err != nil || reason < 0 || reason > 99 {
+		return m.Error(fmt.Errorf("Unable to parse reason code from data field (got: %s)", m.Fields[3]))
+	}
+	m.Reason = NAKReason(reason)
+
+	m.Description = m.Fields[4]
+
+	return nil
+}
+
+// Serialize return a valid sentence NAK as string
+func (m GPNAK) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPNAK")
+	fields := make([]string, 0)
+	fields = append(fields,
+		m.Talker.Serialize(),
+		m.Formatter,
+		m.UniqueID,
+		strconv.Itoa(int(m.Reason)),
+		m.Description)
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+const (
+	// QueryNotSupported is a NAKReason type as int 0
+	QueryNotSupported NAKReason = 0
+	// FormatterNotSupported is a NAKReason type as int 1
+	FormatterNotSupported NAKReason = 1
+	// FormatterNotEnabled is a NAKReason type as int 2
+	FormatterNotEnabled NAKReason = 2
+	// FormatterUnavailable is a NAKReason type as int 3 (temporarily unavailable)
+	FormatterUnavailable NAKReason = 3
+	// FormatterQueryNotSupported is a NAKReason type as int 4
+	FormatterQueryNotSupported NAKReason = 4
+	// AccessDenied is a NAKReason type as int 5
+	AccessDenied NAKReason = 5
+	// BadChecksum is a NAKReason type as int 6
+	BadChecksum NAKReason = 6
+	// ListenerProcessingIssue is a NAKReason type as int 7
+	ListenerProcessingIssue NAKReason = 7
+	// OtherReason is a NAKReason type as int 49 (described by text)
+	OtherReason NAKReason = 49
+)
+
+// NAKReason type as int, reason code of a negative acknowledgement
+type NAKReason int
+
+// String return NAKReason as human string
+func (r NAKReason) String() string {
+	switch r {
+	case QueryNotSupported:
+		return "query functionality not supported"
+	case FormatterNotSupported:
+		return "sentence formatter not supported"
+	case FormatterNotEnabled:
+		return "sentence formatter not enabled"
+	case FormatterUnavailable:
+		return "sentence formatter temporarily unavailable"
+	case FormatterQueryNotSupported:
+		return "query for sentence formatter not supported"
+	case AccessDenied:
+		return "access denied"
+	case BadChecksum:
+		return "bad checksum"
+	case ListenerProcessingIssue:
+		return "listener processing issue"
+	case OtherReason:
+		return "other reason"
+	default:
+		return "unknow"
+	}
+}
@@ -0,0 +1,180 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+OSD Own Ship Data
+       1   2 3   4 5   6 7   8   9 10
+       |   | |   | |   | |   |   | |
+$--OSD,x.x,A,x.x,a,x.x,a,x.x,x.x,a*hh
+
+1) Heading, degrees true
+2) Status, A = Data valid, V = Invalid
+3) Vessel course, degrees true
+4) Course reference, B/M/W/R/P
+5) Vessel speed
+6) Speed reference, B/M/W/R/P
+7) Vessel set, degrees true
+8) Vessel drift (speed)
+9) Speed units, K = km/h, N = Knots, S = statute miles/h
+10) Checksum
+
+Reference: B = Bottom tracking log, M = Manually entered, W = Water referenced,
+R = Radar tracking of fixed target, P = Positioning system ground reference
+
+Examples:
+$RAOSD,35.1,A,36.0,P,10.2,P,15.3,0.1,N*41
+$INOSD,182.4,V,180.0,W,6.5,B,,,K*50
+*/
+
+// NewGPOSD allocate GPOSD struct for OSD sentence (Own ship data),
+// also used for other talkers (ie: RAOSD, INOSD)
+func NewGPOSD(m Message) *GPOSD {
+	return &GPOSD{Message: m}
+}
+
+// GPOSD struct
+type GPOSD struct {
+	Message
+
+	Heading         float64 // Heading in degree (true)
+	IsValid         DataValid
+	Course          float64         // Vessel course in degree (true)
+	CourseReference ReferenceSystem // Course reference system
+	Speed           float64         // Vessel speed in SpeedUnit
+	SpeedReference  ReferenceSystem // Speed reference system
+	Set             *float64        // Vessel set in degree (true), nil if not provided
+	Drift           *float64        // Vessel drift in SpeedUnit, nil if not provided
+	SpeedUnit       SpeedUnit
+}
+
+// Current return set and drift as Current in knots, false if not provided
+func (m GPOSD) Current() (Current, bool) {
+	if m.Set == nil || m.Drift == nil {
+		return Current{}, false
+	}
+	return Current{Set: *m.Set, Drift: m.SpeedUnit.ToKnots(*m.Drift)}, true
+}
+
+func (m *GPOSD) parse() (err error) {
+	if len(m.Fields) != 9 {
+		return m.Error(fmt.Errorf("Incomplete GPOSD message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 9))
+	}
+
+	if len(m.Fields[0]) > 0 {
+		if m.Heading, err = strconv.ParseFloat(m.Fields[0], 64); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse heading from data field (got: %s)", m.Fields[0]))
+		}
+	}
+
+	m.IsValid = (m.Fields[1] == "A")
+
+	if len(m.Fields[2]) > 0 {
+		if m.Course, err = strconv.ParseFloat(m.Fields[2], 64); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse course from data field (got: %s)", m.Fields[2]))
+		}
+	}
+
+	if m.CourseReference, err = ParseReferenceSystem(m.Fields[3]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse course reference from data field (got: %s)", m.Fields[3]))
+	}
+
+	if len(m.Fields[4]) > 0 {
+		if m.Speed, err = strconv.ParseFloat(m.Fields[4], 64); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse speed from data field (got: %s)", m.Fields[4]))
+		}
+	}
+
+	if m.SpeedReference, err = ParseReferenceSystem(m.Fields[5]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse speed reference from data field (got: %s)", m.Fields[5]))
+	}
+
+	if m.Set, err = parseOptionalFloat(m.Fields[6]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse set from data field (got: %s)", m.Fields[6]))
+	}
+
+	if m.Drift, err = parseOptionalFloat(m.Fields[7]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse drift from data field (got: %s)", m.Fields[7]))
+	}
+
+	if m.SpeedUnit, err = ParseSpeedUnit(m.Fields[8]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse speed unit from data field (got: %s)", m.Fields[8]))
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence OSD as string
+func (m GPOSD) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPOSD")
+	fields := make([]string, 0)
+	fields = append(fields,
+		fmt.Sprintf("%.1f", m.Heading),
+		m.IsValid.Serialize(),
+		fmt.Sprintf("%.1f", m.Course),
+		m.CourseReference.Serialize(),
+		fmt.Sprintf("%.1f", m.Speed),
+		m.SpeedReference.Serialize(),
+		formatOptionalFloat(m.Set, "%.1f"),
+		formatOptionalFloat(m.Drift, "%.1f"),
+		m.SpeedUnit.Serialize())
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+const (
+	// BottomTrackingLog is a ReferenceSystem type as string "B"
+	BottomTrackingLog ReferenceSystem = "B"
+	// ManuallyEntered is a ReferenceSystem type as string "M"
+	ManuallyEntered ReferenceSystem = "M"
+	// WaterReferenced is a ReferenceSystem type as string "W"
+	WaterReferenced ReferenceSystem = "W"
+	// RadarTracking is a ReferenceSystem type as string "R"
+	RadarTracking ReferenceSystem = "R"
+	// PositioningSystem is a ReferenceSystem type as string "P"
+	PositioningSystem ReferenceSystem = "P"
+)
+
+// ReferenceSystem type as string, reference of course and speed measurements
+type ReferenceSystem string
+
+// Serialize return ReferenceSystem as string
+func (r ReferenceSystem) Serialize() string {
+	return string(r)
+}
+
+// String return ReferenceSystem as human string
+func (r ReferenceSystem) String() string {
+	switch r {
+	case BottomTrackingLog:
+		return "bottom tracking log"
+	case ManuallyEntered:
+		return "manually entered"
+	case WaterReferenced:
+		return "water referenced"
+	case RadarTracking:
+		return "radar tracking"
+	case PositioningSystem:
+		return "positioning system"
+	default:
+		return "unknow"
+	}
+}
+
+// ParseReferenceSystem check ReferenceSystem validity, return an error
+// "unknow value" if not
+func ParseReferenceSystem(raw string) (r ReferenceSystem, err error) {
+	r = ReferenceSystem(raw)
+	switch r {
+	case BottomTrackingLog, ManuallyEntered, WaterReferenced, RadarTracking, PositioningSystem:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
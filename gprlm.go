@@ -0,0 +1,136 @@
+package nmea
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+)
+
+/*
+RLM Return Link Message
+       1               2         3 4
+       |               |         | |
+$--RLM,hhhhhhhhhhhhhhh,hhmmss.ss,h,h--h*hh
+
+1) Beacon ID, 15 hexadecimal digits
+2) Time of reception of the message, UTC
+3) Message code, 1 hexadecimal digit:
+	1 = acknowledgement service
+	2 = command service
+	3 = message service
+	F = test service
+4) Message body, hexadecimal
+5) Checksum
+
+Examples:
+$GARLM,9C7A4D2F3B00001,101523.00,1,2A40*71
+$GNRLM,2DD42A7F28C0001,063005.50,3,0C1F00A8E5*03
+*/
+
+// NewGPRLM allocate GPRLM struct for RLM sentence (Return link message),
+// also used for other talkers (ie: GARLM, GNRLM)
+func NewGPRLM(m Message) *GPRLM {
+	return &GPRLM{Message: m}
+}
+
+// GPRLM struct
+type GPRLM struct {
+	Message
+
+	BeaconID string // 15 hexadecimal digits
+	TimeUTC  TimeOfDay
+	Code     RLMCode
+	Body     string // Message body as hexadecimal digits
+}
+
+func (m *GPRLM) parse() (err error) {
+	if len(m.Fields) != 4 {
+		return m.Error(fmt.Errorf("Incomplete GPRLM message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 4))
+	}
+
+	if _, err = strconv.ParseUint(m.Fields[0], 16, 64); err != nil || len(m.Fields[0]) != 15 {
+		return m.Error(fmt.Errorf("Unable to parse beacon ID from data field (got: %s)", m.Fields[0]))
+	}
+	m.BeaconID = m.Fields[0]
+
+	if m.TimeUTC, err = ParseTimeOfDay(m.Fields[1]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse time UTC from data field (got: %s)", m.Fields[1]))
+	}
+
+	if _, err = strconv.ParseUint(m.Fields[2], 16, 8); err != nil || len(m.Fields[2]) != 1 {
+		return m.Error(fmt.Errorf("Unable to parse message code from data field (got: %s)", m.Fields[2]))
+	}
+	m.Code = RLMCode(m.Fields[2])
+
+	for _, c := range m.Fields[3] {
+		if _, err = strconv.ParseUint(string(c), 16, 8); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse message body from data field (got: %s)", m.Fields[3]))
+		}
+	}
+	m.Body = m.Fields[3]
+
+	return nil
+}
+
+// Data return message body as bytes, an odd last hexadecimal digit is
+// completed with 0
+func (m GPRLM) Data() []byte {
+	body := m.Body
+	if len(body)%2 != 0 {
+		body += "0"
+	}
+	data, _ := hex.DecodeString(body) // Already validated on parse
+	return data
+}
+
+// Serialize return a valid sentence RLM as string
+func (m GPRLM) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPRLM")
+	fields := make([]string, 0)
+	fields = append(fields,
+		m.BeaconID,
+		m.TimeUTC.Serialize(),
+		m.Code.Serialize(),
+		m.Body)
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+const (
+	// AcknowledgementService is a RLMCode type as string "1"
+	AcknowledgementService RLMCode = "1"
+	// CommandService is a RLMCode type as string "2"
+	CommandService RLMCode = "2"
+	// MessageService is a RLMCode type as string "3"
+	MessageService RLMCode = "3"
+	// TestService is a RLMCode type as string "F"
+	TestService RLMCode = "F"
+)
+
+// RLMCode type as string, message code of a return link message
+type RLMCode string
+
+// Serialize return RLMCode as string
+func (c RLMCode) Serialize() string {
+	return string(c)
+}
+
+// String return RLMCode as human string
+func (c RLMCode) String() string {
+	switch c {
+	case AcknowledgementService:
+		return "acknowledgement service"
+	case CommandService:
+		return "command service"
+	case MessageService:
+		return "message service"
+	case TestService:
+		return "test service"
+	default:
+		return "unknow"
+	}
+}
@@ -0,0 +1,142 @@
+package nmea
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+/*
+RMA Recommended Minimum Specific Loran-C Data
+       1 2       3 4        5 6   7   8   9   10  11 12 13
+       | |       | |        | |   |   |   |   |   |  |  |
+$--RMA,A,llll.ll,a,yyyyy.yy,a,x.x,x.x,x.x,x.x,x.x,a,m*hh
+
+1) Status, A = Data valid, V = Blink, cycle or SNR warning
+2) Latitude
+3) N or S (North or South)
+4) Longitude
+5) E or W (East or West)
+6) Time difference A, microseconds
+7) Time difference B, microseconds
+8) Speed over ground, knots
+9) Track made good, degrees true
+10) Magnetic variation, degrees
+11) E or W (East or West)
+12) Mode indicator (NMEA 2.3, optional)
+13) Checksum
+
+Examples:
+$LCRMA,A,4917.24,N,12309.57,W,15920.5,26745.7,5.5,52.5,13.5,E*72
+$LCRMA,A,5130.02,N,12046.34,W,14162.8,42011.3,0.0,0.0,,,A*76
+*/
+
+// NewGPRMA allocate GPRMA struct for RMA sentence (Recommended minimum specific
+// Loran-C data), also used for other talkers (ie: LCRMA)
+func NewGPRMA(m Message) *GPRMA {
+	return &GPRMA{Message: m}
+}
+
+// GPRMA struct
+type GPRMA struct {
+	Message
+
+	IsValid           DataValid
+	Latitude          LatLong         // In decimal format
+	Longitude         LatLong         // In decimal format
+	TimeDifferenceA   *float64        // Time difference A in microseconds, nil if not provided
+	TimeDifferenceB   *float64        // Time difference B in microseconds, nil if not provided
+	Speed             float64         // Speed over ground in knots
+	COG               float64         // Track made good in degree (true)
+	MagneticVariation *float64        // Magnetic variation in degree (negative = West), nil if not provided
+	PositioningMode   PositioningMode // Mode indicator, empty if not provided
+}
+
+func (m *GPRMA) parse() (err error) {
+	if len(m.Fields) != 11 && len(m.Fields) != 12 {
+		return m.Error(fmt.Errorf("Incomplete GPRMA message, not enougth data fields (got: %d, wanted: %d or %d)", len(m.Fields), 11, 12))
+	}
+
+	m.IsValid = (m.Fields[0] == "A")
+
+	if latitude := strings.TrimSpace(strings.Join(m.Fields[1:3], " ")); len(latitude) > 0 {
+		if m.Latitude, err = NewLatLong(latitude); err != nil {
+			return m.Error(err)
+		}
+	}
+
+	if longitude := strings.TrimSpace(strings.Join(m.Fields[3:5], " ")); len(longitude) > 0 {
+		if m.Longitude, err = NewLatLong(longitude); err != nil {
+			return m.Error(err)
+		}
+	}
+
+	if m.TimeDifferenceA, err = parseOptionalFloat(m.Fields[5]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse time difference A from data field (got: %s)", m.Fields[5]))
+	}
+
+	if m.TimeDifferenceB, err = parseOptionalFloat(m.Fields[6]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse time difference B from data field (got: %s)", m.Fields[6]))
+	}
+
+	if len(m.Fields[7]) > 0 {
+		if m.Speed, err = strconv.ParseFloat(m.Fields[7], 64); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse speed over ground from data field (got: %s)", m.Fields[7]))
+		}
+	}
+
+	if len(m.Fields[8]) > 0 {
+		if m.COG, err = strconv.ParseFloat(m.Fields[8], 64); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse track made good from data field (got: %s)", m.Fields[8]))
+		}
+	}
+
+	if len(m.Fields[9]) > 0 {
+		variation, err := parseSignedOffset(m.Fields[9], m.Fields[10], East, West)
+		if err != nil {
+			return m.Error(fmt.Errorf("Unable to parse magnetic variation from data field (got: %s,%s)", m.Fields[9], m.Fields[10]))
+		}
+		m.MagneticVariation = &variation
+	}
+
+	if len(m.Fields) == 12 {
+		if m.PositioningMode, err = ParsePositioningMode(m.Fields[11]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse mode indicator from data field (got: %s)", m.Fields[11]))
+		}
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence RMA as string
+func (m GPRMA) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPRMA")
+	fields := make([]string, 0)
+	fields = append(fields, m.IsValid.Serialize())
+	fields = append(fields, serializeOptionalPosition(m.Latitude, m.Longitude, 2)...)
+	fields = append(fields,
+		formatOptionalFloat(m.TimeDifferenceA, "%.1f"),
+		formatOptionalFloat(m.TimeDifferenceB, "%.1f"),
+		fmt.Sprintf("%.1f", m.Speed),
+		fmt.Sprintf("%.1f", m.COG))
+
+	switch {
+	case m.MagneticVariation == nil:
+		fields = append(fields, "", "")
+	case *m.MagneticVariation < 0:
+		fields = append(fields, fmt.Sprintf("%.1f", math.Abs(*m.MagneticVariation)), West.String())
+	default:
+		fields = append(fields, fmt.Sprintf("%.1f", *m.MagneticVariation), East.String())
+	}
+
+	if len(m.PositioningMode) > 0 {
+		fields = append(fields, m.PositioningMode.Serialize())
+	}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -0,0 +1,172 @@
+package nmea
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+/*
+RMB Recommended Minimum Navigation Information, to a destination waypoint
+       1 2   3 4    5    6       7 8        9 10  11  12  13 14 15
+       | |   | |    |    |       | |        | |   |   |   |  |  |
+$--RMB,A,x.x,a,c--c,c--c,llll.ll,a,yyyyy.yy,a,x.x,x.x,x.x,A,m*hh
+
+1) Status, A = Data valid, V = Navigation receiver warning
+2) Cross track error, nautical miles
+3) Direction to steer, L or R
+4) Origin waypoint ID
+5) Destination waypoint ID
+6) Destination waypoint latitude
+7) N or S (North or South)
+8) Destination waypoint longitude
+9) E or W (East or West)
+10) Range to destination, nautical miles
+11) Bearing to destination, degrees true
+12) Destination closing velocity, knots
+13) Arrival status, A = Arrival circle entered, V = Not entered
+14) Mode indicator (NMEA 2.3, optional)
+15) Checksum
+
+Examples:
+$GPRMB,A,0.66,L,003,004,4917.24,N,12309.57,W,001.3,052.5,000.5,V*20
+$GPRMB,A,4.08,L,EGLL,EGLM,5130.02,N,12046.34,W,004.6,213.9,122.9,A,A*53
+*/
+
+// NewGPRMB allocate GPRMB struct for RMB sentence (Recommended minimum navigation
+// information to a destination waypoint), also used for other talkers (ie: IIRMB, INRMB)
+func NewGPRMB(m Message) *GPRMB {
+	return &GPRMB{Message: m}
+}
+
+// GPRMB struct
+type GPRMB struct {
+	Message
+
+	IsValid         DataValid
+	CrossTrackError float64         // Cross track error in nautical miles
+	SteerDirection  SteerDirection  // Direction to steer to return on track
+	OriginID        string          // Origin waypoint ID
+	DestinationID   string          // Destination waypoint ID
+	Destination     Position        // Destination waypoint location
+	Range           float64         // Range to destination in nautical miles
+	Bearing         float64         // Bearing to destination in degree (true)
+	ClosingVelocity float64         // Destination closing velocity in knots
+	IsArrived       DataValid       // Arrival circle entered
+	PositioningMode PositioningMode // Mode indicator, empty if not provided
+}
+
+// NewNavigation return a RMB sentence to navigate from the fix f along the
+// leg from origin to destination waypoints, the arrival circle radius is in meters
+func NewNavigation(f Fix, origin, destination Waypoint, arrivalRadius float64) (GPRMB, error) {
+	if destination.Position == nil {
+		return GPRMB{}, fmt.Errorf("Invalid destination waypoint, location is missing (got: %s)", destination.Name)
+	}
+
+	m := GPRMB{
+		IsValid:        f.IsValid,
+		SteerDirection: SteerRight,
+		OriginID:       origin.Name,
+		DestinationID:  destination.Name,
+		Destination:    *destination.Position,
+		Bearing:        f.Position.BearingTo(*destination.Position),
+	}
+
+	m.ClosingVelocity = f.Speed * math.Cos(toRadians(f.COG-m.Bearing))
+
+	distance := f.Position.DistanceTo(*destination.Position)
+	m.Range = distance / MetersPerNauticalMile
+	m.IsArrived = distance <= arrivalRadius
+
+	if origin.Position != nil {
+		xte := f.Position.CrossTrackDistance(*origin.Position, *destination.Position)
+		if xte > 0 {
+			m.SteerDirection = SteerLeft
+		}
+		m.CrossTrackError = math.Abs(xte) / MetersPerNauticalMile
+	}
+
+	return m, nil
+}
+
+func (m *GPRMB) parse() (err error) {
+	if len(m.Fields) != 13 && len(m.Fields) != 14 {
+		return m.Error(fmt.Errorf("Incomplete GPRMB message, not enougth data fields (got: %d, wanted: %d or %d)", len(m.Fields), 13, 14))
+	}
+
+	m.IsValid = (m.Fields[0] == "A")
+
+	if len(m.Fields[1]) > 0 {
+		if m.CrossTrackError, err = strconv.ParseFloat(m.Fields[1], 64); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse cross track error from data field (got: %s)", m.Fields[1]))
+		}
+	}
+
+	if len(m.Fields[2]) > 0 {
+		if m.SteerDirection, err = ParseSteerDirection(m.Fields[2]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse direction to steer from data field (got: %s)", m.Fields[2]))
+		}
+	}
+
+	m.OriginID = m.Fields[3]
+	m.DestinationID = m.Fields[4]
+
+	if latitude := strings.TrimSpace(strings.Join(m.Fields[5:7], " ")); len(latitude) > 0 {
+		if m.Destination.Latitude, err = NewLatLong(latitude); err != nil {
+			return m.Error(err)
+		}
+	}
+
+	if longitude := strings.TrimSpace(strings.Join(m.Fields[7:9], " ")); len(longitude) > 0 {
+		if m.Destination.Longitude, err = NewLatLong(longitude); err != nil {
+			return m.Error(err)
+		}
+	}
+
+	for i, v := range map[int]*float64{9: &m.Range, 10: &m.Bearing, 11: &m.ClosingVelocity} {
+		if len(m.Fields[i]) > 0 {
+			if *v, err = strconv.ParseFloat(m.Fields[i], 64); err != nil {
+				return m.Error(fmt.Errorf("Unable to parse data field %d (got: %s)", i+1, m.Fields[i]))
+			}
+		}
+	}
+
+	m.IsArrived = (m.Fields[12] == "A")
+
+	if len(m.Fields) == 14 {
+		if m.PositioningMode, err = ParsePositioningMode(m.Fields[13]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse mode indicator from data field (got: %s)", m.Fields[13]))
+		}
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence RMB as string
+func (m GPRMB) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPRMB")
+	fields := make([]string, 0)
+	fields = append(fields,
+		m.IsValid.Serialize(),
+		fmt.Sprintf("%.2f", m.CrossTrackError),
+		m.SteerDirection.Serialize(),
+		m.OriginID,
+		m.DestinationID)
+	fields = append(fields, serializeOptionalPosition(m.Destination.Latitude, m.Destination.Longitude, 2)...)
+	fields = append(fields,
+		fmt.Sprintf("%05.1f", m.Range),
+		fmt.Sprintf("%05.1f", m.Bearing),
+		fmt.Sprintf("%05.1f", m.ClosingVelocity),
+		m.IsArrived.Serialize())
+
+	if len(m.PositioningMode) > 0 {
+		fields = append(fields, m.PositioningMode.Serialize())
+	}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -63,6 +63,9 @@ func (m *GPRMC) parse() (err error) {
 		return m.Error(fmt.Errorf("Unable to parse datetime UTC from data field (got: %s)", datetime))
 	}
 
+	// Apply century policy on two-digit year
+	m.DateTimeUTC = m.DateTimeUTC.AddDate(ExpandYear(m.DateTimeUTC.Year()%100, DefaultCenturyPivot)-m.DateTimeUTC.Year(), 0, 0)
+
 	m.IsValid = (m.Fields[1] == "A")
 
 	if latitude := strings.TrimSpace(strings.Join(m.Fields[2:4], " ")); len(latitude) > 0 {
@@ -0,0 +1,60 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+ROT Rate Of Turn
+       1   2 3
+       |   | |
+$--ROT,x.x,A*hh
+
+1) Rate of turn, degrees per minute, "-" means bow turns to port
+2) Status, A - Data Valid, V - Data Invalid
+3) Checksum
+
+Examples:
+$HEROT,-11.3,A*35
+*/
+
+// NewGPROT allocate GPROT struct for ROT sentence (Rate of turn),
+// also used for other talkers (ie: HEROT, TIROT)
+func NewGPROT(m Message) *GPROT {
+	return &GPROT{Message: m}
+}
+
+// GPROT struct
+type GPROT struct {
+	Message
+
+	RateOfTurn float64 // Rate of turn in degree per minute (negative = port)
+	IsValid    DataValid
+}
+
+func (m *GPROT) parse() (err error) {
+	if len(m.Fields) != 2 {
+		return m.Error(fmt.Errorf("Incomplete GPROT message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 2))
+	}
+
+	if m.RateOfTurn, err = strconv.ParseFloat(m.Fields[0], 64); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse rate of turn from data field (got: %s)", m.Fields[0]))
+	}
+
+	m.IsValid = (m.Fields[1] == "A")
+
+	return nil
+}
+
+// Serialize return a valid sentence ROT as string
+func (m GPROT) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPROT")
+	fields := make([]string, 0)
+	fields = append(fields, fmt.Sprintf("%.1f", m.RateOfTurn), m.IsValid.Serialize())
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
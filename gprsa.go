@@ -0,0 +1,69 @@
+package nmea
+
+import "fmt"
+
+/*
+RSA Rudder Sensor Angle
+       1   2 3   4 5
+       |   | |   | |
+$--RSA,x.x,A,x.x,A*hh
+
+1) Starboard (or single) rudder sensor, "-" means Turn To Port
+2) Status, A - Data Valid, V - Data Invalid
+3) Port rudder sensor
+4) Status, A - Data Valid, V - Data Invalid
+5) Checksum
+
+Examples:
+$IIRSA,10.5,A,,V*4D
+*/
+
+// NewGPRSA allocate GPRSA struct for RSA sentence (Rudder sensor angle),
+// also used for other talkers (ie: IIRSA, AGRSA)
+func NewGPRSA(m Message) *GPRSA {
+	return &GPRSA{Message: m}
+}
+
+// GPRSA struct
+type GPRSA struct {
+	Message
+
+	Starboard      *float64 // Starboard (or single) rudder angle in degree (negative = port), nil if not provided
+	StarboardValid DataValid
+	Port           *float64 // Port rudder angle in degree (negative = port), nil if not provided
+	PortValid      DataValid
+}
+
+func (m *GPRSA) parse() (err error) {
+	if len(m.Fields) != 4 {
+		return m.Error(fmt.Errorf("Incomplete GPRSA message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 4))
+	}
+
+	if m.Starboard, err = parseOptionalFloat(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse starboard rudder angle from data field (got: %s)", m.Fields[0]))
+	}
+
+	m.StarboardValid = (m.Fields[1] == "A")
+
+	if m.Port, err = parseOptionalFloat(m.Fields[2]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse port rudder angle from data field (got: %s)", m.Fields[2]))
+	}
+
+	m.PortValid = (m.Fields[3] == "A")
+
+	return nil
+}
+
+// Serialize return a valid sentence RSA as string
+func (m GPRSA) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPRSA")
+	fields := make([]string, 0)
+	fields = append(fields,
+		formatOptionalFloat(m.Starboard, "%.1f"), m.StarboardValid.Serialize(),
+		formatOptionalFloat(m.Port, "%.1f"), m.PortValid.Serialize())
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -0,0 +1,157 @@
+package nmea
+
+import (
+	"fmt"
+)
+
+/*
+RSD Radar System Data
+       1   2   3   4   5   6   7   8   9   10  11  12 13 14
+       |   |   |   |   |   |   |   |   |   |   |   |  |  |
+$--RSD,x.x,x.x,x.x,x.x,x.x,x.x,x.x,x.x,x.x,x.x,x.x,a,a*hh
+
+1) Origin 1 range, from own ship
+2) Origin 1 bearing, degrees from 0
+3) Variable Range Marker 1 (VRM1), range
+4) Bearing line 1 (EBL1), degrees from 0
+5) Origin 2 range
+6) Origin 2 bearing
+7) VRM 2, range
+8) EBL 2, degrees
+9) Cursor range, from own ship
+10) Cursor bearing, degrees clockwise from 0
+11) Range scale in use
+12) Range units, K = Kilometers, N = Nautical miles, S = Statute miles
+13) Display rotation, C = Course up, H = Head up, N = North up
+14) Checksum
+
+Examples:
+$RARSD,0.00,0.0,2.10,118.0,,,,,3.47,41.6,6.00,N,N*6A
+$RARSD,0.50,45.0,1.20,90.0,1.00,180.0,3.00,270.0,,,12.00,K,H*54
+*/
+
+// NewGPRSD allocate GPRSD struct for RSD sentence (Radar system data),
+// also used for other talkers (ie: RARSD)
+func NewGPRSD(m Message) *GPRSD {
+	return &GPRSD{Message: m}
+}
+
+// GPRSD struct, ranges are in RangeUnit and values are nil if not provided
+type GPRSD struct {
+	Message
+
+	Markers         [2]RadarMarkers
+	CursorRange     *float64 // Cursor range from own ship
+	CursorBearing   *float64 // Cursor bearing in degree clockwise from 0
+	RangeScale      *float64 // Range scale in use
+	RangeUnit       DistanceUnit
+	DisplayRotation DisplayRotation
+}
+
+// RadarMarkers struct is a set of radar display origin, variable range marker
+// and electronic bearing line
+type RadarMarkers struct {
+	OriginRange   *float64 // Origin range from own ship
+	OriginBearing *float64 // Origin bearing in degree from 0
+	VRM           *float64 // Variable range marker
+	EBL           *float64 // Electronic bearing line in degree from 0
+}
+
+func (m *GPRSD) parse() (err error) {
+	if len(m.Fields) != 13 {
+		return m.Error(fmt.Errorf("Incomplete GPRSD message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 13))
+	}
+
+	values := []**float64{
+		&m.Markers[0].OriginRange, &m.Markers[0].OriginBearing, &m.Markers[0].VRM, &m.Markers[0].EBL,
+		&m.Markers[1].OriginRange, &m.Markers[1].OriginBearing, &m.Markers[1].VRM, &m.Markers[1].EBL,
+		&m.CursorRange, &m.CursorBearing, &m.RangeScale,
+	}
+	for i, v := range values {
+		if *v, err = parseOptionalFloat(m.Fields[i]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse data field %d (got: %s)", i+1, m.Fields[i]))
+		}
+	}
+
+	if len(m.Fields[11]) > 0 {
+		if m.RangeUnit, err = ParseDistanceUnit(m.Fields[11]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse range unit from data field (got: %s)", m.Fields[11]))
+		}
+	}
+
+	if len(m.Fields[12]) > 0 {
+		if m.DisplayRotation, err = ParseDisplayRotation(m.Fields[12]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse display rotation from data field (got: %s)", m.Fields[12]))
+		}
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence RSD as string
+func (m GPRSD) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPRSD")
+	fields := make([]string, 0)
+	for _, s := range m.Markers {
+		fields = append(fields,
+			formatOptionalFloat(s.OriginRange, "%.2f"),
+			formatOptionalFloat(s.OriginBearing, "%.1f"),
+			formatOptionalFloat(s.VRM, "%.2f"),
+			formatOptionalFloat(s.EBL, "%.1f"))
+	}
+	fields = append(fields,
+		formatOptionalFloat(m.CursorRange, "%.2f"),
+		formatOptionalFloat(m.CursorBearing, "%.1f"),
+		formatOptionalFloat(m.RangeScale, "%.2f"),
+		m.RangeUnit.Serialize(),
+		m.DisplayRotation.Serialize())
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+const (
+	// CourseUp is a DisplayRotation type as string "C"
+	CourseUp DisplayRotation = "C"
+	// HeadUp is a DisplayRotation type as string "H"
+	HeadUp DisplayRotation = "H"
+	// NorthUp is a DisplayRotation type as string "N"
+	NorthUp DisplayRotation = "N"
+)
+
+// DisplayRotation type as string
+type DisplayRotation string
+
+// Serialize return DisplayRotation as string
+func (d DisplayRotation) Serialize() string {
+	return string(d)
+}
+
+// String return DisplayRotation as human string
+func (d DisplayRotation) String() string {
+	switch d {
+	case CourseUp:
+		return "course up"
+	case HeadUp:
+		return "head up"
+	case NorthUp:
+		return "north up"
+	default:
+		return "unknow"
+	}
+}
+
+// ParseDisplayRotation check DisplayRotation validity, return an error
+// "unknow value" if not
+func ParseDisplayRotation(raw string) (d DisplayRotation, err error) {
+	d = DisplayRotation(raw)
+	switch d {
+	case CourseUp, HeadUp, NorthUp:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
@@ -0,0 +1,128 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+/*
+RTE Routes
+       1 2 3 4    5    6         n
+       | | | |    |    |         |
+$--RTE,x,x,a,c--c,c--c,c--c,...,c--c*hh
+
+1) Total number of messages being transmitted
+2) Message number
+3) Message mode, c = Complete route (all waypoints), w = Working route (first listed waypoint is the FROM)
+4) Route name
+5) Waypoint ID
+n) Checksum
+
+Examples:
+$GPRTE,2,1,c,0,PBRCPK,PBRTO,PTELGR,PPLAND,PYAMBU,PPFAIR,PWARRN,PMORTL,PLISMR*73
+$IIRTE,1,1,w,EGLL,EGLM,EGLN*35
+*/
+
+// NewGPRTE allocate GPRTE struct for RTE sentence (Routes),
+// also used for other talkers (ie: IIRTE, INRTE, ECRTE)
+func NewGPRTE(m Message) *GPRTE {
+	return &GPRTE{Message: m}
+}
+
+// GPRTE struct
+type GPRTE struct {
+	Message
+
+	NbOfMessages  int       // Total number of messages for the route
+	MessageNumber int       // Message number, starting at 1
+	RouteMode     RouteMode // Complete or working route
+	RouteName     string
+	WaypointIDs   []string // Waypoint IDs carried by this message
+}
+
+func (m *GPRTE) parse() (err error) {
+	if len(m.Fields) < 4 {
+		return m.Error(fmt.Errorf("Incomplete GPRTE message, not enougth data fields (got: %d, wanted at least: %d)", len(m.Fields), 4))
+	}
+
+	if m.NbOfMessages, err = strconv.Atoi(m.Fields[0]); err != nil || m.NbOfMessages < 1 {
+		return m.Error(fmt.Errorf("Unable to parse total number of messages from data field (got: %s)", m.Fields[0]))
+	}
+
+	if m.MessageNumber, err = strconv.Atoi(m.Fields[1]); err != nil || m.MessageNumber < 1 || m.MessageNumber > m.NbOfMessages {
+		return m.Error(fmt.Errorf("Unable to parse message number from data field (got: %s)", m.Fields[1]))
+	}
+
+	if m.RouteMode, err = ParseRouteMode(m.Fields[2]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse route mode from data field (got: %s)", m.Fields[2]))
+	}
+
+	m.RouteName = m.Fields[3]
+
+	m.WaypointIDs = make([]string, 0)
+	for _, id := range m.Fields[4:] {
+		if id = strings.TrimSpace(id); len(id) > 0 {
+			m.WaypointIDs = append(m.WaypointIDs, id)
+		}
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence RTE as string
+func (m GPRTE) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPRTE")
+	fields := make([]string, 0)
+	fields = append(fields,
+		strconv.Itoa(m.NbOfMessages),
+		strconv.Itoa(m.MessageNumber),
+		m.RouteMode.Serialize(),
+		m.RouteName)
+	fields = append(fields, m.WaypointIDs...)
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+const (
+	// CompleteRoute is a RouteMode type as string "c"
+	CompleteRoute RouteMode = "c"
+	// WorkingRoute is a RouteMode type as string "w"
+	WorkingRoute RouteMode = "w"
+)
+
+// RouteMode type as string
+type RouteMode string
+
+// Serialize return RouteMode as string
+func (r RouteMode) Serialize() string {
+	return string(r)
+}
+
+// String return RouteMode as human string
+func (r RouteMode) String() string {
+	switch r {
+	case CompleteRoute:
+		return "complete route"
+	case WorkingRoute:
+		return "working route"
+	default:
+		return "unknow"
+	}
+}
+
+// ParseRouteMode check RouteMode validity, return an error
+// "unknow value" if not
+func ParseRouteMode(raw string) (r RouteMode, err error) {
+	r = RouteMode(raw)
+	switch r {
+	case CompleteRoute, WorkingRoute:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
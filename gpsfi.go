@@ -0,0 +1,142 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+SFI Scanning Frequency Information
+       1 2 3      4      13     14
+       | | |      |      |      |
+$--SFI,x,x,xxxxxx,c,....,xxxxxx,c*hh
+
+1) Total number of messages
+2) Message number
+3) 1st frequency, 100 Hz
+4) 1st mode of operation (see FSI)
+5) to 14) Up to 5 other frequency and mode pairs
+15) Checksum
+
+Examples:
+$CTSFI,2,1,021820,d,041250,m,062310,q,083940,s,122340,t,164380,w*45
+$CTSFI,2,2,218230,|*3D
+*/
+
+// NewGPSFI allocate GPSFI struct for SFI sentence (scanning frequency information),
+// also used for other talkers (ie: CTSFI)
+func NewGPSFI(m Message) *GPSFI {
+	return &GPSFI{Message: m}
+}
+
+// GPSFI struct
+type GPSFI struct {
+	Message
+
+	NbOfMessages  int
+	MessageNumber int
+	Frequencies   []ScanningFrequency // Up to 6 frequencies per sentence
+}
+
+// ScanningFrequency struct is a frequency scanned by a radio
+type ScanningFrequency struct {
+	Frequency float64 // Frequency in kHz
+	Mode      OperationMode
+}
+
+func (m *GPSFI) parse() (err error) {
+	if len(m.Fields) < 4 || len(m.Fields) > 14 || len(m.Fields)%2 != 0 {
+		return m.Error(fmt.Errorf("Incomplete GPSFI message, not enougth data fields (got: %d, wanted: even number from %d to %d)", len(m.Fields), 4, 14))
+	}
+
+	if m.NbOfMessages, err = strconv.Atoi(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse total number of messages from data field (got: %s)", m.Fields[0]))
+	}
+
+	if m.MessageNumber, err = strconv.Atoi(m.Fields[1]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse message number from data field (got: %s)", m.Fields[1]))
+	}
+
+	m.Frequencies = make([]ScanningFrequency, 0)
+	for i := 2; i < len(m.Fields); i += 2 {
+		// Unused pairs may be sent as null fields
+		if len(m.Fields[i]) == 0 && len(m.Fields[i+1]) == 0 {
+			continue
+		}
+
+		var sf ScanningFrequency
+		frequency, err := parseFrequency(m.Fields[i])
+		if err != nil || frequency == nil {
+			return m.Error(fmt.Errorf("Unable to parse frequency from data field (got: %s)", m.Fields[i]))
+		}
+		sf.Frequency = *frequency
+
+		if sf.Mode, err = ParseOperationMode(m.Fields[i+1]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse mode of operation from data field (got: %s)", m.Fields[i+1]))
+		}
+
+		m.Frequencies = append(m.Frequencies, sf)
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence SFI as string
+func (m GPSFI) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPSFI")
+	fields := make([]string, 0)
+	fields = append(fields,
+		strconv.Itoa(m.NbOfMessages),
+		strconv.Itoa(m.MessageNumber))
+
+	for _, sf := range m.Frequencies {
+		frequency := sf.Frequency
+		fields = append(fields, formatFrequency(&frequency), sf.Mode.Serialize())
+	}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+// SFIAssembler struct assembles groups of SFI sentences by talker
+type SFIAssembler struct {
+	pending map[TalkerID][]ScanningFrequency
+	seq     map[TalkerID]int
+}
+
+// NewSFIAssembler allocate SFIAssembler struct
+func NewSFIAssembler() *SFIAssembler {
+	return &SFIAssembler{pending: make(map[TalkerID][]ScanningFrequency), seq: make(map[TalkerID]int)}
+}
+
+// Add feed SFIAssembler with a message, other messages than SFI are ignored.
+// Return the list of scanned frequencies once all the sentences of a group
+// have been received
+func (a *SFIAssembler) Add(msg NMEA) ([]ScanningFrequency, error) {
+	m, ok := msg.(*GPSFI)
+	if !ok {
+		return nil, nil
+	}
+
+	talker := m.Type.GetTypeID().Talker
+
+	if m.MessageNumber == 1 {
+		a.pending[talker] = make([]ScanningFrequency, 0)
+	} else if _, ok := a.pending[talker]; !ok || a.seq[talker] != m.MessageNumber-1 {
+		delete(a.pending, talker)
+		return nil, m.Error(fmt.Errorf("Out of sequence SFI message (got: %d/%d)", m.MessageNumber, m.NbOfMessages))
+	}
+	a.seq[talker] = m.MessageNumber
+	a.pending[talker] = append(a.pending[talker], m.Frequencies...)
+
+	if m.MessageNumber < m.NbOfMessages {
+		return nil, nil
+	}
+
+	frequencies := a.pending[talker]
+	delete(a.pending, talker)
+	return frequencies, nil
+}
@@ -0,0 +1,90 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+STN Multiple Data ID
+       1
+       |
+$--STN,xx*hh
+
+1) Talker ID number, 00 to 99
+2) Checksum
+
+Sent before the sentences of a talker to identify the device among several
+devices using the same talker ID
+
+Examples:
+$IISTN,01*64
+$GPSTN,12*71
+*/
+
+// NewGPSTN allocate GPSTN struct for STN sentence (Multiple data ID),
+// also used for other talkers (ie: IISTN)
+func NewGPSTN(m Message) *GPSTN {
+	return &GPSTN{Message: m}
+}
+
+// GPSTN struct
+type GPSTN struct {
+	Message
+
+	TalkerNumber int
+}
+
+func (m *GPSTN) parse() (err error) {
+	if len(m.Fields) != 1 {
+		return m.Error(fmt.Errorf("Incomplete GPSTN message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 1))
+	}
+
+	if m.TalkerNumber, err = strconv.Atoi(m.Fields[0]); err != nil || m.TalkerNumber < 0 || m.TalkerNumber > 99 {
+		return m.Error(fmt.Errorf("Unable to parse talker ID number from data field (got: %s)", m.Fields[0]))
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence STN as string
+func (m GPSTN) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPSTN")
+	fields := make([]string, 0)
+	fields = append(fields, fmt.Sprintf("%02d", m.TalkerNumber))
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+// STNDemultiplexer struct tags the sentences of a talker with the device
+// number announced by the last STN sentence of this talker
+type STNDemultiplexer struct {
+	numbers map[TalkerID]int
+}
+
+// NewSTNDemultiplexer allocate STNDemultiplexer struct
+func NewSTNDemultiplexer() *STNDemultiplexer {
+	return &STNDemultiplexer{numbers: make(map[TalkerID]int)}
+}
+
+// Source feed STNDemultiplexer with a message and return the device number of
+// its talker, return false for STN sentences and talkers without STN sentence
+func (d *STNDemultiplexer) Source(msg NMEA) (int, bool) {
+	hdr := msg.GetMessage().Type
+	if hdr == nil {
+		return 0, false
+	}
+	talker := hdr.GetTypeID().Talker
+
+	if m, ok := msg.(*GPSTN); ok {
+		d.numbers[talker] = m.TalkerNumber
+		return 0, false
+	}
+
+	number, ok := d.numbers[talker]
+	return number, ok
+}
@@ -0,0 +1,29 @@
+package nmea
+
+import "testing"
+
+func TestSTNDemultiplexer(t *testing.T) {
+	d := NewSTNDemultiplexer()
+
+	sources := make([]int, 0)
+	for _, raw := range []string{
+		"$GPGLL,3110.2908,N,12123.2348,E,041139.000,A,A*59",
+		"$IISTN,01*64",
+		"$IIMTW,17.8,C*1D",
+		"$IISTN,02*67",
+		"$IIMTW,17.8,C*1D",
+		"$IIMWV,041.0,T,12.4,N,A*09",
+	} {
+		msg, err := Parse(raw)
+		if err != nil {
+			t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+		}
+		if n, ok := d.Source(msg); ok {
+			sources = append(sources, n)
+		}
+	}
+
+	if len(sources) != 3 || sources[0] != 1 || sources[1] != 2 || sources[2] != 2 {
+		t.Fatalf("Wrong demultiplexed sources (got: %v)", sources)
+	}
+}
@@ -0,0 +1,120 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+THS True Heading and Status
+       1   2 3
+       |   | |
+$--THS,x.x,a*hh
+
+1) Heading, degrees true
+2) Mode indicator:
+   A - Autonomous, E - Estimated (dead reckoning), M - Manual input,
+   S - Simulator, V - Data not valid
+3) Checksum
+
+Examples:
+$GPTHS,338.01,A*0E
+*/
+
+// NewGPTHS allocate GPTHS struct for THS sentence (True heading and status),
+// also used for other talkers (ie: INTHS)
+func NewGPTHS(m Message) *GPTHS {
+	return &GPTHS{Message: m}
+}
+
+// GPTHS struct
+type GPTHS struct {
+	Message
+
+	Heading float64 // Heading in degree (true)
+	Mode    HeadingMode
+}
+
+func (m *GPTHS) parse() (err error) {
+	if len(m.Fields) != 2 {
+		return m.Error(fmt.Errorf("Incomplete GPTHS message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 2))
+	}
+
+	if m.Heading, err = strconv.ParseFloat(m.Fields[0], 64); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse heading from data field (got: %s)", m.Fields[0]))
+	}
+
+	if m.Mode, err = ParseHeadingMode(m.Fields[1]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse mode indicator from data field (got: %s)", m.Fields[1]))
+	}
+
+	return nil
+}
+
+// IsValid return true if heading is usable (mode indicator other than "V")
+func (m GPTHS) IsValid() bool {
+	return m.Mode != HeadingModeNotValid
+}
+
+// Serialize return a valid sentence THS as string
+func (m GPTHS) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPTHS")
+	fields := make([]string, 0)
+	fields = append(fields, fmt.Sprintf("%.2f", m.Heading), m.Mode.Serialize())
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+const (
+	// HeadingModeAutonomous is a HeadingMode as string "A"
+	HeadingModeAutonomous HeadingMode = "A"
+	// HeadingModeEstimated is a HeadingMode as string "E"
+	HeadingModeEstimated HeadingMode = "E"
+	// HeadingModeManual is a HeadingMode as string "M"
+	HeadingModeManual HeadingMode = "M"
+	// HeadingModeSimulator is a HeadingMode as string "S"
+	HeadingModeSimulator HeadingMode = "S"
+	// HeadingModeNotValid is a HeadingMode as string "V"
+	HeadingModeNotValid HeadingMode = "V"
+)
+
+// HeadingMode type as string
+type HeadingMode string
+
+// Serialize return HeadingMode as string
+func (h HeadingMode) Serialize() string {
+	return string(h)
+}
+
+// String return HeadingMode as human string
+func (h HeadingMode) String() string {
+	switch h {
+	case HeadingModeAutonomous:
+		return "autonomous"
+	case HeadingModeEstimated:
+		return "estimated"
+	case HeadingModeManual:
+		return "manual input"
+	case HeadingModeSimulator:
+		return "simulator"
+	case HeadingModeNotValid:
+		return "not valid"
+	default:
+		return "unknow"
+	}
+}
+
+// ParseHeadingMode check HeadingMode validity, return an error
+// "unknow value" if not
+func ParseHeadingMode(raw string) (h HeadingMode, err error) {
+	h = HeadingMode(raw)
+	switch h {
+	case HeadingModeAutonomous, HeadingModeEstimated, HeadingModeManual, HeadingModeSimulator, HeadingModeNotValid:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
@@ -0,0 +1,113 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+/*
+TLL Target Latitude and Longitude
+       1  2       3 4        5 6    7         8 9 10
+       |  |       | |        | |    |         | | |
+$--TLL,xx,llll.ll,a,yyyyy.yy,a,c--c,hhmmss.ss,a,a*hh
+
+1) Target number, 00 - 99
+2) Target latitude
+3) N or S (North or South)
+4) Target longitude
+5) E or W (East or West)
+6) Target name
+7) Time (UTC)
+8) Target status, L = Lost, Q = Query (acquiring), T = Tracking
+9) Reference target, R = Reference, null otherwise
+10) Checksum
+
+Examples:
+$RATLL,01,4917.24,N,12309.57,W,TGT01,100021.00,T,*7B
+$GPTLL,02,5130.02,N,12046.34,W,MOB,083015,Q,R*0B
+*/
+
+// NewGPTLL allocate GPTLL struct for TLL sentence (Target latitude and longitude),
+// also used for other talkers (ie: RATLL)
+func NewGPTLL(m Message) *GPTLL {
+	return &GPTLL{Message: m}
+}
+
+// GPTLL struct
+type GPTLL struct {
+	Message
+
+	TargetNumber int
+	Position     Position   // Target location
+	Name         string     // Target name
+	TimeUTC      *TimeOfDay // Time UTC data field, nil if not provided
+	Status       TargetStatus
+	IsReference  bool // Target used as reference
+}
+
+func (m *GPTLL) parse() (err error) {
+	if len(m.Fields) != 9 {
+		return m.Error(fmt.Errorf("Incomplete GPTLL message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 9))
+	}
+
+	if m.TargetNumber, err = strconv.Atoi(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse target number from data field (got: %s)", m.Fields[0]))
+	}
+
+	if latitude := strings.TrimSpace(strings.Join(m.Fields[1:3], " ")); len(latitude) > 0 {
+		if m.Position.Latitude, err = NewLatLong(latitude); err != nil {
+			return m.Error(err)
+		}
+	}
+
+	if longitude := strings.TrimSpace(strings.Join(m.Fields[3:5], " ")); len(longitude) > 0 {
+		if m.Position.Longitude, err = NewLatLong(longitude); err != nil {
+			return m.Error(err)
+		}
+	}
+
+	m.Name = m.Fields[5]
+
+	if len(m.Fields[6]) > 0 {
+		t, err := ParseTimeOfDay(m.Fields[6])
+		if err != nil {
+			return m.Error(fmt.Errorf("Unable to parse time UTC from data field (got: %s)", m.Fields[6]))
+		}
+		m.TimeUTC = &t
+	}
+
+	if m.Status, err = ParseTargetStatus(m.Fields[7]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse target status from data field (got: %s)", m.Fields[7]))
+	}
+
+	m.IsReference = (m.Fields[8] == "R")
+
+	return nil
+}
+
+// Serialize return a valid sentence TLL as string
+func (m GPTLL) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPTLL")
+	timeUTC, reference := "", ""
+	if m.TimeUTC != nil {
+		timeUTC = m.TimeUTC.Serialize()
+	}
+	if m.IsReference {
+		reference = "R"
+	}
+
+	fields := make([]string, 0)
+	fields = append(fields, fmt.Sprintf("%02d", m.TargetNumber))
+	fields = append(fields, serializeOptionalPosition(m.Position.Latitude, m.Position.Longitude, 2)...)
+	fields = append(fields,
+		m.Name,
+		timeUTC,
+		m.Status.Serialize(),
+		reference)
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
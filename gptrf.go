@@ -0,0 +1,126 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/*
+TRF Transit Fix Data
+       1         2      3       4 5        6 7   8   9   10  11  12
+       |         |      |       | |        | |   |   |   |   |   |
+$--TRF,hhmmss.ss,xxxxxx,llll.ll,a,yyyyy.yy,a,x.x,x.x,x.x,x.x,xxx,A*hh
+
+1) Time (UTC)
+2) Date, ddmmyy
+3) Latitude
+4) N or S
+5) Longitude
+6) E or W
+7) Elevation angle, degrees
+8) Number of iterations
+9) Number of Doppler intervals
+10) Update distance, nautical miles
+11) Satellite ID
+12) Data validity, A = valid, V = invalid
+13) Checksum
+
+Examples:
+$TRTRF,162015.00,040589,4536.28,N,12327.92,W,38.5,4,27,1.35,120,A*0A
+$TRTRF,051210.00,120390,3752.15,S,14512.05,E,12.0,7,19,0.42,130,V*1F
+*/
+
+// NewGPTRF allocate GPTRF struct for TRF sentence (Transit fix data),
+// also used for other talkers (ie: TRTRF)
+func NewGPTRF(m Message) *GPTRF {
+	return &GPTRF{Message: m}
+}
+
+// GPTRF struct
+type GPTRF struct {
+	Message
+
+	DateTimeUTC      time.Time // Aggregation of TimeUTC+Date data field
+	Position         Position
+	ElevationAngle   float64 // Elevation angle of the satellite in degree
+	Iterations       int
+	DopplerIntervals int
+	UpdateDistance   float64 // Distance from previous fix in nautical miles
+	SatelliteID      int
+	IsValid          DataValid
+}
+
+func (m *GPTRF) parse() (err error) {
+	if len(m.Fields) != 12 {
+		return m.Error(fmt.Errorf("Incomplete GPTRF message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 12))
+	}
+
+	datetime := fmt.Sprintf("%s %s", m.Fields[1], m.Fields[0])
+	if m.DateTimeUTC, err = time.Parse("020106 150405", datetime); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse datetime UTC from data field (got: %s)", datetime))
+	}
+
+	// Apply century policy on two-digit year
+	m.DateTimeUTC = m.DateTimeUTC.AddDate(ExpandYear(m.DateTimeUTC.Year()%100, DefaultCenturyPivot)-m.DateTimeUTC.Year(), 0, 0)
+
+	if latitude := strings.TrimSpace(strings.Join(m.Fields[2:4], " ")); len(latitude) > 0 {
+		if m.Position.Latitude, err = NewLatLong(latitude); err != nil {
+			return m.Error(err)
+		}
+	}
+
+	if longitude := strings.TrimSpace(strings.Join(m.Fields[4:6], " ")); len(longitude) > 0 {
+		if m.Position.Longitude, err = NewLatLong(longitude); err != nil {
+			return m.Error(err)
+		}
+	}
+
+	if m.ElevationAngle, err = strconv.ParseFloat(m.Fields[6], 64); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse elevation angle from data field (got: %s)", m.Fields[6]))
+	}
+
+	if m.Iterations, err = strconv.Atoi(m.Fields[7]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse number of iterations from data field (got: %s)", m.Fields[7]))
+	}
+
+	if m.DopplerIntervals, err = strconv.Atoi(m.Fields[8]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse number of Doppler intervals from data field (got: %s)", m.Fields[8]))
+	}
+
+	if m.UpdateDistance, err = strconv.ParseFloat(m.Fields[9], 64); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse update distance from data field (got: %s)", m.Fields[9]))
+	}
+
+	if m.SatelliteID, err = strconv.Atoi(m.Fields[10]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse satellite ID from data field (got: %s)", m.Fields[10]))
+	}
+
+	m.IsValid = (m.Fields[11] == "A")
+
+	return nil
+}
+
+// Serialize return a valid sentence TRF as string
+func (m GPTRF) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPTRF")
+	fields := make([]string, 0)
+	fields = append(fields,
+		m.DateTimeUTC.Format("150405.00"),
+		m.DateTimeUTC.Format("020106"))
+	fields = append(fields, serializeOptionalPosition(m.Position.Latitude, m.Position.Longitude, 2)...)
+	fields = append(fields,
+		fmt.Sprintf("%.1f", m.ElevationAngle),
+		strconv.Itoa(m.Iterations),
+		strconv.Itoa(m.DopplerIntervals),
+		fmt.Sprintf("%.2f", m.UpdateDistance),
+		strconv.Itoa(m.SatelliteID),
+		m.IsValid.Serialize())
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
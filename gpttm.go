@@ -0,0 +1,282 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+TTM Tracked Target Message
+       1  2   3   4 5   6   7 8   9   10 11   12 13 14        15 16
+       |  |   |   | |   |   | |   |   |  |    |  |  |         |  |
+$--TTM,xx,x.x,x.x,a,x.x,x.x,a,x.x,x.x,a,c--c,a,a,hhmmss.ss,a*hh
+
+1) Target number, 00 - 99
+2) Target distance from own ship
+3) Bearing from own ship, degrees
+4) Bearing reference, T = True, R = Relative
+5) Target speed
+6) Target course, degrees
+7) Course reference, T = True, R = Relative
+8) Distance of closest point of approach (CPA)
+9) Time to CPA in minutes, "-" means increasing
+10) Speed/distance units, K = km/h, N = Knots, S = statute miles
+11) Target name
+12) Target status, L = Lost, Q = Query (acquiring), T = Tracking
+13) Reference target, R = Reference, null otherwise
+14) Time of data (UTC) (NMEA 3.0, optional)
+15) Type of acquisition, A = Automatic, M = Manual, R = Reported (NMEA 3.0, optional)
+16) Checksum
+
+Examples:
+$RATTM,11,11.40,13.6,T,7.0,20.0,T,0.20,-1.3,N,TGT11,T,,100021.00,A*5A
+$RATTM,02,1.25,245.0,R,12.5,90.0,T,0.50,4.2,K,,Q,R*37
+*/
+
+// NewGPTTM allocate GPTTM struct for TTM sentence (Tracked target message),
+// also used for other talkers (ie: RATTM)
+func NewGPTTM(m Message) *GPTTM {
+	return &GPTTM{Message: m}
+}
+
+// GPTTM struct, distances and speeds are expressed in Unit
+type GPTTM struct {
+	Message
+
+	TargetNumber     int
+	Distance         float64          // Target distance from own ship
+	Bearing          float64          // Bearing from own ship in degree
+	BearingReference BearingReference // True or relative bearing
+	Speed            float64          // Target speed
+	Course           float64          // Target course in degree
+	CourseReference  BearingReference // True or relative course
+	CPA              float64          // Distance of closest point of approach
+	TCPA             float64          // Time to CPA in minutes, negative means increasing
+	Unit             SpeedUnit        // Speed/distance units, K = km(/h), N = nautical miles (knots), S = statute miles(/h)
+	Name             string           // Target name
+	Status           TargetStatus
+	IsReference      bool              // Target used as reference
+	TimeUTC          *TimeOfDay        // Time of data, nil if not provided
+	Acquisition      TargetAcquisition // Type of acquisition, empty if not provided
+	hasExtension     bool              // Sentence provided NMEA 3.0 fields
+}
+
+func (m *GPTTM) parse() (err error) {
+	if len(m.Fields) != 13 && len(m.Fields) != 15 {
+		return m.Error(fmt.Errorf("Incomplete GPTTM message, not enougth data fields (got: %d, wanted: %d or %d)", len(m.Fields), 13, 15))
+	}
+
+	if m.TargetNumber, err = strconv.Atoi(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse target number from data field (got: %s)", m.Fields[0]))
+	}
+
+	for i, v := range map[int]*float64{1: &m.Distance, 2: &m.Bearing, 4: &m.Speed, 5: &m.Course, 7: &m.CPA, 8: &m.TCPA} {
+		if len(m.Fields[i]) > 0 {
+			if *v, err = strconv.ParseFloat(m.Fields[i], 64); err != nil {
+				return m.Error(fmt.Errorf("Unable to parse data field %d (got: %s)", i+1, m.Fields[i]))
+			}
+		}
+	}
+
+	if m.BearingReference, err = ParseBearingReference(m.Fields[3]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse bearing reference from data field (got: %s)", m.Fields[3]))
+	}
+
+	if m.CourseReference, err = ParseBearingReference(m.Fields[6]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse course reference from data field (got: %s)", m.Fields[6]))
+	}
+
+	if m.Unit, err = ParseSpeedUnit(m.Fields[9]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse units from data field (got: %s)", m.Fields[9]))
+	}
+
+	m.Name = m.Fields[10]
+
+	if m.Status, err = ParseTargetStatus(m.Fields[11]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse target status from data field (got: %s)", m.Fields[11]))
+	}
+
+	m.IsReference = (m.Fields[12] == "R")
+
+	if len(m.Fields) == 15 {
+		m.hasExtension = true
+
+		if len(m.Fields[13]) > 0 {
+			t, err := ParseTimeOfDay(m.Fields[13])
+			if err != nil {
+				return m.Error(fmt.Errorf("Unable to parse time UTC from data field (got: %s)", m.Fields[13]))
+			}
+			m.TimeUTC = &t
+		}
+
+		if len(m.Fields[14]) > 0 {
+			if m.Acquisition, err = ParseTargetAcquisition(m.Fields[14]); err != nil {
+				return m.Error(fmt.Errorf("Unable to parse acquisition type from data field (got: %s)", m.Fields[14]))
+			}
+		}
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence TTM as string
+func (m GPTTM) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPTTM")
+	reference := ""
+	if m.IsReference {
+		reference = "R"
+	}
+
+	fields := make([]string, 0)
+	fields = append(fields,
+		fmt.Sprintf("%02d", m.TargetNumber),
+		fmt.Sprintf("%.2f", m.Distance),
+		fmt.Sprintf("%.1f", m.Bearing), m.BearingReference.Serialize(),
+		fmt.Sprintf("%.1f", m.Speed),
+		fmt.Sprintf("%.1f", m.Course), m.CourseReference.Serialize(),
+		fmt.Sprintf("%.2f", m.CPA),
+		fmt.Sprintf("%.1f", m.TCPA),
+		m.Unit.Serialize(),
+		m.Name,
+		m.Status.Serialize(),
+		reference)
+
+	if m.hasExtension || m.TimeUTC != nil || len(m.Acquisition) > 0 {
+		timeUTC := ""
+		if m.TimeUTC != nil {
+			timeUTC = m.TimeUTC.Serialize()
+		}
+		fields = append(fields, timeUTC, m.Acquisition.Serialize())
+	}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+const (
+	// TrueBearing is a BearingReference type as string "T"
+	TrueBearing BearingReference = "T"
+	// RelativeBearing is a BearingReference type as string "R"
+	RelativeBearing BearingReference = "R"
+)
+
+// BearingReference type as string, reference of target bearing or course
+type BearingReference string
+
+// Serialize return BearingReference as string
+func (r BearingReference) Serialize() string {
+	return string(r)
+}
+
+// String return BearingReference as human string
+func (r BearingReference) String() string {
+	switch r {
+	case TrueBearing:
+		return "true"
+	case RelativeBearing:
+		return "relative"
+	default:
+		return "unknow"
+	}
+}
+
+// ParseBearingReference check BearingReference validity, return an error
+// "unknow value" if not
+func ParseBearingReference(raw string) (r BearingReference, err error) {
+	r = BearingReference(raw)
+	switch r {
+	case TrueBearing, RelativeBearing:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
+
+const (
+	// TargetLost is a TargetStatus type as string "L"
+	TargetLost TargetStatus = "L"
+	// TargetQuery is a TargetStatus type as string "Q"
+	TargetQuery TargetStatus = "Q"
+	// TargetTracking is a TargetStatus type as string "T"
+	TargetTracking TargetStatus = "T"
+)
+
+// TargetStatus type as string
+type TargetStatus string
+
+// Serialize return TargetStatus as string
+func (s TargetStatus) Serialize() string {
+	return string(s)
+}
+
+// String return TargetStatus as human string
+func (s TargetStatus) String() string {
+	switch s {
+	case TargetLost:
+		return "lost"
+	case TargetQuery:
+		return "acquiring"
+	case TargetTracking:
+		return "tracking"
+	default:
+		return "unknow"
+	}
+}
+
+// ParseTargetStatus check TargetStatus validity, return an error
+// "unknow value" if not
+func ParseTargetStatus(raw string) (s TargetStatus, err error) {
+	s = TargetStatus(raw)
+	switch s {
+	case TargetLost, TargetQuery, TargetTracking:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
+
+const (
+	// AutomaticAcquisition is a TargetAcquisition type as string "A"
+	AutomaticAcquisition TargetAcquisition = "A"
+	// ManualAcquisition is a TargetAcquisition type as string "M"
+	ManualAcquisition TargetAcquisition = "M"
+	// ReportedAcquisition is a TargetAcquisition type as string "R"
+	ReportedAcquisition TargetAcquisition = "R"
+)
+
+// TargetAcquisition type as string
+type TargetAcquisition string
+
+// Serialize return TargetAcquisition as string
+func (a TargetAcquisition) Serialize() string {
+	return string(a)
+}
+
+// String return TargetAcquisition as human string
+func (a TargetAcquisition) String() string {
+	switch a {
+	case AutomaticAcquisition:
+		return "automatic"
+	case ManualAcquisition:
+		return "manual"
+	case ReportedAcquisition:
+		return "reported"
+	default:
+		return "unknow"
+	}
+}
+
+// ParseTargetAcquisition check TargetAcquisition validity, return an error
+// "unknow value" if not
+func ParseTargetAcquisition(raw string) (a TargetAcquisition, err error) {
+	a = TargetAcquisition(raw)
+	switch a {
+	case AutomaticAcquisition, ManualAcquisition, ReportedAcquisition:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
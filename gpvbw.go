@@ -0,0 +1,113 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+VBW Dual Ground/Water Speed
+       1   2   3 4   5   6 7   8 9   10 11
+       |   |   | |   |   | |   | |   |  |
+$--VBW,x.x,x.x,A,x.x,x.x,A,x.x,A,x.x,A*hh
+
+1) Longitudinal water speed, knots, "-" means astern
+2) Transverse water speed, knots, "-" means port
+3) Status, water speed, A = Data valid, V = Invalid
+4) Longitudinal ground speed, knots, "-" means astern
+5) Transverse ground speed, knots, "-" means port
+6) Status, ground speed, A = Data valid, V = Invalid
+7) Stern transverse water speed, knots (NMEA 3.0, optional)
+8) Status, stern water speed, A = Data valid, V = Invalid (NMEA 3.0, optional)
+9) Stern transverse ground speed, knots (NMEA 3.0, optional)
+10) Status, stern ground speed, A = Data valid, V = Invalid (NMEA 3.0, optional)
+11) Checksum
+
+Examples:
+$IIVBW,4.5,-0.2,A,4.8,0.1,A*60
+$VWVBW,2.1,0.3,A,2.0,-0.4,A,0.2,A,-0.1,V*50
+*/
+
+// NewGPVBW allocate GPVBW struct for VBW sentence (Dual ground/water speed),
+// also used for other talkers (ie: IIVBW, VWVBW)
+func NewGPVBW(m Message) *GPVBW {
+	return &GPVBW{Message: m}
+}
+
+// GPVBW struct
+type GPVBW struct {
+	Message
+
+	LongitudinalWaterSpeed  float64   // Longitudinal water speed in knots, negative means astern
+	TransverseWaterSpeed    float64   // Transverse water speed in knots, negative means port
+	IsWaterSpeedValid       DataValid // Water speed status
+	LongitudinalGroundSpeed float64   // Longitudinal ground speed in knots, negative means astern
+	TransverseGroundSpeed   float64   // Transverse ground speed in knots, negative means port
+	IsGroundSpeedValid      DataValid // Ground speed status
+
+	SternWaterSpeed         *float64  // Stern transverse water speed in knots, nil if not provided
+	IsSternWaterSpeedValid  DataValid // Stern water speed status
+	SternGroundSpeed        *float64  // Stern transverse ground speed in knots, nil if not provided
+	IsSternGroundSpeedValid DataValid // Stern ground speed status
+}
+
+func (m *GPVBW) parse() (err error) {
+	if len(m.Fields) != 6 && len(m.Fields) != 10 {
+		return m.Error(fmt.Errorf("Incomplete GPVBW message, not enougth data fields (got: %d, wanted: %d or %d)", len(m.Fields), 6, 10))
+	}
+
+	for i, v := range map[int]*float64{
+		0: &m.LongitudinalWaterSpeed,
+		1: &m.TransverseWaterSpeed,
+		3: &m.LongitudinalGroundSpeed,
+		4: &m.TransverseGroundSpeed,
+	} {
+		if len(m.Fields[i]) > 0 {
+			if *v, err = strconv.ParseFloat(m.Fields[i], 64); err != nil {
+				return m.Error(fmt.Errorf("Unable to parse speed from data field (got: %s)", m.Fields[i]))
+			}
+		}
+	}
+
+	m.IsWaterSpeedValid = (m.Fields[2] == "A")
+	m.IsGroundSpeedValid = (m.Fields[5] == "A")
+
+	if len(m.Fields) == 10 {
+		if m.SternWaterSpeed, err = parseOptionalFloat(m.Fields[6]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse stern water speed from data field (got: %s)", m.Fields[6]))
+		}
+		m.IsSternWaterSpeedValid = (m.Fields[7] == "A")
+
+		if m.SternGroundSpeed, err = parseOptionalFloat(m.Fields[8]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse stern ground speed from data field (got: %s)", m.Fields[8]))
+		}
+		m.IsSternGroundSpeedValid = (m.Fields[9] == "A")
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence VBW as string
+func (m GPVBW) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPVBW")
+	fields := make([]string, 0)
+	fields = append(fields,
+		fmt.Sprintf("%.1f", m.LongitudinalWaterSpeed),
+		fmt.Sprintf("%.1f", m.TransverseWaterSpeed),
+		m.IsWaterSpeedValid.Serialize(),
+		fmt.Sprintf("%.1f", m.LongitudinalGroundSpeed),
+		fmt.Sprintf("%.1f", m.TransverseGroundSpeed),
+		m.IsGroundSpeedValid.Serialize())
+
+	if m.SternWaterSpeed != nil || m.SternGroundSpeed != nil {
+		fields = append(fields,
+			formatOptionalFloat(m.SternWaterSpeed, "%.1f"), m.IsSternWaterSpeedValid.Serialize(),
+			formatOptionalFloat(m.SternGroundSpeed, "%.1f"), m.IsSternGroundSpeedValid.Serialize())
+	}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -0,0 +1,93 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+VDR Set and Drift
+       1   2 3   4 5   6 7
+       |   | |   | |   | |
+$--VDR,x.x,T,x.x,M,x.x,N*hh
+
+1) Direction of current, degrees true
+2) T = True
+3) Direction of current, degrees magnetic
+4) M = Magnetic
+5) Current speed, knots
+6) N = Knots
+7) Checksum
+
+Examples:
+$IIVDR,10.1,T,12.3,M,1.2,N*3A
+$GPVDR,,T,,M,0.5,N*2B
+*/
+
+// NewGPVDR allocate GPVDR struct for VDR sentence (Set and drift),
+// also used for other talkers (ie: IIVDR)
+func NewGPVDR(m Message) *GPVDR {
+	return &GPVDR{Message: m}
+}
+
+// GPVDR struct
+type GPVDR struct {
+	Message
+
+	SetTrue     *float64 // Direction of current in degree (true), nil if not provided
+	SetMagnetic *float64 // Direction of current in degree (magnetic), nil if not provided
+	Drift       float64  // Current speed in knots
+}
+
+func (m *GPVDR) parse() (err error) {
+	if len(m.Fields) != 6 {
+		return m.Error(fmt.Errorf("Incomplete GPVDR message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 6))
+	}
+
+	// Validate fixed field
+	for i, v := range map[int]string{1: "T", 3: "M", 5: "N"} {
+		if m.Fields[i] != v {
+			return m.Error(fmt.Errorf("Invalid fixed field at %d (got: %s, wanted: %s)", i+1, m.Fields[i], v))
+		}
+	}
+
+	if m.SetTrue, err = parseOptionalFloat(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse true set from data field (got: %s)", m.Fields[0]))
+	}
+
+	if m.SetMagnetic, err = parseOptionalFloat(m.Fields[2]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse magnetic set from data field (got: %s)", m.Fields[2]))
+	}
+
+	if len(m.Fields[4]) > 0 {
+		if m.Drift, err = strconv.ParseFloat(m.Fields[4], 64); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse drift from data field (got: %s)", m.Fields[4]))
+		}
+	}
+
+	return nil
+}
+
+// Current return the current described by the sentence, false if the true
+// direction is not provided
+func (m GPVDR) Current() (Current, bool) {
+	if m.SetTrue == nil {
+		return Current{}, false
+	}
+	return Current{Set: *m.SetTrue, Drift: m.Drift}, true
+}
+
+// Serialize return a valid sentence VDR as string
+func (m GPVDR) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPVDR")
+	fields := make([]string, 0)
+	fields = append(fields,
+		formatOptionalFloat(m.SetTrue, "%.1f"), "T",
+		formatOptionalFloat(m.SetMagnetic, "%.1f"), "M",
+		fmt.Sprintf("%.1f", m.Drift), "N")
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
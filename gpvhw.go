@@ -0,0 +1,94 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+VHW Water Speed and Heading
+       1   2 3   4 5   6 7   8 9
+       |   | |   | |   | |   | |
+$--VHW,x.x,T,x.x,M,x.x,N,x.x,K*hh
+
+1) Heading, degrees true
+2) T = True
+3) Heading, degrees magnetic
+4) M = Magnetic
+5) Speed of vessel relative to the water, knots
+6) N = Knots
+7) Speed of vessel relative to the water, km/hr
+8) K = Kilometers
+9) Checksum
+
+Examples:
+$VWVHW,,T,,M,3.20,N,5.93,K*5A
+$IIVHW,245.1,T,245.1,M,5.10,N,9.45,K*59
+*/
+
+// NewGPVHW allocate GPVHW struct for VHW sentence (Water speed and heading),
+// also used for other talkers (ie: VWVHW, IIVHW)
+func NewGPVHW(m Message) *GPVHW {
+	return &GPVHW{Message: m}
+}
+
+// GPVHW struct
+type GPVHW struct {
+	Message
+
+	HeadingTrue     *float64 // Heading in degree (true), nil if not provided
+	HeadingMagnetic *float64 // Heading in degree (magnetic), nil if not provided
+	SpeedKnots      float64  // Speed through water in knots
+	SpeedKmh        float64  // Speed through water in km/h
+}
+
+func (m *GPVHW) parse() (err error) {
+	if len(m.Fields) != 8 {
+		return m.Error(fmt.Errorf("Incomplete GPVHW message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 8))
+	}
+
+	// Validate fixed field
+	for i, v := range map[int]string{1: "T", 3: "M", 5: "N", 7: "K"} {
+		if m.Fields[i] != v {
+			return m.Error(fmt.Errorf("Invalid fixed field at %d (got: %s, wanted: %s)", i+1, m.Fields[i], v))
+		}
+	}
+
+	if m.HeadingTrue, err = parseOptionalFloat(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse true heading from data field (got: %s)", m.Fields[0]))
+	}
+
+	if m.HeadingMagnetic, err = parseOptionalFloat(m.Fields[2]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse magnetic heading from data field (got: %s)", m.Fields[2]))
+	}
+
+	if len(m.Fields[4]) > 0 {
+		if m.SpeedKnots, err = strconv.ParseFloat(m.Fields[4], 64); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse speed from data field (got: %s)", m.Fields[4]))
+		}
+	}
+
+	if len(m.Fields[6]) > 0 {
+		if m.SpeedKmh, err = strconv.ParseFloat(m.Fields[6], 64); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse speed from data field (got: %s)", m.Fields[6]))
+		}
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence VHW as string
+func (m GPVHW) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPVHW")
+	fields := make([]string, 0)
+	fields = append(fields,
+		formatOptionalFloat(m.HeadingTrue, "%.1f"), "T",
+		formatOptionalFloat(m.HeadingMagnetic, "%.1f"), "M",
+		fmt.Sprintf("%.2f", m.SpeedKnots), "N",
+		fmt.Sprintf("%.2f", m.SpeedKmh), "K")
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -0,0 +1,101 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+VLW Distance Traveled through Water
+       1   2 3   4 5   6 7   8 9
+       |   | |   | |   | |   | |
+$--VLW,x.x,N,x.x,N,x.x,N,x.x,N*hh
+
+1) Total cumulative water distance, nautical miles
+2) N = Nautical Miles
+3) Water distance since reset, nautical miles
+4) N = Nautical Miles
+5) Total cumulative ground distance, nautical miles (NMEA 4.0, optional)
+6) N = Nautical Miles
+7) Ground distance since reset, nautical miles (NMEA 4.0, optional)
+8) N = Nautical Miles
+9) Checksum
+
+Examples:
+$IIVLW,10.1,N,3.2,N*7C
+$VWVLW,2962.8,N,1.3,N,3150.2,N,1.5,N*48
+*/
+
+// NewGPVLW allocate GPVLW struct for VLW sentence (Distance traveled through water),
+// also used for other talkers (ie: IIVLW, VWVLW)
+func NewGPVLW(m Message) *GPVLW {
+	return &GPVLW{Message: m}
+}
+
+// GPVLW struct
+type GPVLW struct {
+	Message
+
+	TotalWaterDistance  float64  // Total cumulative water distance in nautical miles
+	TripWaterDistance   float64  // Water distance since reset in nautical miles
+	TotalGroundDistance *float64 // Total cumulative ground distance in nautical miles, nil if not provided
+	TripGroundDistance  *float64 // Ground distance since reset in nautical miles, nil if not provided
+}
+
+func (m *GPVLW) parse() (err error) {
+	if len(m.Fields) != 4 && len(m.Fields) != 8 {
+		return m.Error(fmt.Errorf("Incomplete GPVLW message, not enougth data fields (got: %d, wanted: %d or %d)", len(m.Fields), 4, 8))
+	}
+
+	// Validate fixed field
+	for i := 1; i < len(m.Fields); i += 2 {
+		if m.Fields[i] != "N" && (len(m.Fields[i]) > 0 || len(m.Fields[i-1]) > 0) {
+			return m.Error(fmt.Errorf("Invalid fixed field at %d (got: %s, wanted: %s)", i+1, m.Fields[i], "N"))
+		}
+	}
+
+	if len(m.Fields[0]) > 0 {
+		if m.TotalWaterDistance, err = strconv.ParseFloat(m.Fields[0], 64); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse total water distance from data field (got: %s)", m.Fields[0]))
+		}
+	}
+
+	if len(m.Fields[2]) > 0 {
+		if m.TripWaterDistance, err = strconv.ParseFloat(m.Fields[2], 64); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse trip water distance from data field (got: %s)", m.Fields[2]))
+		}
+	}
+
+	if len(m.Fields) == 8 {
+		if m.TotalGroundDistance, err = parseOptionalFloat(m.Fields[4]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse total ground distance from data field (got: %s)", m.Fields[4]))
+		}
+
+		if m.TripGroundDistance, err = parseOptionalFloat(m.Fields[6]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse trip ground distance from data field (got: %s)", m.Fields[6]))
+		}
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence VLW as string
+func (m GPVLW) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPVLW")
+	fields := make([]string, 0)
+	fields = append(fields,
+		fmt.Sprintf("%.1f", m.TotalWaterDistance), "N",
+		fmt.Sprintf("%.1f", m.TripWaterDistance), "N")
+
+	if m.TotalGroundDistance != nil || m.TripGroundDistance != nil {
+		fields = append(fields,
+			formatOptionalFloat(m.TotalGroundDistance, "%.1f"), "N",
+			formatOptionalFloat(m.TripGroundDistance, "%.1f"), "N")
+	}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -3,6 +3,7 @@ package nmea
 import (
 	"fmt"
 	"strconv"
+	"strings"
 )
 
 /*
@@ -37,6 +38,8 @@ type GPVTG struct {
 	SpeedKnots      float64 // Speed over ground in knots
 	SpeedKmh        float64 // Speed over ground in km/h
 	PositioningMode PositioningMode
+
+	decimals [3]int // Number of decimals of COG and speeds data fields, 0 means default (1), -1 means none
 }
 
 func (m *GPVTG) parse() (err error) {
@@ -63,6 +66,14 @@ func (m *GPVTG) parse() (err error) {
 		return m.Error(fmt.Errorf("Unable to parse speed from data field (got: %s)", m.Fields[6]))
 	}
 
+	// Keep precision of each data field to serialize them back identically
+	for i, field := range []string{m.Fields[0], m.Fields[4], m.Fields[6]} {
+		m.decimals[i] = -1
+		if j := strings.Index(field, "."); j >= 0 {
+			m.decimals[i] = len(field) - j - 1
+		}
+	}
+
 	if m.PositioningMode, err = ParsePositioningMode(m.Fields[8]); err != nil {
 		return m.Error(fmt.Errorf("Unable to parse GPS positioning mode from data field (got: %s)", m.Fields[8]))
 	}
@@ -74,11 +85,23 @@ func (m *GPVTG) parse() (err error) {
 func (m GPVTG) Serialize() string { // Implement NMEA interface
 
 	hdr := TypeIDs["GPVTG"]
+	values := make([]string, 0)
+	for i, v := range []float64{m.COG, m.SpeedKnots, m.SpeedKmh} {
+		decimals := m.decimals[i]
+		switch {
+		case decimals == 0:
+			decimals = 1
+		case decimals < 0:
+			decimals = 0
+		}
+		values = append(values, strconv.FormatFloat(v, 'f', decimals, 64))
+	}
+
 	fields := make([]string, 0)
-	fields = append(fields, fmt.Sprintf("%03.1f", m.COG), "T",
+	fields = append(fields, values[0], "T",
 		"", "M",
-		fmt.Sprintf("%03.1f", m.SpeedKnots), "N",
-		fmt.Sprintf("%03.1f", m.SpeedKmh), "K",
+		values[1], "N",
+		values[2], "K",
 		string(m.PositioningMode))
 	msg := Message{Type: hdr, Fields: fields}
 	msg.Checksum = msg.ComputeChecksum()
@@ -0,0 +1,125 @@
+package nmea
+
+import (
+	"fmt"
+	"math"
+)
+
+/*
+VWR Relative (Apparent) Wind Speed and Angle
+       1   2 3   4 5   6 7   8 9
+       |   | |   | |   | |   | |
+$--VWR,x.x,a,x.x,N,x.x,M,x.x,K*hh
+
+1) Wind angle from the bow, 0 to 180 degrees
+2) L = Left (port), R = Right (starboard)
+3) Wind speed, knots
+4) N = Knots
+5) Wind speed, meters/second
+6) M = Meters/second
+7) Wind speed, km/h
+8) K = Kilometers/hour
+9) Checksum
+
+Examples:
+$IIVWR,148.0,L,10.4,N,5.4,M,19.3,K*55
+$WIVWR,032.5,R,7.1,N,3.7,M,13.1,K*62
+*/
+
+// NewGPVWR allocate GPVWR struct for VWR sentence (Relative wind speed and angle),
+// also used for other talkers (ie: IIVWR, WIVWR)
+func NewGPVWR(m Message) *GPVWR {
+	return &GPVWR{Message: m}
+}
+
+// GPVWR struct
+type GPVWR struct {
+	Message
+
+	Angle       *float64 // Wind angle from the bow in degree (-180 ~ 180), negative = port, nil if not provided
+	SpeedKnots  *float64 // Wind speed in knots, nil if not provided
+	SpeedMeters *float64 // Wind speed in meters per second, nil if not provided
+	SpeedKmh    *float64 // Wind speed in km/h, nil if not provided
+}
+
+// MWV return the relative wind as MWV sentence, speed comes from the first
+// provided data field (knots, meters per second then km/h). MWV is invalid
+// if angle or speed is not provided
+func (m GPVWR) MWV() GPMWV {
+	mwv := GPMWV{Reference: RelativeWind, SpeedUnit: Knots, IsValid: Invalid}
+	if m.Angle != nil {
+		mwv.Angle = normalizeDegrees(*m.Angle)
+	}
+
+	for _, s := range []struct {
+		value *float64
+		unit  SpeedUnit
+	}{{m.SpeedKnots, Knots}, {m.SpeedMeters, MetersPerSecond}, {m.SpeedKmh, KilometersPerHour}} {
+		if s.value != nil {
+			mwv.Speed, mwv.SpeedUnit = *s.value, s.unit
+			mwv.IsValid = DataValid(m.Angle != nil)
+			break
+		}
+	}
+
+	return mwv
+}
+
+func (m *GPVWR) parse() (err error) {
+	if len(m.Fields) != 8 {
+		return m.Error(fmt.Errorf("Incomplete GPVWR message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 8))
+	}
+
+	// Validate fixed field
+	for i, v := range map[int]string{3: "N", 5: "M", 7: "K"} {
+		if m.Fields[i] != v {
+			return m.Error(fmt.Errorf("Invalid fixed field at %d (got: %s, wanted: %s)", i+1, m.Fields[i], v))
+		}
+	}
+
+	if m.Angle, err = parseOptionalFloat(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse wind angle from data field (got: %s)", m.Fields[0]))
+	}
+
+	if m.Angle != nil {
+		switch m.Fields[1] {
+		case "L":
+			*m.Angle = -*m.Angle
+		case "R":
+		default:
+			return m.Error(fmt.Errorf("Wrong wind angle direction (got: %s)", m.Fields[1]))
+		}
+	}
+
+	for i, v := range map[int]**float64{2: &m.SpeedKnots, 4: &m.SpeedMeters, 6: &m.SpeedKmh} {
+		if *v, err = parseOptionalFloat(m.Fields[i]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse wind speed from data field (got: %s)", m.Fields[i]))
+		}
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence VWR as string
+func (m GPVWR) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPVWR")
+	angle, side := "", ""
+	if m.Angle != nil {
+		angle, side = fmt.Sprintf("%05.1f", math.Abs(*m.Angle)), "R"
+		if *m.Angle < 0 {
+			side = "L"
+		}
+	}
+
+	fields := make([]string, 0)
+	fields = append(fields,
+		angle, side,
+		formatOptionalFloat(m.SpeedKnots, "%.1f"), "N",
+		formatOptionalFloat(m.SpeedMeters, "%.1f"), "M",
+		formatOptionalFloat(m.SpeedKmh, "%.1f"), "K")
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
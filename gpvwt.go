@@ -0,0 +1,113 @@
+package nmea
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+/*
+VWT True Wind Speed and Angle
+       1   2 3   4 5   6 7   8 9
+       |   | |   | |   | |   | |
+$--VWT,x.x,a,x.x,N,x.x,M,x.x,K*hh
+
+1) True wind angle from the bow, 0 to 180 degrees
+2) L = Left (port), R = Right (starboard)
+3) Wind speed, knots
+4) N = Knots
+5) Wind speed, meters/second
+6) M = Meters/second
+7) Wind speed, km/h
+8) K = Kilometers/hour
+9) Checksum
+
+Examples:
+$IIVWT,030.0,R,10.1,N,5.2,M,18.7,K*45
+$WIVWT,115.2,L,6.4,N,3.3,M,11.9,K*73
+*/
+
+// NewGPVWT allocate GPVWT struct for VWT sentence (True wind speed and angle),
+// also used for other talkers (ie: IIVWT, WIVWT)
+func NewGPVWT(m Message) *GPVWT {
+	return &GPVWT{Message: m}
+}
+
+// GPVWT struct
+type GPVWT struct {
+	Message
+
+	Angle       float64 // True wind angle from the bow in degree (-180 ~ 180), negative = port
+	SpeedKnots  float64 // Wind speed in knots
+	SpeedMeters float64 // Wind speed in meters per second
+	SpeedKmh    float64 // Wind speed in km/h
+}
+
+// MWV return the true wind as MWV sentence in knots
+func (m GPVWT) MWV() GPMWV {
+	return GPMWV{
+		Angle:     normalizeDegrees(m.Angle),
+		Reference: TheoreticalWind,
+		Speed:     m.SpeedKnots,
+		SpeedUnit: Knots,
+		IsValid:   Valid,
+	}
+}
+
+func (m *GPVWT) parse() (err error) {
+	if len(m.Fields) != 8 {
+		return m.Error(fmt.Errorf("Incomplete GPVWT message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 8))
+	}
+
+	// Validate fixed field
+	for i, v := range map[int]string{3: "N", 5: "M", 7: "K"} {
+		if m.Fields[i] != v {
+			return m.Error(fmt.Errorf("Invalid fixed field at %d (got: %s, wanted: %s)", i+1, m.Fields[i], v))
+		}
+	}
+
+	if len(m.Fields[0]) > 0 {
+		if m.Angle, err = strconv.ParseFloat(m.Fields[0], 64); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse wind angle from data field (got: %s)", m.Fields[0]))
+		}
+
+		switch m.Fields[1] {
+		case "L":
+			m.Angle = -m.Angle
+		case "R":
+		default:
+			return m.Error(fmt.Errorf("Wrong wind angle direction (got: %s)", m.Fields[1]))
+		}
+	}
+
+	for i, v := range map[int]*float64{2: &m.SpeedKnots, 4: &m.SpeedMeters, 6: &m.SpeedKmh} {
+		if len(m.Fields[i]) > 0 {
+			if *v, err = strconv.ParseFloat(m.Fields[i], 64); err != nil {
+				return m.Error(fmt.Errorf("Unable to parse wind speed from data field (got: %s)", m.Fields[i]))
+			}
+		}
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence VWT as string
+func (m GPVWT) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPVWT")
+	side := "R"
+	if m.Angle < 0 {
+		side = "L"
+	}
+
+	fields := make([]string, 0)
+	fields = append(fields,
+		fmt.Sprintf("%05.1f", math.Abs(m.Angle)), side,
+		fmt.Sprintf("%.1f", m.SpeedKnots), "N",
+		fmt.Sprintf("%.1f", m.SpeedMeters), "M",
+		fmt.Sprintf("%.1f", m.SpeedKmh), "K")
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -0,0 +1,84 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+WCV Waypoint Closure Velocity
+       1   2 3    4 5
+       |   | |    | |
+$--WCV,x.x,N,c--c,m*hh
+
+1) Velocity component, knots
+2) N = Knots
+3) Waypoint ID
+4) Mode indicator (NMEA 2.3, optional)
+5) Checksum
+
+Examples:
+$GPWCV,2.1,N,POINTB*14
+$GPWCV,0.5,N,EGLM,A*72
+*/
+
+// NewGPWCV allocate GPWCV struct for WCV sentence (Waypoint closure velocity),
+// also used for other talkers (ie: IIWCV, INWCV)
+func NewGPWCV(m Message) *GPWCV {
+	return &GPWCV{Message: m}
+}
+
+// GPWCV struct
+type GPWCV struct {
+	Message
+
+	ClosureVelocity float64         // Velocity toward the waypoint in knots
+	WaypointID      string          // Waypoint ID
+	PositioningMode PositioningMode // Mode indicator, empty if not provided
+}
+
+func (m *GPWCV) parse() (err error) {
+	if len(m.Fields) != 3 && len(m.Fields) != 4 {
+		return m.Error(fmt.Errorf("Incomplete GPWCV message, not enougth data fields (got: %d, wanted: %d or %d)", len(m.Fields), 3, 4))
+	}
+
+	// Validate fixed field
+	if m.Fields[1] != "N" {
+		return m.Error(fmt.Errorf("Invalid fixed field at %d (got: %s, wanted: %s)", 2, m.Fields[1], "N"))
+	}
+
+	if len(m.Fields[0]) > 0 {
+		if m.ClosureVelocity, err = strconv.ParseFloat(m.Fields[0], 64); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse closure velocity from data field (got: %s)", m.Fields[0]))
+		}
+	}
+
+	m.WaypointID = m.Fields[2]
+
+	if len(m.Fields) == 4 {
+		if m.PositioningMode, err = ParsePositioningMode(m.Fields[3]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse mode indicator from data field (got: %s)", m.Fields[3]))
+		}
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence WCV as string
+func (m GPWCV) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPWCV")
+	fields := make([]string, 0)
+	fields = append(fields,
+		fmt.Sprintf("%.1f", m.ClosureVelocity), "N",
+		m.WaypointID)
+
+	if len(m.PositioningMode) > 0 {
+		fields = append(fields, m.PositioningMode.Serialize())
+	}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -0,0 +1,82 @@
+package nmea
+
+import (
+	"fmt"
+)
+
+/*
+WNC Distance, Waypoint to Waypoint
+       1   2 3   4 5    6    7
+       |   | |   | |    |    |
+$--WNC,x.x,N,x.x,K,c--c,c--c*hh
+
+1) Distance, nautical miles
+2) N = Nautical miles
+3) Distance, kilometers
+4) K = Kilometers
+5) TO waypoint ID
+6) FROM waypoint ID
+7) Checksum
+
+Examples:
+$GPWNC,200.0,N,370.4,K,POINTB,POINTA*49
+$GPWNC,,N,,K,WPT2,WPT1*4B
+*/
+
+// NewGPWNC allocate GPWNC struct for WNC sentence (Distance waypoint to waypoint),
+// also used for other talkers (ie: IIWNC, INWNC)
+func NewGPWNC(m Message) *GPWNC {
+	return &GPWNC{Message: m}
+}
+
+// GPWNC struct
+type GPWNC struct {
+	Message
+
+	DistanceNauticalMiles *float64 // Distance in nautical miles, nil if not provided
+	DistanceKilometers    *float64 // Distance in kilometers, nil if not provided
+	ToID                  string   // TO waypoint ID
+	FromID                string   // FROM waypoint ID
+}
+
+func (m *GPWNC) parse() (err error) {
+	if len(m.Fields) != 6 {
+		return m.Error(fmt.Errorf("Incomplete GPWNC message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 6))
+	}
+
+	// Validate fixed field
+	for i, v := range map[int]string{1: "N", 3: "K"} {
+		if m.Fields[i] != v {
+			return m.Error(fmt.Errorf("Invalid fixed field at %d (got: %s, wanted: %s)", i+1, m.Fields[i], v))
+		}
+	}
+
+	if m.DistanceNauticalMiles, err = parseOptionalFloat(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse distance from data field (got: %s)", m.Fields[0]))
+	}
+
+	if m.DistanceKilometers, err = parseOptionalFloat(m.Fields[2]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse distance from data field (got: %s)", m.Fields[2]))
+	}
+
+	m.ToID = m.Fields[4]
+	m.FromID = m.Fields[5]
+
+	return nil
+}
+
+// Serialize return a valid sentence WNC as string
+func (m GPWNC) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPWNC")
+	fields := make([]string, 0)
+	fields = append(fields,
+		formatOptionalFloat(m.DistanceNauticalMiles, "%.1f"), "N",
+		formatOptionalFloat(m.DistanceKilometers, "%.1f"), "K",
+		m.ToID,
+		m.FromID)
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
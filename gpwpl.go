@@ -0,0 +1,75 @@
+package nmea
+
+import (
+	"fmt"
+	"strings"
+)
+
+/*
+WPL Waypoint Location
+       1       2 3        4 5    6
+       |       | |        | |    |
+$--WPL,llll.ll,a,yyyyy.yy,a,c--c*hh
+
+1) Latitude
+2) N or S (North or South)
+3) Longitude
+4) E or W (East or West)
+5) Waypoint name
+6) Checksum
+
+Examples:
+$GPWPL,4917.16,N,12310.64,W,003*65
+$IIWPL,5130.02,N,12046.34,W,EGLM*4E
+*/
+
+// NewGPWPL allocate GPWPL struct for WPL sentence (Waypoint location),
+// also used for other talkers (ie: IIWPL, INWPL, ECWPL)
+func NewGPWPL(m Message) *GPWPL {
+	return &GPWPL{Message: m}
+}
+
+// GPWPL struct
+type GPWPL struct {
+	Message
+
+	Position Position // Waypoint location
+	Name     string   // Waypoint name
+}
+
+// Waypoint return the located waypoint described by the sentence
+func (m GPWPL) Waypoint() Waypoint {
+	pos := m.Position
+	return Waypoint{Name: m.Name, Position: &pos}
+}
+
+func (m *GPWPL) parse() (err error) {
+	if len(m.Fields) != 5 {
+		return m.Error(fmt.Errorf("Incomplete GPWPL message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 5))
+	}
+
+	if m.Position.Latitude, err = NewLatLong(strings.Join(m.Fields[0:2], " ")); err != nil {
+		return m.Error(err)
+	}
+
+	if m.Position.Longitude, err = NewLatLong(strings.Join(m.Fields[2:4], " ")); err != nil {
+		return m.Error(err)
+	}
+
+	m.Name = m.Fields[4]
+
+	return nil
+}
+
+// Serialize return a valid sentence WPL as string
+func (m GPWPL) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPWPL")
+	fields := make([]string, 0)
+	fields = append(fields, serializePosition(m.Position.Latitude, m.Position.Longitude, 2)...)
+	fields = append(fields, m.Name)
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -0,0 +1,217 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+XDR Transducer Measurements
+       1 2   3 4    n
+       | |   | |    |
+$--XDR,a,x.x,a,c--c,...*hh
+
+1) Transducer type
+2) Measurement data
+3) Units of measurement
+4) Transducer ID
+n) Checksum
+
+Fields 1 to 4 are repeated for each transducer measurement
+
+Transducer types and units:
+A) Angular displacement, D = Degrees, "-" means anticlockwise
+C) Temperature, C = Celsius
+D) Linear displacement, M = Meters, "-" means compression
+F) Frequency, H = Hertz
+G) Generic, none
+H) Humidity, P = Percent
+I) Current, A = Amperes
+L) Salinity, S = ppt
+N) Force, N = Newton, "-" means compression
+P) Pressure, B = Bars, P = Pascal
+R) Flow rate, l = Liters/second
+S) Switch or valve, none
+T) Tachometer, R = RPM
+U) Voltage, V = Volts
+V) Volume, M = Cubic meters
+
+Examples:
+$WIXDR,C,19.7,C,AIRTEMP,P,1.0175,B,BARO,H,56.2,P,RH*1A
+$IIXDR,A,-2.5,D,PITCH,A,3.1,D,ROLL*3D
+*/
+
+// NewGPXDR allocate GPXDR struct for XDR sentence (Transducer measurements),
+// also used for other talkers (ie: IIXDR, WIXDR)
+func NewGPXDR(m Message) *GPXDR {
+	return &GPXDR{Message: m}
+}
+
+// GPXDR struct
+type GPXDR struct {
+	Message
+
+	Measurements []Measurement
+}
+
+// Measurement struct is a transducer measurement
+type Measurement struct {
+	Type  TransducerType
+	Value *float64 // Measurement data, nil if not provided
+	Unit  string   // Units of measurement, depends on transducer type
+	ID    string   // Transducer ID
+}
+
+// String return Measurement as human string
+func (m Measurement) String() string {
+	value := "-"
+	if m.Value != nil {
+		value = strconv.FormatFloat(*m.Value, 'f', -1, 64)
+	}
+	return fmt.Sprintf("%s %s: %s %s", m.Type, m.ID, value, m.Unit)
+}
+
+// Measurement return the first measurement of a transducer type and ID,
+// an empty ID match any transducer of the type
+func (m GPXDR) Measurement(t TransducerType, id string) (Measurement, bool) {
+	for _, v := range m.Measurements {
+		if v.Type == t && (len(id) == 0 || v.ID == id) {
+			return v, true
+		}
+	}
+	return Measurement{}, false
+}
+
+func (m *GPXDR) parse() (err error) {
+	if len(m.Fields) < 4 || len(m.Fields)%4 != 0 {
+		return m.Error(fmt.Errorf("Incomplete GPXDR message, not enougth data fields (got: %d, wanted a multiple of: %d)", len(m.Fields), 4))
+	}
+
+	m.Measurements = make([]Measurement, 0, len(m.Fields)/4)
+	for i := 0; i < len(m.Fields); i += 4 {
+		v := Measurement{Unit: m.Fields[i+2], ID: m.Fields[i+3]}
+
+		if v.Type, err = ParseTransducerType(m.Fields[i]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse transducer type from data field (got: %s)", m.Fields[i]))
+		}
+
+		if v.Value, err = parseOptionalFloat(m.Fields[i+1]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse measurement from data field (got: %s)", m.Fields[i+1]))
+		}
+
+		m.Measurements = append(m.Measurements, v)
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence XDR as string
+func (m GPXDR) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPXDR")
+	fields := make([]string, 0)
+	for _, v := range m.Measurements {
+		value := ""
+		if v.Value != nil {
+			value = strconv.FormatFloat(*v.Value, 'f', -1, 64)
+		}
+		fields = append(fields, v.Type.Serialize(), value, v.Unit, v.ID)
+	}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+const (
+	// AngularDisplacementTransducer is a TransducerType type as string "A"
+	AngularDisplacementTransducer TransducerType = "A"
+	// TemperatureTransducer is a TransducerType type as string "C"
+	TemperatureTransducer TransducerType = "C"
+	// LinearDisplacementTransducer is a TransducerType type as string "D"
+	LinearDisplacementTransducer TransducerType = "D"
+	// FrequencyTransducer is a TransducerType type as string "F"
+	FrequencyTransducer TransducerType = "F"
+	// GenericTransducer is a TransducerType type as string "G"
+	GenericTransducer TransducerType = "G"
+	// HumidityTransducer is a TransducerType type as string "H"
+	HumidityTransducer TransducerType = "H"
+	// CurrentTransducer is a TransducerType type as string "I"
+	CurrentTransducer TransducerType = "I"
+	// SalinityTransducer is a TransducerType type as string "L"
+	SalinityTransducer TransducerType = "L"
+	// ForceTransducer is a TransducerType type as string "N"
+	ForceTransducer TransducerType = "N"
+	// PressureTransducer is a TransducerType type as string "P"
+	PressureTransducer TransducerType = "P"
+	// FlowRateTransducer is a TransducerType type as string "R"
+	FlowRateTransducer TransducerType = "R"
+	// SwitchTransducer is a TransducerType type as string "S"
+	SwitchTransducer TransducerType = "S"
+	// TachometerTransducer is a TransducerType type as string "T"
+	TachometerTransducer TransducerType = "T"
+	// VoltageTransducer is a TransducerType type as string "U"
+	VoltageTransducer TransducerType = "U"
+	// VolumeTransducer is a TransducerType type as string "V"
+	VolumeTransducer TransducerType = "V"
+)
+
+// TransducerType type as string
+type TransducerType string
+
+// Serialize return TransducerType as string
+func (t TransducerType) Serialize() string {
+	return string(t)
+}
+
+// String return TransducerType as human string
+func (t TransducerType) String() string {
+	switch t {
+	case AngularDisplacementTransducer:
+		return "angular displacement"
+	case TemperatureTransducer:
+		return "temperature"
+	case LinearDisplacementTransducer:
+		return "linear displacement"
+	case FrequencyTransducer:
+		return "frequency"
+	case GenericTransducer:
+		return "generic"
+	case HumidityTransducer:
+		return "humidity"
+	case CurrentTransducer:
+		return "current"
+	case SalinityTransducer:
+		return "salinity"
+	case ForceTransducer:
+		return "force"
+	case PressureTransducer:
+		return "pressure"
+	case FlowRateTransducer:
+		return "flow rate"
+	case SwitchTransducer:
+		return "switch"
+	case TachometerTransducer:
+		return "tachometer"
+	case VoltageTransducer:
+		return "voltage"
+	case VolumeTransducer:
+		return "volume"
+	default:
+		return "unknow"
+	}
+}
+
+// ParseTransducerType check TransducerType validity, return an error
+// "unknow value" if not
+func ParseTransducerType(raw string) (t TransducerType, err error) {
+	t = TransducerType(raw)
+	switch t {
+	case AngularDisplacementTransducer, TemperatureTransducer, LinearDisplacementTransducer, FrequencyTransducer, GenericTransducer, HumidityTransducer, CurrentTransducer,
+		SalinityTransducer, ForceTransducer, PressureTransducer, FlowRateTransducer, SwitchTransducer, TachometerTransducer, VoltageTransducer, VolumeTransducer:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
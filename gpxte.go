@@ -0,0 +1,111 @@
+package nmea
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+/*
+XTE Cross-Track Error, Measured
+       1 2 3   4 5 6 7
+       | | |   | | | |
+$--XTE,A,A,x.x,a,N,m*hh
+
+1) Status, A = Data valid, V = Loran-C blink or SNR warning (general warning flag)
+2) Status, A = Data valid, V = Loran-C cycle lock warning
+3) Cross track error magnitude
+4) Direction to steer, L or R
+5) Cross track units, N = Nautical miles
+6) Mode indicator (NMEA 2.3, optional)
+7) Checksum
+
+Examples:
+$GPXTE,A,A,0.67,L,N*6F
+$GPXTE,A,A,0.12,R,N,A*1E
+*/
+
+// NewGPXTE allocate GPXTE struct for XTE sentence (Cross-track error, measured),
+// also used for other talkers (ie: IIXTE, INXTE)
+func NewGPXTE(m Message) *GPXTE {
+	return &GPXTE{Message: m}
+}
+
+// GPXTE struct
+type GPXTE struct {
+	Message
+
+	IsValid          DataValid       // General warning flag
+	IsCycleLockValid DataValid       // Cycle lock warning flag
+	CrossTrackError  float64         // Cross track error magnitude in nautical miles
+	SteerDirection   SteerDirection  // Direction to steer to return on track
+	PositioningMode  PositioningMode // Mode indicator, empty if not provided
+}
+
+// NewCrossTrackError return a valid XTE sentence for the position p on the
+// track from origin to destination
+func NewCrossTrackError(p, origin, destination Position) GPXTE {
+	m := GPXTE{IsValid: Valid, IsCycleLockValid: Valid, SteerDirection: SteerRight}
+	xte := p.CrossTrackDistance(origin, destination)
+	if xte > 0 {
+		m.SteerDirection = SteerLeft
+	}
+	m.CrossTrackError = math.Abs(xte) / MetersPerNauticalMile
+	return m
+}
+
+func (m *GPXTE) parse() (err error) {
+	if len(m.Fields) != 5 && len(m.Fields) != 6 {
+		return m.Error(fmt.Errorf("Incomplete GPXTE message, not enougth data fields (got: %d, wanted: %d or %d)", len(m.Fields), 5, 6))
+	}
+
+	// Validate fixed field
+	if m.Fields[4] != "N" {
+		return m.Error(fmt.Errorf("Invalid fixed field at %d (got: %s, wanted: %s)", 5, m.Fields[4], "N"))
+	}
+
+	m.IsValid = (m.Fields[0] == "A")
+	m.IsCycleLockValid = (m.Fields[1] == "A")
+
+	if len(m.Fields[2]) > 0 {
+		if m.CrossTrackError, err = strconv.ParseFloat(m.Fields[2], 64); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse cross track error from data field (got: %s)", m.Fields[2]))
+		}
+	}
+
+	if len(m.Fields[3]) > 0 {
+		if m.SteerDirection, err = ParseSteerDirection(m.Fields[3]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse direction to steer from data field (got: %s)", m.Fields[3]))
+		}
+	}
+
+	if len(m.Fields) == 6 {
+		if m.PositioningMode, err = ParsePositioningMode(m.Fields[5]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse mode indicator from data field (got: %s)", m.Fields[5]))
+		}
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence XTE as string
+func (m GPXTE) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPXTE")
+	fields := make([]string, 0)
+	fields = append(fields,
+		m.IsValid.Serialize(),
+		m.IsCycleLockValid.Serialize(),
+		fmt.Sprintf("%.2f", m.CrossTrackError),
+		m.SteerDirection.Serialize(),
+		"N")
+
+	if len(m.PositioningMode) > 0 {
+		fields = append(fields, m.PositioningMode.Serialize())
+	}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -0,0 +1,96 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+/*
+ZDA Time & Date - UTC, day, month, year and local time zone
+       1         2  3  4    5  6  7
+       |         |  |  |    |  |  |
+$--ZDA,hhmmss.ss,xx,xx,xxxx,xx,xx*hh
+
+1) Time (UTC)
+2) Day, 01 to 31
+3) Month, 01 to 12
+4) Year
+5) Local zone description, 00 to +/- 13 hours
+6) Local zone minutes description (same sign as hours)
+7) Checksum
+
+Example:
+$GPZDA,160012.71,11,03,2004,-1,00*7D
+*/
+
+// NewGPZDA allocate GPZDA struct for ZDA sentence (Time & Date),
+// also used for other talkers (ie: GNZDA, GLZDA, GAZDA)
+func NewGPZDA(m Message) *GPZDA {
+	return &GPZDA{Message: m}
+}
+
+// GPZDA struct
+type GPZDA struct {
+	Message
+
+	DateTimeUTC      time.Time // Aggregation of TimeUTC+Date data field
+	LocalZoneHours   int
+	LocalZoneMinutes int
+}
+
+func (m *GPZDA) parse() (err error) {
+	if len(m.Fields) != 6 {
+		return m.Error(fmt.Errorf("Incomplete GPZDA message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 6))
+	}
+
+	datetime := fmt.Sprintf("%s %s %s %s", m.Fields[1], m.Fields[2], m.Fields[3], m.Fields[0])
+	if m.DateTimeUTC, err = time.Parse("02 01 2006 150405", datetime); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse datetime UTC from data field (got: %s)", datetime))
+	}
+
+	if len(m.Fields[4]) > 0 {
+		if m.LocalZoneHours, err = strconv.Atoi(m.Fields[4]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse local zone hours from data field (got: %s)", m.Fields[4]))
+		}
+	}
+
+	if len(m.Fields[5]) > 0 {
+		if m.LocalZoneMinutes, err = strconv.Atoi(m.Fields[5]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse local zone minutes from data field (got: %s)", m.Fields[5]))
+		}
+	}
+
+	return nil
+}
+
+// Location return the local time zone described by the sentence
+func (m GPZDA) Location() *time.Location {
+	offset := m.LocalZoneHours*3600 + m.LocalZoneMinutes*60
+	return time.FixedZone(fmt.Sprintf("UTC%+03d:%02d", m.LocalZoneHours, abs(m.LocalZoneMinutes)), offset)
+}
+
+// Serialize return a valid sentence ZDA as string
+func (m GPZDA) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPZDA")
+	fields := make([]string, 0)
+	fields = append(fields,
+		m.DateTimeUTC.Format("150405.00"),
+		m.DateTimeUTC.Format("02"),
+		m.DateTimeUTC.Format("01"),
+		m.DateTimeUTC.Format("2006"),
+		fmt.Sprintf("%02d", m.LocalZoneHours),
+		fmt.Sprintf("%02d", m.LocalZoneMinutes))
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+func abs(i int) int {
+	if i < 0 {
+		return -i
+	}
+	return i
+}
@@ -0,0 +1,124 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+/*
+ZDL Time and Distance to Variable Point
+       1         2   3
+       |         |   |
+$--ZDL,hhmmss.ss,x.x,a*hh
+
+1) Time to point, hh = 00 to 99
+2) Distance to point, nautical miles
+3) Type of point:
+	C = collision
+	T = turning point
+	R = reference (general)
+	W = wheelover
+4) Checksum
+
+Examples:
+$GPZDL,002345.50,1.25,W*0D
+$GPZDL,010000.00,12.30,C*2B
+*/
+
+// NewGPZDL allocate GPZDL struct for ZDL sentence (time and distance to variable point)
+func NewGPZDL(m Message) *GPZDL {
+	return &GPZDL{Message: m}
+}
+
+// GPZDL struct
+type GPZDL struct {
+	Message
+
+	TimeToPoint     time.Duration
+	DistanceToPoint float64 // Distance to point in nautical miles
+	PointType       PointType
+}
+
+func (m *GPZDL) parse() (err error) {
+	if len(m.Fields) != 3 {
+		return m.Error(fmt.Errorf("Incomplete GPZDL message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 3))
+	}
+
+	if m.TimeToPoint, err = parseElapsedTime(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse time to point from data field (got: %s)", m.Fields[0]))
+	}
+
+	if m.DistanceToPoint, err = strconv.ParseFloat(m.Fields[1], 64); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse distance to point from data field (got: %s)", m.Fields[1]))
+	}
+
+	if m.PointType, err = ParsePointType(m.Fields[2]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse type of point from data field (got: %s)", m.Fields[2]))
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence ZDL as string
+func (m GPZDL) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPZDL")
+	fields := make([]string, 0)
+	fields = append(fields,
+		formatElapsedTime(m.TimeToPoint),
+		fmt.Sprintf("%.2f", m.DistanceToPoint),
+		m.PointType.Serialize())
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+const (
+	// CollisionPoint is a PointType type as string "C"
+	CollisionPoint PointType = "C"
+	// TurningPoint is a PointType type as string "T"
+	TurningPoint PointType = "T"
+	// ReferencePoint is a PointType type as string "R"
+	ReferencePoint PointType = "R"
+	// WheelOverPoint is a PointType type as string "W"
+	WheelOverPoint PointType = "W"
+)
+
+// PointType type as string
+type PointType string
+
+// Serialize return PointType as string
+func (p PointType) Serialize() string {
+	return string(p)
+}
+
+// String return PointType as human string
+func (p PointType) String() string {
+	switch p {
+	case CollisionPoint:
+		return "collision"
+	case TurningPoint:
+		return "turning point"
+	case ReferencePoint:
+		return "reference"
+	case WheelOverPoint:
+		return "wheelover"
+	default:
+		return "unknow"
+	}
+}
+
+// ParsePointType check PointType validity, return an error
+// "unknow value" if not
+func ParsePointType(raw string) (p PointType, err error) {
+	p = PointType(raw)
+	switch p {
+	case CollisionPoint, TurningPoint, ReferencePoint, WheelOverPoint:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
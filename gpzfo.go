@@ -0,0 +1,76 @@
+package nmea
+
+import (
+	"fmt"
+	"time"
+)
+
+/*
+ZFO UTC & Time from Origin Waypoint
+       1         2         3    4
+       |         |         |    |
+$--ZFO,hhmmss.ss,hhmmss.ss,c--c*hh
+
+1) Universal Time Coordinated (UTC)
+2) Elapsed time, hh = 00 to 99
+3) Origin waypoint ID
+4) Checksum
+
+Examples:
+$GPZFO,145832.12,042359.17,WPT*3E
+$GPZFO,093015.00,120512.50,HOME*69
+*/
+
+// NewGPZFO allocate GPZFO struct for ZFO sentence (UTC & time from origin waypoint)
+func NewGPZFO(m Message) *GPZFO {
+	return &GPZFO{Message: m}
+}
+
+// GPZFO struct
+type GPZFO struct {
+	Message
+
+	TimeUTC     TimeOfDay
+	ElapsedTime time.Duration // Time elapsed since origin waypoint
+	OriginID    string
+}
+
+func (m *GPZFO) parse() (err error) {
+	if len(m.Fields) != 3 {
+		return m.Error(fmt.Errorf("Incomplete GPZFO message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 3))
+	}
+
+	if m.TimeUTC, err = ParseTimeOfDay(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse time UTC from data field (got: %s)", m.Fields[0]))
+	}
+
+	if m.ElapsedTime, err = parseElapsedTime(m.Fields[1]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse elapsed time from data field (got: %s)", m.Fields[1]))
+	}
+
+	m.OriginID = m.Fields[2]
+
+	return nil
+}
+
+// Departure return time of departure from origin waypoint, the date of the
+// observation is given by date (UTC)
+func (m GPZFO) Departure(date time.Time) time.Time {
+	return m.TimeUTC.On(date).Add(-m.ElapsedTime)
+}
+
+// Serialize return a valid sentence ZFO as string
+func (m GPZFO) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPZFO")
+	fields := make([]string, 0)
+	fields = append(fields,
+		m.TimeUTC.Serialize(),
+		formatElapsedTime(m.ElapsedTime),
+		m.OriginID)
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -0,0 +1,76 @@
+package nmea
+
+import (
+	"fmt"
+	"time"
+)
+
+/*
+ZTG UTC & Time to Destination Waypoint
+       1         2         3    4
+       |         |         |    |
+$--ZTG,hhmmss.ss,hhmmss.ss,c--c*hh
+
+1) Universal Time Coordinated (UTC)
+2) Time remaining to go, hh = 00 to 99
+3) Destination waypoint ID
+4) Checksum
+
+Examples:
+$GPZTG,145832.12,042359.17,WPT*24
+$GPZTG,093015.00,000512.50,HOME*70
+*/
+
+// NewGPZTG allocate GPZTG struct for ZTG sentence (UTC & time to destination waypoint)
+func NewGPZTG(m Message) *GPZTG {
+	return &GPZTG{Message: m}
+}
+
+// GPZTG struct
+type GPZTG struct {
+	Message
+
+	TimeUTC       TimeOfDay
+	TimeToGo      time.Duration // Time remaining to reach destination waypoint
+	DestinationID string
+}
+
+func (m *GPZTG) parse() (err error) {
+	if len(m.Fields) != 3 {
+		return m.Error(fmt.Errorf("Incomplete GPZTG message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 3))
+	}
+
+	if m.TimeUTC, err = ParseTimeOfDay(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse time UTC from data field (got: %s)", m.Fields[0]))
+	}
+
+	if m.TimeToGo, err = parseElapsedTime(m.Fields[1]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse time to go from data field (got: %s)", m.Fields[1]))
+	}
+
+	m.DestinationID = m.Fields[2]
+
+	return nil
+}
+
+// ETA return estimated time of arrival at destination waypoint, the date of
+// the observation is given by date (UTC)
+func (m GPZTG) ETA(date time.Time) time.Time {
+	return m.TimeUTC.On(date).Add(m.TimeToGo)
+}
+
+// Serialize return a valid sentence ZTG as string
+func (m GPZTG) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GPZTG")
+	fields := make([]string, 0)
+	fields = append(fields,
+		m.TimeUTC.Serialize(),
+		formatElapsedTime(m.TimeToGo),
+		m.DestinationID)
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
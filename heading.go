@@ -0,0 +1,177 @@
+package nmea
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+const (
+	// HeadingSourceNone means no heading available
+	HeadingSourceNone HeadingSource = iota
+	// HeadingSourceCOG is heading derived from course over ground
+	HeadingSourceCOG
+	// HeadingSourceMagnetic is heading from a magnetic compass corrected with deviation and variation
+	HeadingSourceMagnetic
+	// HeadingSourceTrue is heading from a gyro or satellite compass
+	HeadingSourceTrue
+)
+
+// HeadingSource type as int, higher is better
+type HeadingSource int
+
+// String return HeadingSource as human string
+func (s HeadingSource) String() string {
+	switch s {
+	case HeadingSourceNone:
+		return "none"
+	case HeadingSourceCOG:
+		return "COG"
+	case HeadingSourceMagnetic:
+		return "magnetic"
+	case HeadingSourceTrue:
+		return "true"
+	default:
+		return "unknow"
+	}
+}
+
+// Heading struct is a true heading with its provenance
+type Heading struct {
+	Value  float64 // Heading in degree (true)
+	Source HeadingSource
+	Time   time.Time // Local time of the source data
+}
+
+// String return Heading as human string
+func (h Heading) String() string {
+	return fmt.Sprintf("%.1f° (%s)", h.Value, h.Source)
+}
+
+// HeadingFusion struct selects the best available heading source
+// (true > magnetic+variation > COG) and rate-limits jumps between values
+type HeadingFusion struct {
+	Timeout   time.Duration // Delay after which a source is considered stale
+	MinSpeed  float64       // Minimum speed over ground in knots to use COG as heading
+	MaxRate   float64       // Maximum heading change in degree per second, 0 to disable rate-limiting
+	Variation float64       // Default magnetic variation in degree (negative = West)
+	Deviation float64       // Default compass deviation in degree (negative = West)
+
+	sources    map[HeadingSource]Heading
+	rot        *float64 // Rate of turn in degree per minute (negative = port)
+	rotTime    time.Time
+	output     *Heading
+	outputTime time.Time
+}
+
+// NewHeadingFusion allocate HeadingFusion struct with stale timeout
+func NewHeadingFusion(timeout time.Duration) *HeadingFusion {
+	return &HeadingFusion{
+		Timeout:  timeout,
+		MinSpeed: 1,
+		MaxRate:  30,
+		sources:  make(map[HeadingSource]Heading),
+	}
+}
+
+// UpdateTrue feed HeadingFusion with a true heading in degree received at time now
+func (f *HeadingFusion) UpdateTrue(heading float64, now time.Time) {
+	f.sources[HeadingSourceTrue] = Heading{Value: normalizeDegrees(heading), Source: HeadingSourceTrue, Time: now}
+}
+
+// UpdateMagnetic feed HeadingFusion with a magnetic sensor heading in degree received at time now,
+// deviation and variation (negative = West) default to the configured ones if nil
+func (f *HeadingFusion) UpdateMagnetic(heading float64, deviation, variation *float64, now time.Time) {
+	dev, vari := f.Deviation, f.Variation
+	if deviation != nil {
+		dev = *deviation
+	}
+	if variation != nil {
+		vari = *variation
+	}
+	f.sources[HeadingSourceMagnetic] = Heading{Value: normalizeDegrees(heading + dev + vari), Source: HeadingSourceMagnetic, Time: now}
+}
+
+// UpdateCOG feed HeadingFusion with course over ground in degree and speed in knots received at time now,
+// ignored under MinSpeed
+func (f *HeadingFusion) UpdateCOG(cog, speed float64, now time.Time) {
+	if speed < f.MinSpeed {
+		return
+	}
+	f.sources[HeadingSourceCOG] = Heading{Value: normalizeDegrees(cog), Source: HeadingSourceCOG, Time: now}
+}
+
+// UpdateRateOfTurn feed HeadingFusion with rate of turn in degree per minute (negative = port)
+func (f *HeadingFusion) UpdateRateOfTurn(rot float64, now time.Time) {
+	f.rot, f.rotTime = &rot, now
+}
+
+// Update feed HeadingFusion with a message received at time now
+func (f *HeadingFusion) Update(msg NMEA, now time.Time) {
+	switch m := msg.(type) {
+	case *GPRMC:
+		if m.IsValid {
+			f.UpdateCOG(m.COG, m.Speed, now)
+		}
+	case *GPVTG:
+		f.UpdateCOG(m.COG, m.SpeedKnots, now)
+	case *GPHDT:
+		f.UpdateTrue(m.Heading, now)
+	case *GPHDM:
+		f.UpdateMagnetic(m.Heading, nil, nil, now)
+	case *GPHDG:
+		f.UpdateMagnetic(m.Heading, m.Deviation, m.Variation, now)
+	case *GPTHS:
+		if m.IsValid() {
+			f.UpdateTrue(m.Heading, now)
+		}
+	case *PASHR:
+		if m.IsTrueHeading {
+			f.UpdateTrue(m.Heading, now)
+		}
+	case *PRDID:
+		f.UpdateTrue(m.Heading, now)
+	case *PSXN23:
+		f.UpdateTrue(m.Heading, now)
+	case *PFECGPatt:
+		f.UpdateTrue(m.Yaw, now)
+	case *GPROT:
+		if m.IsValid {
+			f.UpdateRateOfTurn(m.RateOfTurn, now)
+		}
+	}
+}
+
+// Heading return the fused heading at time now, false if no source is available
+func (f *HeadingFusion) Heading(now time.Time) (Heading, bool) {
+	var best *Heading
+	for _, src := range []HeadingSource{HeadingSourceTrue, HeadingSourceMagnetic, HeadingSourceCOG} {
+		if h, ok := f.sources[src]; ok && (f.Timeout <= 0 || now.Sub(h.Time) <= f.Timeout) {
+			best = &h
+			break
+		}
+	}
+
+	if best == nil {
+		return Heading{}, false
+	}
+
+	out := *best
+	if dt := now.Sub(f.outputTime).Seconds(); f.output != nil && f.MaxRate > 0 {
+		if dt <= 0 {
+			return *f.output, true // No elapsed time to turn: hold the last output
+		}
+
+		allowed := f.MaxRate * dt
+		if f.rot != nil && (f.Timeout <= 0 || now.Sub(f.rotTime) <= f.Timeout) {
+			allowed = math.Abs(*f.rot)/60*dt + f.MaxRate*dt/10 // Expected turn with a small tolerance
+		}
+
+		if diff := angleDiff(f.output.Value, out.Value); math.Abs(diff) > allowed {
+			out.Value = normalizeDegrees(f.output.Value + math.Copysign(allowed, diff))
+		}
+	}
+
+	f.output, f.outputTime = &out, now
+	return out, true
+}
@@ -0,0 +1,61 @@
+package nmea
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHeadingFusion(t *testing.T) {
+	start := time.Date(2020, time.June, 1, 12, 0, 0, 0, time.UTC)
+	f := NewHeadingFusion(5 * time.Second)
+	f.MaxRate = 0
+
+	if _, ok := f.Heading(start); ok {
+		t.Fatal("Heading shouldn't be available without source")
+	}
+
+	// True heading is preferred over magnetic and COG
+	f.UpdateCOG(80, 5, start)
+	f.UpdateMagnetic(90, nil, nil, start)
+	f.UpdateTrue(100, start)
+	if h, ok := f.Heading(start); !ok || h.Source != HeadingSourceTrue || h.Value != 100 {
+		t.Fatalf("Wrong heading source (got: %s)", h)
+	}
+
+	// Stale true heading falls back to magnetic corrected with variation
+	f.Variation = -2
+	f.UpdateMagnetic(90, nil, nil, start.Add(4*time.Second))
+	if h, _ := f.Heading(start.Add(6 * time.Second)); h.Source != HeadingSourceMagnetic || h.Value != 88 {
+		t.Fatalf("Wrong heading with stale true source (got: %s)", h)
+	}
+
+	// COG is ignored under minimum speed
+	f.UpdateCOG(180, 0.5, start.Add(10*time.Second))
+	if h, ok := f.Heading(start.Add(20 * time.Second)); ok {
+		t.Fatalf("All sources should be stale (got: %s)", h)
+	}
+}
+
+func TestHeadingRateLimit(t *testing.T) {
+	start := time.Date(2020, time.June, 1, 12, 0, 0, 0, time.UTC)
+	f := NewHeadingFusion(5 * time.Second)
+
+	f.UpdateTrue(350, start)
+	f.Heading(start)
+
+	// Jump of 90° is limited to 30°/s, the shortest way
+	f.UpdateTrue(80, start.Add(time.Second))
+	if h, _ := f.Heading(start.Add(time.Second)); Round(h.Value, 6) != 20 {
+		t.Fatalf("Wrong rate-limited heading (got: %s)", h)
+	}
+
+	// Output is held when called twice at the same time
+	if h, _ := f.Heading(start.Add(time.Second)); Round(h.Value, 6) != 20 {
+		t.Fatalf("Wrong heading at the same time (got: %s)", h)
+	}
+
+	// And keeps turning at the limited rate afterwards
+	if h, _ := f.Heading(start.Add(2 * time.Second)); Round(h.Value, 6) != 50 {
+		t.Fatalf("Wrong rate-limited heading (got: %s)", h)
+	}
+}
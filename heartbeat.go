@@ -0,0 +1,65 @@
+package nmea
+
+import (
+	"sort"
+	"time"
+)
+
+// Heartbeat struct generates the HBT sentences of a piece of equipment
+type Heartbeat struct {
+	Interval time.Duration // Repeat interval announced in sentences
+	seq      int
+}
+
+// NewHeartbeat allocate Heartbeat struct with repeat interval
+func NewHeartbeat(interval time.Duration) *Heartbeat {
+	return &Heartbeat{Interval: interval, seq: -1}
+}
+
+// Next return the next HBT sentence, with sequence identifier from 0 to 9
+func (h *Heartbeat) Next(status DataValid) GPHBT {
+	h.seq = (h.seq + 1) % 10
+	return GPHBT{Interval: h.Interval, IsNormal: status, SequenceNumber: h.seq}
+}
+
+// HeartbeatSupervisor struct supervises connected equipment from their HBT
+// sentences, by talker
+type HeartbeatSupervisor struct {
+	Tolerance time.Duration // Extra delay allowed after the repeat interval
+	last      map[TalkerID]time.Time
+	beats     map[TalkerID]GPHBT
+}
+
+// NewHeartbeatSupervisor allocate HeartbeatSupervisor struct
+func NewHeartbeatSupervisor(tolerance time.Duration) *HeartbeatSupervisor {
+	return &HeartbeatSupervisor{
+		Tolerance: tolerance,
+		last:      make(map[TalkerID]time.Time),
+		beats:     make(map[TalkerID]GPHBT),
+	}
+}
+
+// Update feed HeartbeatSupervisor with a message received at time now,
+// other messages than HBT are ignored
+func (s *HeartbeatSupervisor) Update(msg NMEA, now time.Time) {
+	m, ok := msg.(*GPHBT)
+	if !ok {
+		return
+	}
+	talker := m.Type.GetTypeID().Talker
+	s.last[talker] = now
+	s.beats[talker] = *m
+}
+
+// Failed return the talkers whose heartbeat is late or report a system
+// failure at time now, ordered by talker
+func (s HeartbeatSupervisor) Failed(now time.Time) []TalkerID {
+	talkers := make([]TalkerID, 0)
+	for talker, hbt := range s.beats {
+		if hbt.IsNormal != Valid || now.Sub(s.last[talker]) > hbt.Interval+s.Tolerance {
+			talkers = append(talkers, talker)
+		}
+	}
+	sort.Slice(talkers, func(i, j int) bool { return talkers[i] < talkers[j] })
+	return talkers
+}
@@ -0,0 +1,35 @@
+package nmea
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHeartbeatSupervisor(t *testing.T) {
+	h := NewHeartbeat(5 * time.Second)
+	for i := 0; i < 10; i++ {
+		h.Next(Valid)
+	}
+	// Sequence identifier wraps around after 9
+	if hbt := h.Next(Valid); hbt.Serialize() != "$GPHBT,5.0,A,0*3F" {
+		t.Fatalf("Wrong heartbeat (got: %s)", hbt.Serialize())
+	}
+
+	s := NewHeartbeatSupervisor(time.Second)
+	start := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	for _, raw := range []string{"$IIHBT,30.0,A,3*1D", "$GPHBT,5.0,V,0*28"} {
+		msg, err := Parse(raw)
+		if err != nil {
+			t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+		}
+		s.Update(msg, start)
+	}
+
+	if failed := s.Failed(start.Add(10 * time.Second)); len(failed) != 1 || failed[0] != TalkerIDGPS {
+		t.Fatalf("Wrong failed equipment (got: %v)", failed)
+	}
+
+	if failed := s.Failed(start.Add(32 * time.Second)); len(failed) != 2 {
+		t.Fatalf("Late heartbeat should fail (got: %v)", failed)
+	}
+}
@@ -3,6 +3,7 @@ package nmea
 import (
 	"fmt"
 	"math"
+	"strconv"
 )
 
 // PrependXZero return string with expected number of zero (as prefix)
@@ -36,3 +37,23 @@ func Round(value float64, expected int) float64 {
 	}
 	return math.Floor(digit) / pow
 }
+
+// parseOptionalFloat return nil for an empty data field
+func parseOptionalFloat(raw string) (*float64, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	v, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// formatOptionalFloat return an empty data field for nil value
+func formatOptionalFloat(v *float64, format string) string {
+	if v == nil {
+		return ""
+	}
+	return fmt.Sprintf(format, *v)
+}
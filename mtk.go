@@ -0,0 +1,88 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// MediaTek limits of configuration commands
+const (
+	// MTKMaxOutputRate is the maximum output rate of a sentence, in number of position fixes
+	MTKMaxOutputRate = 5
+	// MTKMinUpdateRate is the minimum interval between position fixes
+	MTKMinUpdateRate = 100 * time.Millisecond
+	// MTKMaxUpdateRate is the maximum interval between position fixes
+	MTKMaxUpdateRate = 10 * time.Second
+)
+
+// MTKBaudRates are the baud rates allowed by PMTK251 command, 0 restores the default one
+var MTKBaudRates = []int{0, 4800, 9600, 14400, 19200, 38400, 57600, 115200}
+
+// MTKOutputRates struct is the output rate of each sentence for PMTK314
+// command, as a number of position fixes between two outputs (0 disables the sentence)
+type MTKOutputRates struct {
+	GLL int
+	RMC int
+	VTG int
+	GGA int
+	GSA int
+	GSV int
+	ZDA int
+}
+
+// NewMTKCommand return a PMTK command sentence of packet type with data fields,
+// the checksum is computed
+func NewMTKCommand(packetType string, fields ...string) Message {
+	hdr, ok := TypeIDs["PMTK"+packetType]
+	if !ok {
+		hdr = MtkTypeID{TypeID: TypeID{Talker: TalkerIDProprietary, Code: "MTK"}, PacketType: packetType}
+	}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+	return msg
+}
+
+// NewMTKSetOutputRates return the PMTK314 command setting the output rate of each sentence
+func NewMTKSetOutputRates(r MTKOutputRates) (Message, error) {
+	// Fields 6 to 16 are reserved, 18 is MCHN (not documented)
+	rates := []int{r.GLL, r.RMC, r.VTG, r.GGA, r.GSA, r.GSV, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, r.ZDA, 0}
+	fields := make([]string, 0)
+	for _, rate := range rates {
+		if rate < 0 || rate > MTKMaxOutputRate {
+			return Message{}, fmt.Errorf("Invalid output rate (got: %d, wanted: 0 to %d)", rate, MTKMaxOutputRate)
+		}
+		fields = append(fields, strconv.Itoa(rate))
+	}
+	return NewMTKCommand("314", fields...), nil
+}
+
+// NewMTKRestoreOutputRates return the PMTK314 command restoring the default output rates
+func NewMTKRestoreOutputRates() Message {
+	return NewMTKCommand("314", "-1")
+}
+
+// NewMTKSetUpdateRate return the PMTK220 command setting the interval between position fixes
+func NewMTKSetUpdateRate(interval time.Duration) (Message, error) {
+	if interval < MTKMinUpdateRate || interval > MTKMaxUpdateRate {
+		return Message{}, fmt.Errorf("Invalid update rate (got: %s, wanted: %s to %s)", interval, MTKMinUpdateRate, MTKMaxUpdateRate)
+	}
+	return NewMTKCommand("220", strconv.Itoa(int(interval/time.Millisecond))), nil
+}
+
+// NewMTKSetBaudRate return the PMTK251 command setting the baud rate of NMEA port
+func NewMTKSetBaudRate(baud int) (Message, error) {
+	for _, allowed := range MTKBaudRates {
+		if baud == allowed {
+			return NewMTKCommand("251", strconv.Itoa(baud)), nil
+		}
+	}
+	return Message{}, fmt.Errorf("Invalid baud rate (got: %d, wanted: one of %v)", baud, MTKBaudRates)
+}
+
+// NewMTKStandby return the PMTK161 command entering standby mode, any byte
+// sent to the module wakes it up
+func NewMTKStandby() Message {
+	return NewMTKCommand("161", "0")
+}
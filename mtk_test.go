@@ -0,0 +1,96 @@
+package nmea
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMTKCommands(t *testing.T) {
+	rates, err := NewMTKSetOutputRates(MTKOutputRates{RMC: 1, GGA: 1, GSA: 1, GSV: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	update, err := NewMTKSetUpdateRate(200 * time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	baud, err := NewMTKSetBaudRate(115200)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	commands := map[string]Message{
+		"$PMTK314,0,1,0,1,1,5,0,0,0,0,0,0,0,0,0,0,0,0,0*2C": rates,
+		"$PMTK314,-1*04":     NewMTKRestoreOutputRates(),
+		"$PMTK220,200*2C":    update,
+		"$PMTK251,115200*1F": baud,
+		"$PMTK161,0*28":      NewMTKStandby(),
+		"$PMTK101*32":        NewMTKCommand("101"),
+	}
+
+	for wanted, cmd := range commands {
+		if cmd.Serialize() != wanted {
+			t.Fatalf("Wrong MTK command (got: %s, wanted: %s)", cmd.Serialize(), wanted)
+		}
+	}
+
+	if _, err := NewMTKSetOutputRates(MTKOutputRates{GSV: 6}); err == nil {
+		t.Fatal("Output rate out of range should be rejected")
+	}
+
+	if _, err := NewMTKSetUpdateRate(20 * time.Second); err == nil {
+		t.Fatal("Update rate out of range should be rejected")
+	}
+
+	if _, err := NewMTKSetBaudRate(1200); err == nil {
+		t.Fatal("Unsupported baud rate should be rejected")
+	}
+
+	msg, err := Parse("$PMTK001,314,3*36")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ack := msg.(*PMTK001); !ack.Acknowledges(rates) || ack.Acknowledges(baud) || !ack.Succeeded() {
+		t.Fatalf("Wrong MTK acknowledgement (got: %+v)", ack)
+	}
+
+	msg, err = Parse("$PMTK010,001*2E")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sys := msg.(*PMTK010); sys.SystemMessage != StartupSystemMessage {
+		t.Fatalf("Wrong MTK system message (got: %s)", sys.SystemMessage)
+	}
+}
+
+func TestMTKStatus(t *testing.T) {
+	msg, err := Parse("$PMTK705,AXN_3.10_3333_12102201,0000,QUECTEL-L80,*11")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if release := msg.(*PMTK705); release.ProductModel != "QUECTEL-L80" || release.SDKVersion == nil || *release.SDKVersion != "" {
+		t.Fatalf("Wrong MTK firmware release (got: %+v)", release)
+	}
+
+	msg, err = Parse("$PMTK011,MTKGPS*08")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !msg.(*PMTK011).IsStartup() {
+		t.Fatal("MTKGPS text should be the startup message")
+	}
+
+	msg, err = Parse("$PMTK707,56,1468,172800,1470,151200,1468,237600,1468,259200*13")
+	if err != nil {
+		t.Fatal(err)
+	}
+	epo := msg.(*PMTK707)
+	if wanted := time.Date(2008, time.February, 26, 18, 0, 0, 0, time.UTC); !epo.FirstCurrent.Equal(wanted) {
+		t.Fatalf("Wrong EPO first current set (got: %s, wanted: %s)", epo.FirstCurrent, wanted)
+	}
+	if !epo.IsValid(epo.LastCurrent.Add(time.Hour)) || epo.IsValid(epo.LastCurrent.Add(6*time.Hour)) {
+		t.Fatal("Wrong EPO validity")
+	}
+}
@@ -36,6 +36,15 @@ func (m Message) Error(err error) error {
 	return fmt.Errorf("[%s] %s (with payload: %s)", m.Type.Serialize(), err.Error(), strings.Join(m.Fields, FieldDelimiter))
 }
 
+// header return the type of message, or the default one for a message
+// crafted from scratch
+func (m Message) header(code string) Header {
+	if m.Type != nil {
+		return m.Type
+	}
+	return TypeIDs[code]
+}
+
 // Serialize NMEA message to render raw
 func (m Message) Serialize() string {
 	output := Prefix + m.Payload() + Suffix
@@ -62,6 +71,17 @@ func (m Message) ComputeChecksum() (c uint8) {
 	return
 }
 
+// ChecksumError is returned by Parse when the checksum of a message mismatch
+type ChecksumError struct {
+	Message Message
+	Wanted  uint8
+}
+
+// Error return checksum mismatch description with wrapped data
+func (e ChecksumError) Error() string {
+	return e.Message.Error(fmt.Errorf("Checksump mismatch (got: 0x%x, wanted: 0x%x)", e.Message.Checksum, e.Wanted)).Error()
+}
+
 func (m *Message) parse(data string) (err error) {
 	if len(data) < (len(Prefix) + len(Suffix) + 2) { // +2 for checksum in hex format
 		return fmt.Errorf("Wrong length")
@@ -87,6 +107,12 @@ func (m *Message) parse(data string) (err error) {
 	}
 
 	typ, ok := TypeIDs[fields[0]]
+	if !ok && len(fields) > 1 {
+		// Proprietary sentence with a sub-type as first data field (ie: PUBX,00)
+		if typ, ok = TypeIDs[fields[0]+FieldDelimiter+fields[1]]; ok {
+			fields = fields[1:]
+		}
+	}
 	if !ok {
 		return fmt.Errorf("Message should countains a valid type id (got: %s)", fields[0])
 	}
@@ -102,7 +128,7 @@ func (m *Message) parse(data string) (err error) {
 	}
 
 	if m.Checksum = uint8(checksum); m.Checksum != m.ComputeChecksum() {
-		return m.Error(fmt.Errorf("Checksump mismatch (got: 0x%x, wanted: 0x%x)", checksum, m.ComputeChecksum()))
+		return ChecksumError{Message: *m, Wanted: m.ComputeChecksum()}
 	}
 
 	return nil
@@ -136,7 +162,7 @@ func Parse(raw string) (NMEA, error) {
 		gpgsa := NewGPGSA(*m)
 		err = gpgsa.parse()
 		return gpgsa, err
-	case "GPGSV":
+	case "GPGSV", "GLGSV", "GAGSV":
 		gpgsv := NewGPGSV(*m)
 		err = gpgsv.parse()
 		return gpgsv, err
@@ -148,10 +174,390 @@ func Parse(raw string) (NMEA, error) {
 		gptxt := NewGPTXT(*m)
 		err = gptxt.parse()
 		return gptxt, err
-	case "GPDBT":
+	case "GPDBT", "SDDBT", "IIDBT", "INDBT":
 		gpdbt := NewGPDBT(*m)
 		err = gpdbt.parse()
 		return gpdbt, err
+	case "GPGNS", "GNGNS":
+		gpgns := NewGPGNS(*m)
+		err = gpgns.parse()
+		return gpgns, err
+	case "GPGST", "GNGST":
+		gpgst := NewGPGST(*m)
+		err = gpgst.parse()
+		return gpgst, err
+	case "GPGBS", "GNGBS":
+		gpgbs := NewGPGBS(*m)
+		err = gpgbs.parse()
+		return gpgbs, err
+	case "GPGRS", "GNGRS":
+		gpgrs := NewGPGRS(*m)
+		err = gpgrs.parse()
+		return gpgrs, err
+	case "GPDTM", "GNDTM":
+		gpdtm := NewGPDTM(*m)
+		err = gpdtm.parse()
+		return gpdtm, err
+	case "GPHDT", "HEHDT", "INHDT":
+		gphdt := NewGPHDT(*m)
+		err = gphdt.parse()
+		return gphdt, err
+	case "GPHDM", "HCHDM", "IIHDM":
+		gphdm := NewGPHDM(*m)
+		err = gphdm.parse()
+		return gphdm, err
+	case "GPHDG", "HCHDG", "IIHDG":
+		gphdg := NewGPHDG(*m)
+		err = gphdg.parse()
+		return gphdg, err
+	case "GPTHS", "INTHS", "HETHS":
+		gpths := NewGPTHS(*m)
+		err = gpths.parse()
+		return gpths, err
+	case "GPROT", "HEROT", "TIROT":
+		gprot := NewGPROT(*m)
+		err = gprot.parse()
+		return gprot, err
+	case "GPRSA", "IIRSA", "AGRSA":
+		gprsa := NewGPRSA(*m)
+		err = gprsa.parse()
+		return gprsa, err
+	case "GPVHW", "VWVHW", "IIVHW":
+		gpvhw := NewGPVHW(*m)
+		err = gpvhw.parse()
+		return gpvhw, err
+	case "GPVLW", "IIVLW", "VWVLW":
+		gpvlw := NewGPVLW(*m)
+		err = gpvlw.parse()
+		return gpvlw, err
+	case "GPVBW", "IIVBW", "VWVBW":
+		gpvbw := NewGPVBW(*m)
+		err = gpvbw.parse()
+		return gpvbw, err
+	case "GPVDR", "IIVDR":
+		gpvdr := NewGPVDR(*m)
+		err = gpvdr.parse()
+		return gpvdr, err
+	case "GPXTE", "IIXTE", "INXTE":
+		gpxte := NewGPXTE(*m)
+		err = gpxte.parse()
+		return gpxte, err
+	case "GPRMB", "IIRMB", "INRMB":
+		gprmb := NewGPRMB(*m)
+		err = gprmb.parse()
+		return gprmb, err
+	case "GPRMA", "LCRMA":
+		gprma := NewGPRMA(*m)
+		err = gprma.parse()
+		return gprma, err
+	case "GPAPB", "IIAPB", "INAPB":
+		gpapb := NewGPAPB(*m)
+		err = gpapb.parse()
+		return gpapb, err
+	case "GPAAM", "IIAAM", "INAAM":
+		gpaam := NewGPAAM(*m)
+		err = gpaam.parse()
+		return gpaam, err
+	case "GPBOD", "IIBOD", "INBOD":
+		gpbod := NewGPBOD(*m)
+		err = gpbod.parse()
+		return gpbod, err
+	case "GPBWC", "IIBWC", "INBWC":
+		gpbwc := NewGPBWC(*m)
+		err = gpbwc.parse()
+		return gpbwc, err
+	case "GPBWR", "IIBWR", "INBWR":
+		gpbwr := NewGPBWR(*m)
+		err = gpbwr.parse()
+		return gpbwr, err
+	case "GPBWW", "IIBWW", "INBWW":
+		gpbww := NewGPBWW(*m)
+		err = gpbww.parse()
+		return gpbww, err
+	case "GPWCV", "IIWCV", "INWCV":
+		gpwcv := NewGPWCV(*m)
+		err = gpwcv.parse()
+		return gpwcv, err
+	case "GPWNC", "IIWNC", "INWNC":
+		gpwnc := NewGPWNC(*m)
+		err = gpwnc.parse()
+		return gpwnc, err
+	case "GPWPL", "IIWPL", "INWPL", "ECWPL":
+		gpwpl := NewGPWPL(*m)
+		err = gpwpl.parse()
+		return gpwpl, err
+	case "GPRTE", "IIRTE", "INRTE", "ECRTE":
+		gprte := NewGPRTE(*m)
+		err = gprte.parse()
+		return gprte, err
+	case "GPGLC", "LCGLC":
+		gpglc := NewGPGLC(*m)
+		err = gpglc.parse()
+		return gpglc, err
+	case "GPGTD", "LCGTD":
+		gpgtd := NewGPGTD(*m)
+		err = gpgtd.parse()
+		return gpgtd, err
+	case "GPXDR", "IIXDR", "WIXDR":
+		gpxdr := NewGPXDR(*m)
+		err = gpxdr.parse()
+		return gpxdr, err
+	case "GPMWV", "IIMWV", "WIMWV":
+		gpmwv := NewGPMWV(*m)
+		err = gpmwv.parse()
+		return gpmwv, err
+	case "GPMWD", "IIMWD", "WIMWD":
+		gpmwd := NewGPMWD(*m)
+		err = gpmwd.parse()
+		return gpmwd, err
+	case "GPMDA", "IIMDA", "WIMDA":
+		gpmda := NewGPMDA(*m)
+		err = gpmda.parse()
+		return gpmda, err
+	case "GPMTW", "IIMTW":
+		gpmtw := NewGPMTW(*m)
+		err = gpmtw.parse()
+		return gpmtw, err
+	case "GPMTA", "IIMTA", "WIMTA":
+		gpmta := NewGPMTA(*m)
+		err = gpmta.parse()
+		return gpmta, err
+	case "GPMMB", "IIMMB", "WIMMB":
+		gpmmb := NewGPMMB(*m)
+		err = gpmmb.parse()
+		return gpmmb, err
+	case "GPMHU", "IIMHU", "WIMHU":
+		gpmhu := NewGPMHU(*m)
+		err = gpmhu.parse()
+		return gpmhu, err
+	case "GPVWR", "IIVWR", "WIVWR":
+		gpvwr := NewGPVWR(*m)
+		err = gpvwr.parse()
+		return gpvwr, err
+	case "GPVWT", "IIVWT", "WIVWT":
+		gpvwt := NewGPVWT(*m)
+		err = gpvwt.parse()
+		return gpvwt, err
+	case "GPDPT", "SDDPT", "IIDPT":
+		gpdpt := NewGPDPT(*m)
+		err = gpdpt.parse()
+		return gpdpt, err
+	case "GPDBS", "SDDBS", "IIDBS":
+		gpdbs := NewGPDBS(*m)
+		err = gpdbs.parse()
+		return gpdbs, err
+	case "GPDBK", "SDDBK", "IIDBK":
+		gpdbk := NewGPDBK(*m)
+		err = gpdbk.parse()
+		return gpdbk, err
+	case "GPOSD", "RAOSD", "INOSD":
+		gposd := NewGPOSD(*m)
+		err = gposd.parse()
+		return gposd, err
+	case "GPRSD", "RARSD":
+		gprsd := NewGPRSD(*m)
+		err = gprsd.parse()
+		return gprsd, err
+	case "GPTTM", "RATTM":
+		gpttm := NewGPTTM(*m)
+		err = gpttm.parse()
+		return gpttm, err
+	case "GPTLL", "RATLL":
+		gptll := NewGPTLL(*m)
+		err = gptll.parse()
+		return gptll, err
+	case "GPALM":
+		gpalm := NewGPALM(*m)
+		err = gpalm.parse()
+		return gpalm, err
+	case "GPMSS":
+		gpmss := NewGPMSS(*m)
+		err = gpmss.parse()
+		return gpmss, err
+	case "GPZTG":
+		gpztg := NewGPZTG(*m)
+		err = gpztg.parse()
+		return gpztg, err
+	case "GPZFO":
+		gpzfo := NewGPZFO(*m)
+		err = gpzfo.parse()
+		return gpzfo, err
+	case "GPZDL":
+		gpzdl := NewGPZDL(*m)
+		err = gpzdl.parse()
+		return gpzdl, err
+	case "GPFSI", "CTFSI":
+		gpfsi := NewGPFSI(*m)
+		err = gpfsi.parse()
+		return gpfsi, err
+	case "GPSFI", "CTSFI":
+		gpsfi := NewGPSFI(*m)
+		err = gpsfi.parse()
+		return gpsfi, err
+	case "GPHSC", "INHSC":
+		gphsc := NewGPHSC(*m)
+		err = gphsc.parse()
+		return gphsc, err
+	case "GPHTC", "AGHTC", "INHTC":
+		gphtc := NewGPHTC(*m)
+		err = gphtc.parse()
+		return gphtc, err
+	case "GPHTD", "AGHTD":
+		gphtd := NewGPHTD(*m)
+		err = gphtd.parse()
+		return gphtd, err
+	case "GPALR", "IIALR":
+		gpalr := NewGPALR(*m)
+		err = gpalr.parse()
+		return gpalr, err
+	case "GPACK", "IIACK":
+		gpack := NewGPACK(*m)
+		err = gpack.parse()
+		return gpack, err
+	case "GPALF", "IIALF":
+		gpalf := NewGPALF(*m)
+		err = gpalf.parse()
+		return gpalf, err
+	case "GPDSC", "CDDSC":
+		gpdsc := NewGPDSC(*m)
+		err = gpdsc.parse()
+		return gpdsc, err
+	case "GPDSE", "CDDSE":
+		gpdse := NewGPDSE(*m)
+		err = gpdse.parse()
+		return gpdse, err
+	case "GPMOB", "IIMOB":
+		gpmob := NewGPMOB(*m)
+		err = gpmob.parse()
+		return gpmob, err
+	case "GPTRF", "TRTRF":
+		gptrf := NewGPTRF(*m)
+		err = gptrf.parse()
+		return gptrf, err
+	case "GPSTN", "IISTN":
+		gpstn := NewGPSTN(*m)
+		err = gpstn.parse()
+		return gpstn, err
+	case "GPHBT", "IIHBT":
+		gphbt := NewGPHBT(*m)
+		err = gphbt.parse()
+		return gphbt, err
+	case "GPNAK", "IINAK":
+		gpnak := NewGPNAK(*m)
+		err = gpnak.parse()
+		return gpnak, err
+	case "GPAPA", "IIAPA":
+		gpapa := NewGPAPA(*m)
+		err = gpapa.parse()
+		return gpapa, err
+	case "GPRLM", "GARLM", "GNRLM":
+		gprlm := NewGPRLM(*m)
+		err = gprlm.parse()
+		return gprlm, err
+	case "PMTK001":
+		pmtk001 := NewPMTK001(*m)
+		err = pmtk001.parse()
+		return pmtk001, err
+	case "PMTK010":
+		pmtk010 := NewPMTK010(*m)
+		err = pmtk010.parse()
+		return pmtk010, err
+	case "PMTK011":
+		pmtk011 := NewPMTK011(*m)
+		err = pmtk011.parse()
+		return pmtk011, err
+	case "PMTK705":
+		pmtk705 := NewPMTK705(*m)
+		err = pmtk705.parse()
+		return pmtk705, err
+	case "PMTK707":
+		pmtk707 := NewPMTK707(*m)
+		err = pmtk707.parse()
+		return pmtk707, err
+	case "PUBX,00":
+		pubx00 := NewPUBX00(*m)
+		err = pubx00.parse()
+		return pubx00, err
+	case "PUBX,03":
+		pubx03 := NewPUBX03(*m)
+		err = pubx03.parse()
+		return pubx03, err
+	case "PUBX,04":
+		pubx04 := NewPUBX04(*m)
+		err = pubx04.parse()
+		return pubx04, err
+	case "PUBX,40":
+		pubx40 := NewPUBX40(*m)
+		err = pubx40.parse()
+		return pubx40, err
+	case "PUBX,41":
+		pubx41 := NewPUBX41(*m)
+		err = pubx41.parse()
+		return pubx41, err
+	case "PSRF100":
+		psrf100 := NewPSRF100(*m)
+		err = psrf100.parse()
+		return psrf100, err
+	case "PSRF103":
+		psrf103 := NewPSRF103(*m)
+		err = psrf103.parse()
+		return psrf103, err
+	case "PSRF104":
+		psrf104 := NewPSRF104(*m)
+		err = psrf104.parse()
+		return psrf104, err
+	case "PGRME":
+		pgrme := NewPGRME(*m)
+		err = pgrme.parse()
+		return pgrme, err
+	case "PGRMM":
+		pgrmm := NewPGRMM(*m)
+		err = pgrmm.parse()
+		return pgrmm, err
+	case "PGRMZ":
+		pgrmz := NewPGRMZ(*m)
+		err = pgrmz.parse()
+		return pgrmz, err
+	case "PASHR":
+		pashr := NewPASHR(*m)
+		err = pashr.parse()
+		return pashr, err
+	case "PTNL,GGK":
+		ptnlggk := NewPTNLGGK(*m)
+		err = ptnlggk.parse()
+		return ptnlggk, err
+	case "PRDID":
+		prdid := NewPRDID(*m)
+		err = prdid.parse()
+		return prdid, err
+	case "PHTRO":
+		phtro := NewPHTRO(*m)
+		err = phtro.parse()
+		return phtro, err
+	case "PSXN,20":
+		psxn20 := NewPSXN20(*m)
+		err = psxn20.parse()
+		return psxn20, err
+	case "PSXN,23":
+		psxn23 := NewPSXN23(*m)
+		err = psxn23.parse()
+		return psxn23, err
+	case "PFEC,GPatt":
+		gpatt := NewPFECGPatt(*m)
+		err = gpatt.parse()
+		return gpatt, err
+	case "PFEC,GPhve":
+		gphve := NewPFECGPhve(*m)
+		err = gphve.parse()
+		return gphve, err
+	case "PFEC,pidat":
+		pidat := NewPFECPidat(*m)
+		err = pidat.parse()
+		return pidat, err
+	case "GPZDA", "GNZDA", "GLZDA", "GAZDA":
+		gpzda := NewGPZDA(*m)
+		err = gpzda.parse()
+		return gpzda, err
 	}
 
 	return m, err
@@ -13,11 +13,168 @@ func TestNMEAMessage(t *testing.T) {
 		"$GPGSV,3,1,12,01,05,060,18,02,17,259,43,04,56,287,28,09,08,277,28*77",
 		"$GPGSV,3,2,12,10,34,195,46,13,08,125,45,17,67,014,,20,32,048,24*74",
 		"$GPGSV,3,3,12,23,13,094,48,24,04,292,24,28,49,178,46,32,06,037,22*7D",
+		"$GLGSV,1,1,02,65,32,109,33,66,42,040,31,1*70",
+		"$GAGSV,1,1,01,05,45,120,39,7*4F",
 		"$GPGLL,3110.2908,N,12123.2348,E,041139.000,A,A*59",
 		"$GPTXT,01,01,02,ANTSTATUS=OK*3B",
 		"$GPDBT,108.34,f,33.02,M,18.06,F*35",
-		//"$GPDBT,,,000033.0,M,,*16",
-		//"$INDBT,,,000014.5,M,,*06",
+		"$GNGNS,014035.00,4332.69262,S,17235.48549,E,RR,13,0.9,25.63,11.24,,*70",
+		"$GNGNS,112257.00,3844.24011,N,00908.43828,W,AN,03,10.5,,,,,V*33",
+		"$GPGST,172814.0,0.006,0.023,0.020,273.6,0.023,0.020,0.031*6A",
+		"$GNGST,082356.00,0.810,,,,0.734,0.512,1.273*65",
+		"$GPGBS,015509.00,-0.031,-0.186,0.219,19,0.000,-0.354,6.972*4D",
+		"$GNGBS,170556.00,3.023,2.296,4.533,,,,,1,1*53",
+		"$GPGRS,220320.0,0,-0.8,-0.2,-0.1,-0.2,0.8,0.6,,,,,,*79",
+		"$GNGRS,170556.00,1,0.5,-1.2,0.3,2.1,,,,,,,,,1,1*7B",
+		"$GPDTM,999,,0.08,N,0.07,E,-47.7,W84*1B",
+		"$GPDTM,W84,,0.00,N,0.00,E,0.0,W84*6F",
+		"$GNDTM,P90,,0.02,S,0.11,W,1.2,W84*7D",
+		"$HEHDT,123.4,T*2B",
+		"$GPHDT,274.1,T*35",
+		"$HCHDM,238.5,M*25",
+		"$IIHDM,41.0,M*17",
+		"$HCHDG,98.3,0.0,E,12.6,W*57",
+		"$HCHDG,101.1,,,7.1,W*3C",
+		"$IIHDG,270.4,1.5,W,2.0,E*5C",
+		"$GPTHS,338.01,A*0E",
+		"$INTHS,77.25,V*37",
+		"$HETHS,0.00,S*0F",
+		"$HEROT,-11.3,A*35",
+		"$GPROT,35.6,A*01",
+		"$TIROT,0.0,V*2C",
+		"$IIRSA,10.5,A,,V*4D",
+		"$AGRSA,-3.2,A,-3.0,A*44",
+		"$VWVHW,,T,,M,3.20,N,5.93,K*5A",
+		"$IIVHW,245.1,T,245.1,M,5.10,N,9.45,K*59",
+		"$VWVHW,12.5,T,14.0,M,0.00,N,0.00,K*57",
+		"$IIVLW,10.1,N,3.2,N*7C",
+		"$VWVLW,2962.8,N,1.3,N,3150.2,N,1.5,N*48",
+		"$IIVBW,4.5,-0.2,A,4.8,0.1,A*60",
+		"$VWVBW,2.1,0.3,A,2.0,-0.4,A,0.2,A,-0.1,V*50",
+		"$IIVDR,10.1,T,12.3,M,1.2,N*3A",
+		"$GPVDR,,T,,M,0.5,N*2B",
+		"$GPXTE,A,A,0.67,L,N*6F",
+		"$GPXTE,A,A,0.12,R,N,A*1E",
+		"$GPRMB,A,0.66,L,003,004,4917.24,N,12309.57,W,001.3,052.5,000.5,V*20",
+		"$GPRMB,A,4.08,L,EGLL,EGLM,5130.02,N,12046.34,W,004.6,213.9,122.9,A,A*53",
+		"$LCRMA,A,4917.24,N,12309.57,W,15920.5,26745.7,5.5,52.5,13.5,E*72",
+		"$LCRMA,A,5130.02,N,12046.34,W,14162.8,42011.3,0.0,0.0,,,A*76",
+		"$GPAPB,A,A,0.10,R,N,V,V,011.0,M,DEST,011.0,M,011.0,M*22",
+		"$GPAPB,A,A,0.00,L,N,A,V,213.9,T,EGLM,214.2,T,214.2,T,A*52",
+		"$GPAPB,V,V,0.00,L,N,V,V,,,,,,,*58",
+		"$GPAAM,A,A,0.10,N,WPTNME*32",
+		"$GPAAM,V,V,0.50,N,EGLM*20",
+		"$GPBOD,099.3,T,105.6,M,POINTB,*48",
+		"$GPBOD,097.0,T,103.2,M,POINTB,POINTA*4A",
+		"$GPBWC,081837,,,,,,T,,M,,N,*13",
+		"$GPBWC,220516,5130.02,N,12046.34,W,213.8,T,218.0,M,4.6,N,EGLM,A*7F",
+		"$GPBWR,081837,,,,,,T,,M,,N,*02",
+		"$GPBWR,220516,5130.02,N,12046.34,W,213.8,T,218.0,M,4.6,N,EGLM,A*6E",
+		"$GPBWW,097.0,T,103.2,M,POINTB,POINTA*41",
+		"$GPBWW,,T,,M,WPT2,WPT1*4F",
+		"$GPWCV,2.1,N,POINTB*14",
+		"$GPWCV,0.5,N,EGLM,A*72",
+		"$GPWNC,200.0,N,370.4,K,POINTB,POINTA*49",
+		"$GPWNC,,N,,K,WPT2,WPT1*4B",
+		"$GPWPL,4917.16,N,12310.64,W,003*65",
+		"$IIWPL,5130.02,N,12046.34,W,EGLM*4E",
+		"$GPRTE,2,1,c,0,PBRCPK,PBRTO,PTELGR,PPLAND,PYAMBU,PPFAIR,PWARRN,PMORTL,PLISMR*73",
+		"$IIRTE,1,1,w,EGLL,EGLM,EGLN*35",
+		"$LCGLC,9960,15920.5,A,26745.7,A,42011.3,B,,,,,,*0B",
+		"$LCGLC,7980,14162.8,A,42011.3,C,58993.1,S,12345.6,A,23456.7,A,34567.8,A*72",
+		"$LCGTD,15920.5,26745.7,42011.3,,*50",
+		"$LCGTD,14162.8,42011.3,58993.1,12345.6,23456.7*5E",
+		"$WIXDR,C,19.7,C,AIRTEMP,P,1.0175,B,BARO,H,56.2,P,RH*1A",
+		"$IIXDR,A,-2.5,D,PITCH,A,3.1,D,ROLL*3D",
+		"$IIXDR,T,1850,R,ENGINE#0,U,13.8,V,BATT*4D",
+		"$WIMWV,214.8,R,0.1,K,A*28",
+		"$IIMWV,041.0,T,12.4,N,A*09",
+		"$WIMWD,186.7,T,190.4,M,9.7,N,5.0,M*55",
+		"$IIMWD,,T,,M,12.4,N,6.4,M*71",
+		"$WIMDA,30.2269,I,1.0236,B,17.7,C,,C,42.3,,5.0,C,223.7,T,226.1,M,5.8,N,3.0,M*1C",
+		"$WIMDA,,I,,B,,C,14.2,C,,,,C,,T,,M,,N,,M*1D",
+		"$IIMTW,17.8,C*1D",
+		"$GPMTW,-1.5,C*1D",
+		"$WIMTA,21.3,C*1B",
+		"$IIMTA,-3.2,C*19",
+		"$WIMMB,30.2269,I,1.0236,B*6D",
+		"$IIMMB,29.9213,I,1.0132,B*7A",
+		"$WIMHU,42.3,,5.0,C*3D",
+		"$IIMHU,87.5,12.1,15.6,C*07",
+		"$IIVWR,148.0,L,10.4,N,5.4,M,19.3,K*55",
+		"$WIVWR,032.5,R,7.1,N,3.7,M,13.1,K*62",
+		"$IIVWR,,,10.0,N,5.1,M,18.5,K*3C",
+		"$IIVWR,090.0,L,,N,,M,,K*70",
+		"$IIVWT,030.0,R,10.1,N,5.2,M,18.7,K*45",
+		"$WIVWT,115.2,L,6.4,N,3.3,M,11.9,K*73",
+		"$SDDPT,12.6,-1.2*4C",
+		"$IIDPT,4.1,0.5,100.0*43",
+		"$SDDBS,44.2,f,13.5,M,7.4,F*07",
+		"$SDDBK,37.6,f,11.5,M,6.3,F*1B",
+		"$RAOSD,35.1,A,36.0,P,10.2,P,15.3,0.1,N*41",
+		"$INOSD,182.4,V,180.0,W,6.5,B,,,K*50",
+		"$RARSD,0.00,0.0,2.10,118.0,,,,,3.47,41.6,6.00,N,N*6A",
+		"$RARSD,0.50,45.0,1.20,90.0,1.00,180.0,3.00,270.0,,,12.00,K,H*54",
+		"$RATTM,11,11.40,13.6,T,7.0,20.0,T,0.20,-1.3,N,TGT11,T,,100021.00,A*5A",
+		"$RATTM,02,1.25,245.0,R,12.5,90.0,T,0.50,4.2,K,,Q,R*37",
+		"$RATLL,01,4917.24,N,12309.57,W,TGT01,100021.00,T,*7B",
+		"$GPTLL,02,5130.02,N,12046.34,W,MOB,083015,Q,R*0B",
+		"$GPALM,1,1,15,1159,00,441d,4e,16be,fd5e,a10c9f,4a2da4,686e81,58cbe1,0a4,001*77",
+		"$GPALM,31,1,01,1159,00,4c1a,4e,0a3a,fd2d,a10d4e,2ec9f8,a49c4d,5d9ace,ffb,000*40",
+		"$GPMSS,55,27,318.0,100,1*57",
+		"$GPMSS,48,15,307.5,200*4F",
+		"$GPZTG,145832.12,042359.17,WPT*24",
+		"$GPZTG,093015.00,000512.50,HOME*70",
+		"$GPZFO,145832.12,042359.17,WPT*3E",
+		"$GPZFO,093015.00,120512.50,HOME*69",
+		"$GPZDL,002345.50,1.25,W*0D",
+		"$GPZDL,010000.00,12.30,C*2B",
+		"$CTFSI,020230,026140,m,0*14",
+		"$CTFSI,021820,021820,d,5,R*64",
+		"$GPFSI,,021750,|,9,C*60",
+		"$CTSFI,2,1,021820,d,041250,m,062310,q,083940,s,122340,t,164380,w*45",
+		"$CTSFI,2,2,218230,|*3D",
+		"$GPHSC,128.0,T,133.5,M*59",
+		"$INHSC,045.2,T,,M,C*04",
+		"$AGHTC,V,10.0,L,H,N,30.0,15.0,0.50,60.0,128.5,0.10,130.0,T*35",
+		"$INHTC,A,,,T,R,35.0,10.0,1.20,,045.0,0.25,047.5,M,C*15",
+		"$AGHTD,V,10.0,L,H,N,30.0,15.0,0.50,60.0,128.5,0.10,130.0,T,A,A,A,127.3*5A",
+		"$AGHTD,A,5.0,R,T,T,35.0,10.0,,20.0,045.0,0.25,047.5,M,V,A,V,052.1*6B",
+		"$IIALR,142507.00,031,A,V,DEPTH ALARM*43",
+		"$GPALR,,005,V,A,ANCHOR WATCH*36",
+		"$IIACK,031*57",
+		"$GPACK,005*47",
+		"$IIALF,1,1,0,124304.50,A,W,A,,192,1,1,0,LOST TARGET*14",
+		"$IIALF,2,1,3,100110.00,B,A,V,,3008,1,2,0,HEADING LOST*79",
+		"$IIALF,2,2,3,,,,,,,,,,NO HDG REFERENCE*55",
+		"$IIALF,1,1,,081215.00,A,C,N,SAM,10001,,3,1,GYRO RATE*46",
+		"$CDDSC,12,3380400790,12,06,00,1423108312,2019,,,S,E*6A",
+		"$CDDSC,20,2320001230,00,21,26,0000000016,,,,R,*2C",
+		"$CDDSC,16,0000000000,12,12,00,0000000000,,2351234560,06,B,*3F",
+		"$CDDSE,1,1,A,3380400790,00,45894494*1B",
+		"$CDDSE,2,1,R,2320001230,00,12345678,01,0001*0F",
+		"$CDDSE,2,2,R,2320001230,06,0012*01",
+		"$IIMOB,12A3F,A,135407.60,0,131218,135608.13,5106.25,N,12149.76,E,231.7,1.2,538007213,0*3A",
+		"$IIMOB,12A3F,V,,,,,,,,,,,,*21",
+		"$TRTRF,162015.00,040589,4536.28,N,12327.92,W,38.5,4,27,1.35,120,A*0A",
+		"$TRTRF,051210.00,120390,3752.15,S,14512.05,E,12.0,7,19,0.42,130,V*1F",
+		"$IISTN,01*64",
+		"$GPSTN,12*71",
+		"$IIHBT,30.0,A,3*1D",
+		"$GPHBT,5.0,V,0*28",
+		"$IINAK,GP,GGA,,1,Formatter not supported*54",
+		"$GPNAK,AG,HTC,01,6,Bad checksum*7B",
+		"$GPNAK,II,HBT,,49,*2C",
+		"$GPAPA,A,A,0.10,R,N,V,V,011,M,DEST*3F",
+		"$GPAPA,V,V,0.00,L,N,V,V,,,DEST*5D",
+		"$IIAPA,A,A,0.00,L,N,A,V,214,T,EGLM*3B",
+		"$GARLM,9C7A4D2F3B00001,101523.00,1,2A40*71",
+		"$GNRLM,2DD42A7F28C0001,063005.50,3,0C1F00A8E5*03",
+		"$GPZDA,160012.71,11,03,2004,-1,00*7D",
+		"$GPZDA,201530.00,04,07,2002,00,00*60",
+		"$GNZDA,082710.00,16,09,2002,00,00*7A",
+		"$GPDBT,,,000033.0,M,,*16",
+		"$INDBT,,,000014.5,M,,*06",
 
 		// NMEA packet when no satelite receive
 		"$GPGLL,,,,,000107.799,V,N*7B",
@@ -37,6 +194,9 @@ func TestNMEAMessage(t *testing.T) {
 		"$PMTK010,001*2E",
 		"$PMTK011,MTKGPS*08",
 		"$PMTK001,869,3*37",
+		"$PMTK001,314,3*36",
+		"$PMTK010,002*2D",
+		"$PMTK220,1000*1F",
 		"$PMTK101*32",
 		"$PMTK102*31",
 		"$PMTK103*30",
@@ -65,8 +225,63 @@ func TestNMEAMessage(t *testing.T) {
 		"$PMTK501,1*2B",
 		"$PMTK513,1*28",
 		"$PMTK514,1,1,1,1,1,5,1,1,1,1,1,1,0,1,1,1,1,1,1*2A",
-		// "$PMTK705,AXN_3.10_3333_12102201,0000,QUECTEL-L80,*18",
+		"$PMTK705,AXN_3.10_3333_12102201,0000,QUECTEL-L80,*11",
+		"$PMTK705,AXN_2.31_3339_13101700,5632,PA6H,1.0*6B",
+		"$PMTK707,56,1468,172800,1470,151200,1468,237600,1468,259200*13",
+		"$PMTK707,0,0,0,0,0,0,0,0,0*2E",
 		"$PMTK869,1,1*35",
+
+		// u-blox proprietary sentences
+		"$PUBX,00,081350.00,4717.11321,N,11833.915187,E,546.589,G3,2.1,2.0,0.007,77.52,0.007,,0.92,1.19,0.77,9,0,0*6F",
+		"$PUBX,00,221103.00,3852.55427,S,17745.23351,E,34.318,D3,1.3,2.6,1.854,213.45,-0.023,4,0.81,1.35,0.94,12,0,0*6C",
+		"$PUBX,03,11,23,-,,,45,010,29,-,,,46,013,07,-,,,42,015,08,U,067,31,42,025,10,U,195,33,46,026,18,U,326,08,39,026,17,-,,,32,015,26,U,306,66,48,025,27,U,073,10,36,026,28,U,089,61,46,024,15,-,,,39,014*0D",
+		"$PUBX,03,3,05,U,241,62,47,064,13,e,092,14,,000,120,-,198,32,38,031*02",
+		"$PUBX,04,073731.00,091202,113851.00,1196,15D,1930035,-2660.664,43,*5D",
+		"$PUBX,04,221103.00,150324,511863.00,2305,18,-1542,28.417,21,*26",
+		"$PUBX,40,GLL,1,0,0,0,0,0*5D",
+		"$PUBX,40,GSV,0,5,0,0,0,0*5C",
+		"$PUBX,41,1,0007,0003,19200,0*25",
+		"$PUBX,41,3,0003,0002,115200,1*1E",
+
+		// Trimble proprietary sentences
+		"$PTNL,GGK,102939.00,051910,5000.97323841,N,00827.62010742,E,5,09,1.9,EHT150.790,M*73",
+		"$PTNL,GGK,172814.00,071296,3723.46587704,N,12202.26957864,W,3,06,1.7,EHT-6.777,M*4B",
+		"$PTNL,GGK,102939.00,051910,0000.00000000,N,00827.62010742,E,5,09,1.9,EHT150.790,M*77",
+		"$PTNL,GGK,102939.00,,,,,,0,00,,EHT0.000,M*69",
+
+		// SiRF proprietary sentences
+		"$PSRF100,1,9600,8,1,0*0D",
+		"$PSRF100,0,38400,8,1,0*3C",
+		"$PSRF103,00,01,00,01*25",
+		"$PSRF103,05,00,01,01*20",
+		"$PSRF104,37.3875111,-121.97232,0,96000,237759,1946,12,1*06",
+		"$PSRF104,47.6234,-122.3521,12,0,0,0,12,8*33",
+
+		// Garmin proprietary sentences
+		"$PGRME,15.0,M,45.0,M,25.0,M*1C",
+		"$PGRME,3.3,M,4.9,M,6.0,M*25",
+		"$PGRMM,WGS 84*06",
+		"$PGRMM,NAD27 Canada*2F",
+		"$PGRMZ,246,f,3*1B",
+		"$PGRMZ,1523.5,f,*06",
+
+		// Attitude proprietary sentences
+		"$PASHR,085335.000,224.19,T,-01.26,+00.83,+00.00,0.101,0.113,0.267,1,0*06",
+		"$PASHR,201503.250,012.51,T,+02.37,-01.14,-00.21,0.033,0.033,0.054,2,1*0D",
+		"$PRDID,-1.31,7.81,047.31*58",
+		"$PRDID,2.45,-0.63,312.08*5A",
+		"$PHTRO,1.52,M,2.31,T*4E",
+		"$PHTRO,0.84,P,3.07,B*4B",
+		"$PSXN,20,0,0,0,0*3B",
+		"$PSXN,20,1,0,0,2*38",
+		"$PSXN,23,0.30,-0.97,298.57,0.13*1B",
+		"$PSXN,23,-1.12,0.45,12.80,-0.05*05",
+		"$PFEC,GPatt,021.3,+01.5,-00.8*42",
+		"$PFEC,GPatt,315.6,-02.4,+03.1*4B",
+		"$PFEC,GPhve,-0.125,A*14",
+		"$PFEC,GPhve,0.042,V*2E",
+		"$PFEC,pidat,0,SC-50*5C",
+		"$PFEC,pidat,1,0252383-02.01*58",
 	}
 
 	for _, raw := range nmeas {
@@ -112,3 +327,122 @@ func TestNMEAMessage(t *testing.T) {
 		}
 	*/
 }
+
+func TestSerializePrecision(t *testing.T) {
+	// Each data field is serialized back with its own number of decimals
+	for _, raw := range []string{
+		"$GPVTG,54.7,T,,M,5.50,N,10.186,K,A*35",
+		"$GPVTG,270,T,,M,12.0,N,22.22,K,D*20",
+		"$GPDBT,36.1,f,11.02,M,6.02,F*04",
+		"$GPGLL,4916.45,N,12311.12,E,225444.000,A,A*50",
+	} {
+		msg, err := Parse(raw)
+		if err != nil {
+			t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+		}
+
+		if msg.Serialize() != raw {
+			t.Fatalf("Unable to serialize \"%s\" (got: \"%s\")", raw, msg.Serialize())
+		}
+	}
+
+	// Message crafted from scratch uses default precision
+	vtg := GPVTG{COG: 54.7, SpeedKnots: 5.5, SpeedKmh: 10.186, PositioningMode: AutonomousGNSSFix}
+	if s := vtg.Serialize(); s != "$GPVTG,54.7,T,,M,5.5,N,10.2,K,A*08" {
+		t.Fatalf("Wrong VTG serialization (got: \"%s\")", s)
+	}
+}
+
+func TestSerializeEquatorPosition(t *testing.T) {
+	// Coordinates at 0 keep fixed-width degrees and minutes
+	wpl := GPWPL{Position: Position{Latitude: 0, Longitude: -3.5}, Name: "003"}
+	if s := wpl.Serialize(); s != "$GPWPL,0000.00,N,00330.00,W,003*6A" {
+		t.Fatalf("Wrong WPL serialization (got: \"%s\")", s)
+	}
+	msg, err := Parse(wpl.Serialize())
+	if err != nil {
+		t.Fatalf("Unable to parse serialized WPL, err: %s", err.Error())
+	}
+	if m := msg.(*GPWPL); m.Position != wpl.Position {
+		t.Fatalf("Wrong WPL position (got: %+v)", m.Position)
+	}
+}
+
+func TestSFIAssembler(t *testing.T) {
+	nmeas := []string{
+		"$CTSFI,2,2,218230,|*3D",
+		"$CTSFI,2,1,021820,d,041250,m,062310,q,083940,s,122340,t,164380,w*45",
+		"$CTSFI,2,2,218230,|*3D",
+	}
+
+	a := NewSFIAssembler()
+	var frequencies []ScanningFrequency
+	for i, raw := range nmeas {
+		msg, err := Parse(raw)
+		if err != nil {
+			t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+		}
+		f, err := a.Add(msg)
+		if i == 0 {
+			// Group doesn't start with first message
+			if err == nil {
+				t.Fatal("Out of sequence SFI message should fail")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if f != nil {
+			frequencies = f
+		}
+	}
+
+	if len(frequencies) != 7 {
+		t.Fatalf("Wrong number of assembled frequencies (got: %d)", len(frequencies))
+	}
+
+	if last := frequencies[6]; last.Frequency != 21823 || last.Mode != Facsimile {
+		t.Fatalf("Wrong last frequency (got: %+v)", last)
+	}
+}
+
+func TestGRSResiduals(t *testing.T) {
+	parse := func(raw string) NMEA {
+		msg, err := Parse(raw)
+		if err != nil {
+			t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+		}
+		return msg
+	}
+	gsa := parse("$GPGSA,A,3,14,06,16,31,23,,,,,,,,1.66,1.42,0.84*0F").(*GPGSA)
+
+	// GPS residuals (system ID 1) mapped to GPS satellites
+	grs := parse("$GNGRS,170556.00,1,0.5,-1.2,0.3,2.1,,,,,,,,,1,1*7B").(*GPGRS)
+	residuals := grs.MapToSatellites(gsa)
+	if len(residuals) != 4 || residuals[14] != 0.5 || residuals[6] != -1.2 || residuals[31] != 2.1 {
+		t.Fatalf("Wrong residuals by satellite (got: %v)", residuals)
+	}
+
+	// GLONASS residuals (system ID 2) don't match GPS satellites
+	grs = parse("$GNGRS,170556.00,1,0.5,-1.2,0.3,2.1,,,,,,,,,2,1*78").(*GPGRS)
+	if residuals = grs.MapToSatellites(gsa); residuals != nil {
+		t.Fatalf("Residuals of another system shouldn't be mapped (got: %v)", residuals)
+	}
+
+	if residuals = grs.MapToSatellites(nil); residuals != nil {
+		t.Fatalf("Residuals shouldn't be mapped without GSA (got: %v)", residuals)
+	}
+}
+
+func TestNegativeAcknowledgement(t *testing.T) {
+	msg, err := Parse("$GPGLL,3110.2908,N,12123.2348,E,041139.000,A,A*59")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nak := NewNAK(msg.GetMessage().Type, FormatterNotEnabled, "")
+	if wanted := "$GPNAK,GP,GLL,,2,*1D"; nak.Serialize() != wanted {
+		t.Fatalf("Wrong negative acknowledgement (got: %s, wanted: %s)", nak.Serialize(), wanted)
+	}
+}
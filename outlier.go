@@ -0,0 +1,91 @@
+package nmea
+
+import (
+	"fmt"
+	"time"
+)
+
+// OutlierFilter struct rejects fixes implying an impossible speed or acceleration
+// relative to the previous accepted fix
+type OutlierFilter struct {
+	MaxSpeed        float64 // Maximum speed in knots, 0 to disable
+	MaxAcceleration float64 // Maximum acceleration in m/s², 0 to disable
+	MaxRejections   int     // Consecutive rejections before accepting the fix as new reference, 0 to disable
+
+	last       *Fix
+	lastSpeed  float64 // Speed in m/s between the two last accepted fixes
+	rejections int
+}
+
+// Outlier struct describes a rejected fix
+type Outlier struct {
+	Fix          Fix
+	Distance     float64 // Distance in meters from the last accepted fix
+	Speed        float64 // Implied speed in knots
+	Acceleration float64 // Implied acceleration in m/s²
+	Reason       string
+}
+
+// String return Outlier as human string
+func (o Outlier) String() string {
+	return fmt.Sprintf("outlier rejected: %s (distance: %.1fm, speed: %.1fkn, acceleration: %.1fm/s²)", o.Reason, o.Distance, o.Speed, o.Acceleration)
+}
+
+// NewOutlierFilter allocate OutlierFilter struct with maximum speed in knots
+// and maximum acceleration in m/s²
+func NewOutlierFilter(maxSpeed, maxAcceleration float64) *OutlierFilter {
+	return &OutlierFilter{MaxSpeed: maxSpeed, MaxAcceleration: maxAcceleration, MaxRejections: 5}
+}
+
+// Check return nil if the fix is plausible (and accept it as reference),
+// the description of the outlier otherwise. Invalid fixes are ignored
+func (o *OutlierFilter) Check(f Fix) *Outlier {
+	if !f.IsValid {
+		return nil
+	}
+
+	if o.last == nil {
+		o.accept(f, 0)
+		return nil
+	}
+
+	dt := f.Time.Sub(o.last.Time).Seconds()
+	if dt < -12*3600 && isTimeOfDay(f.Time) && isTimeOfDay(o.last.Time) {
+		dt += 24 * 3600 // Date-less fixes across midnight
+	}
+
+	distance := o.last.Position.DistanceTo(f.Position)
+	outlier := Outlier{Fix: f, Distance: distance}
+	if dt > 0 {
+		speed := distance / dt
+		outlier.Speed, outlier.Acceleration = speed/KnotsToMetersPerSecond, (speed-o.lastSpeed)/dt
+	}
+
+	switch {
+	case dt <= 0: // Repeated or older time, the last accepted fix is held
+		outlier.Reason = "time not after the last accepted fix"
+	case o.MaxSpeed > 0 && outlier.Speed > o.MaxSpeed:
+		outlier.Reason = fmt.Sprintf("speed exceeds %.1fkn", o.MaxSpeed)
+	case o.MaxAcceleration > 0 && outlier.Acceleration > o.MaxAcceleration:
+		outlier.Reason = fmt.Sprintf("acceleration exceeds %.1fm/s²", o.MaxAcceleration)
+	default:
+		o.accept(f, outlier.Speed*KnotsToMetersPerSecond)
+		return nil
+	}
+
+	// Too many consecutive rejections: the reference is likely the outlier
+	if o.rejections++; o.MaxRejections > 0 && o.rejections > o.MaxRejections {
+		o.accept(f, 0)
+	}
+
+	return &outlier
+}
+
+func (o *OutlierFilter) accept(f Fix, speed float64) {
+	o.last, o.lastSpeed, o.rejections = &f, speed, 0
+}
+
+// isTimeOfDay return true for a time without date (ie: from GGA or GLL sentences)
+func isTimeOfDay(t time.Time) bool {
+	return t.Year() == 1 && t.YearDay() == 1
+}
@@ -0,0 +1,41 @@
+package nmea
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOutlierFilter(t *testing.T) {
+	o := NewOutlierFilter(30, 0)
+	start := time.Date(2020, time.June, 1, 12, 0, 0, 0, time.UTC)
+	origin := Position{Latitude: 47.5, Longitude: -3.0}
+
+	if outlier := o.Check(Fix{Time: start, Position: origin, IsValid: Valid}); outlier != nil {
+		t.Fatalf("First fix should be accepted (got: %s)", outlier)
+	}
+	if outlier := o.Check(Fix{Time: start.Add(time.Second), Position: origin.Destination(90, 5), IsValid: Valid}); outlier != nil {
+		t.Fatalf("Plausible fix should be accepted (got: %s)", outlier)
+	}
+
+	// Duplicate and older timestamps are rejected, whatever the position
+	for _, ts := range []time.Time{start.Add(time.Second), start} {
+		if outlier := o.Check(Fix{Time: ts, Position: origin.Destination(0, 1000), IsValid: Valid}); outlier == nil {
+			t.Fatalf("Fix at %s should be rejected", ts)
+		}
+	}
+
+	// Large jump: 1km in 1 second
+	outlier := o.Check(Fix{Time: start.Add(2 * time.Second), Position: origin.Destination(0, 1000), IsValid: Valid})
+	if outlier == nil || outlier.Speed < 1900 {
+		t.Fatalf("Jump should be rejected (got: %v)", outlier)
+	}
+
+	// Date-less fixes across midnight
+	o = NewOutlierFilter(30, 0)
+	before := TimeOfDay{Hour: 23, Minute: 59, Second: 59}.On(time.Time{})
+	after := TimeOfDay{Second: 1}.On(time.Time{})
+	o.Check(Fix{Time: before, Position: origin, IsValid: Valid})
+	if outlier := o.Check(Fix{Time: after, Position: origin.Destination(90, 10), IsValid: Valid}); outlier != nil {
+		t.Fatalf("Fix after midnight should be accepted (got: %s)", outlier)
+	}
+}
@@ -0,0 +1,120 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+PASHR Inertial Attitude Data
+       1          2      3 4      5      6      7     8     9     10 11
+       |          |      | |      |      |      |     |     |     |  |
+$PASHR,hhmmss.sss,hhh.hh,T,rrr.rr,ppp.pp,xxx.xx,a.aaa,b.bbb,c.ccc,d,e*hh
+
+1) Time (UTC)
+2) Heading in degrees
+3) T = heading is true (empty otherwise)
+4) Roll in degrees, positive when port side up
+5) Pitch in degrees, positive when bow up
+6) Heave in meters, positive up
+7) Roll accuracy (standard deviation) in degrees
+8) Pitch accuracy (standard deviation) in degrees
+9) Heading accuracy (standard deviation) in degrees
+10) GPS quality flag: 0 = no position, 1 = non-RTK fix, 2 = RTK fixed
+11) IMU status flag: 0 = IMU out, 1 = satisfactory
+12) Checksum
+
+Examples:
+$PASHR,085335.000,224.19,T,-01.26,+00.83,+00.00,0.101,0.113,0.267,1,0*06
+$PASHR,201503.250,012.51,T,+02.37,-01.14,-00.21,0.033,0.033,0.054,2,1*0D
+*/
+
+// NewPASHR allocate PASHR struct for inertial attitude sentence
+func NewPASHR(m Message) *PASHR {
+	return &PASHR{Message: m}
+}
+
+// PASHR struct
+type PASHR struct {
+	Message
+
+	TimeUTC         TimeOfDay // Time UTC data field, without date
+	Heading         float64   // In degree
+	IsTrueHeading   bool
+	Roll            float64  // In degree, positive when port side up
+	Pitch           float64  // In degree, positive when bow up
+	Heave           float64  // In meters, positive up
+	RollAccuracy    *float64 // Standard deviation in degree
+	PitchAccuracy   *float64 // Standard deviation in degree
+	HeadingAccuracy *float64 // Standard deviation in degree
+	GPSQuality      int      // 0 = no position, 1 = non-RTK fix, 2 = RTK fixed
+	IMUStatus       int      // 0 = IMU out, 1 = satisfactory
+}
+
+func (m *PASHR) parse() (err error) {
+	if len(m.Fields) != 11 {
+		return m.Error(fmt.Errorf("Incomplete PASHR message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 11))
+	}
+
+	if m.TimeUTC, err = ParseTimeOfDay(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse time UTC from data field (got: %s)", m.Fields[0]))
+	}
+
+	for i, v := range map[int]*float64{1: &m.Heading, 3: &m.Roll, 4: &m.Pitch, 5: &m.Heave} {
+		if *v, err = strconv.ParseFloat(m.Fields[i], 64); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse data field %d (got: %s)", i+1, m.Fields[i]))
+		}
+	}
+
+	switch m.Fields[2] {
+	case "T", "":
+		m.IsTrueHeading = m.Fields[2] == "T"
+	default:
+		return m.Error(fmt.Errorf("Unable to parse true heading flag from data field (got: %s)", m.Fields[2]))
+	}
+
+	for i, v := range map[int]**float64{6: &m.RollAccuracy, 7: &m.PitchAccuracy, 8: &m.HeadingAccuracy} {
+		if *v, err = parseOptionalFloat(m.Fields[i]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse data field %d (got: %s)", i+1, m.Fields[i]))
+		}
+	}
+
+	for i, v := range map[int]*int{9: &m.GPSQuality, 10: &m.IMUStatus} {
+		if *v, err = strconv.Atoi(m.Fields[i]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse data field %d (got: %s)", i+1, m.Fields[i]))
+		}
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence PASHR as string
+func (m PASHR) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("PASHR")
+	fields := make([]string, 0)
+
+	trueHeading := ""
+	if m.IsTrueHeading {
+		trueHeading = "T"
+	}
+
+	fields = append(fields,
+		m.TimeUTC.Serialize(),
+		fmt.Sprintf("%06.2f", m.Heading),
+		trueHeading,
+		fmt.Sprintf("%+06.2f", m.Roll),
+		fmt.Sprintf("%+06.2f", m.Pitch),
+		fmt.Sprintf("%+06.2f", m.Heave),
+		formatOptionalFloat(m.RollAccuracy, "%.3f"),
+		formatOptionalFloat(m.PitchAccuracy, "%.3f"),
+		formatOptionalFloat(m.HeadingAccuracy, "%.3f"),
+		strconv.Itoa(m.GPSQuality),
+		strconv.Itoa(m.IMUStatus),
+	)
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -0,0 +1,67 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+PFEC,GPatt Furuno satellite compass attitude
+           1     2     3
+           |     |     |
+$PFEC,GPatt,xxx.x,±xx.x,±xx.x*hh
+
+1) Yaw, heading in degrees (true)
+2) Pitch in degrees, positive when bow up
+3) Roll in degrees, positive when port side up (starboard down)
+4) Checksum
+
+Examples:
+$PFEC,GPatt,021.3,+01.5,-00.8*42
+$PFEC,GPatt,315.6,-02.4,+03.1*4B
+*/
+
+// NewPFECGPatt allocate PFECGPatt struct for Furuno attitude sentence
+func NewPFECGPatt(m Message) *PFECGPatt {
+	return &PFECGPatt{Message: m}
+}
+
+// PFECGPatt struct
+type PFECGPatt struct {
+	Message
+
+	Yaw   float64 // Heading in degree (true)
+	Pitch float64 // In degree, positive when bow up
+	Roll  float64 // In degree, positive when port side up
+}
+
+func (m *PFECGPatt) parse() (err error) {
+	if len(m.Fields) != 3 {
+		return m.Error(fmt.Errorf("Incomplete PFECGPatt message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 3))
+	}
+
+	for i, v := range []*float64{&m.Yaw, &m.Pitch, &m.Roll} {
+		if *v, err = strconv.ParseFloat(m.Fields[i], 64); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse data field %d (got: %s)", i+1, m.Fields[i]))
+		}
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence PFEC,GPatt as string
+func (m PFECGPatt) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("PFEC,GPatt")
+	fields := make([]string, 0)
+	fields = append(fields,
+		fmt.Sprintf("%05.1f", m.Yaw),
+		fmt.Sprintf("%+05.1f", m.Pitch),
+		fmt.Sprintf("%+05.1f", m.Roll),
+	)
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
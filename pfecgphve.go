@@ -0,0 +1,61 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+PFEC,GPhve Furuno satellite compass heave
+           1      2
+           |      |
+$PFEC,GPhve,±x.xxx,A*hh
+
+1) Heave in meters, positive up
+2) Status, A = data valid, V = data invalid
+3) Checksum
+
+Examples:
+$PFEC,GPhve,-0.125,A*14
+$PFEC,GPhve,0.042,V*2E
+*/
+
+// NewPFECGPhve allocate PFECGPhve struct for Furuno heave sentence
+func NewPFECGPhve(m Message) *PFECGPhve {
+	return &PFECGPhve{Message: m}
+}
+
+// PFECGPhve struct
+type PFECGPhve struct {
+	Message
+
+	Heave   float64 // In meters, positive up
+	IsValid DataValid
+}
+
+func (m *PFECGPhve) parse() (err error) {
+	if len(m.Fields) != 2 {
+		return m.Error(fmt.Errorf("Incomplete PFECGPhve message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 2))
+	}
+
+	if m.Heave, err = strconv.ParseFloat(m.Fields[0], 64); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse heave from data field (got: %s)", m.Fields[0]))
+	}
+
+	m.IsValid = (m.Fields[1] == "A")
+
+	return nil
+}
+
+// Serialize return a valid sentence PFEC,GPhve as string
+func (m PFECGPhve) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("PFEC,GPhve")
+	fields := make([]string, 0)
+	fields = append(fields, fmt.Sprintf("%.3f", m.Heave), m.IsValid.Serialize())
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -0,0 +1,110 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+PFEC,pidat Furuno equipment identification
+           1 2
+           | |
+$PFEC,pidat,x,c--c*hh
+
+1) Kind of identification data:
+   0 = Model name, 1 = Software version, 3 = Serial number
+2) Identification data
+3) Checksum
+
+Examples:
+$PFEC,pidat,0,SC-50*5C
+$PFEC,pidat,1,0252383-02.01*58
+*/
+
+// Allowed Furuno identification data kinds
+const (
+	// FECModelName is a FECIdentification type as int 0
+	FECModelName FECIdentification = 0
+	// FECSoftwareVersion is a FECIdentification type as int 1
+	FECSoftwareVersion FECIdentification = 1
+	// FECSerialNumber is a FECIdentification type as int 3
+	FECSerialNumber FECIdentification = 3
+)
+
+// FECIdentification type as int
+type FECIdentification int
+
+// Serialize return FECIdentification as string
+func (i FECIdentification) Serialize() string {
+	return strconv.Itoa(int(i))
+}
+
+// String return FECIdentification as human string
+func (i FECIdentification) String() string {
+	switch i {
+	case FECModelName:
+		return "Model name"
+	case FECSoftwareVersion:
+		return "Software version"
+	case FECSerialNumber:
+		return "Serial number"
+	default:
+		return "unknow"
+	}
+}
+
+// ParseFECIdentification return FECIdentification from raw string, return
+// an error "unknow value" if not allowed
+func ParseFECIdentification(raw string) (i FECIdentification, err error) {
+	v, err := strconv.Atoi(raw)
+	if err != nil {
+		return i, fmt.Errorf("unknow value")
+	}
+	i = FECIdentification(v)
+	switch i {
+	case FECModelName, FECSoftwareVersion, FECSerialNumber:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
+
+// NewPFECPidat allocate PFECPidat struct for Furuno identification sentence
+func NewPFECPidat(m Message) *PFECPidat {
+	return &PFECPidat{Message: m}
+}
+
+// PFECPidat struct
+type PFECPidat struct {
+	Message
+
+	Kind  FECIdentification
+	Value string
+}
+
+func (m *PFECPidat) parse() (err error) {
+	if len(m.Fields) != 2 {
+		return m.Error(fmt.Errorf("Incomplete PFECPidat message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 2))
+	}
+
+	if m.Kind, err = ParseFECIdentification(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse identification kind from data field (got: %s)", m.Fields[0]))
+	}
+
+	m.Value = m.Fields[1]
+
+	return nil
+}
+
+// Serialize return a valid sentence PFEC,pidat as string
+func (m PFECPidat) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("PFEC,pidat")
+	fields := make([]string, 0)
+	fields = append(fields, m.Kind.Serialize(), m.Value)
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -0,0 +1,77 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+PGRME Garmin Estimated Position Error
+       1   2 3   4 5   6
+       |   | |   | |   |
+$PGRME,x.x,M,x.x,M,x.x,M*hh
+
+1) Estimated horizontal position error (HPE)
+2) M = meters
+3) Estimated vertical position error (VPE)
+4) M = meters
+5) Estimated position error (EPE)
+6) M = meters
+7) Checksum
+
+Examples:
+$PGRME,15.0,M,45.0,M,25.0,M*1C
+$PGRME,3.3,M,4.9,M,6.0,M*25
+*/
+
+// NewPGRME allocate PGRME struct for Garmin estimated position error sentence
+func NewPGRME(m Message) *PGRME {
+	return &PGRME{Message: m}
+}
+
+// PGRME struct
+type PGRME struct {
+	Message
+
+	HorizontalError float64 // Estimated horizontal position error (HPE) in meters
+	VerticalError   float64 // Estimated vertical position error (VPE) in meters
+	PositionError   float64 // Estimated position error (EPE) in meters
+}
+
+func (m *PGRME) parse() (err error) {
+	if len(m.Fields) != 6 {
+		return m.Error(fmt.Errorf("Incomplete PGRME message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 6))
+	}
+
+	// Validate fixed field
+	for i, v := range map[int]string{1: "M", 3: "M", 5: "M"} {
+		if m.Fields[i] != v {
+			return m.Error(fmt.Errorf("Invalid fixed field at %d (got: %s, wanted: %s)", i+1, m.Fields[i], v))
+		}
+	}
+
+	for i, v := range map[int]*float64{0: &m.HorizontalError, 2: &m.VerticalError, 4: &m.PositionError} {
+		if *v, err = strconv.ParseFloat(m.Fields[i], 64); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse data field %d (got: %s)", i+1, m.Fields[i]))
+		}
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence PGRME as string
+func (m PGRME) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("PGRME")
+	fields := make([]string, 0)
+	fields = append(fields,
+		fmt.Sprintf("%.1f", m.HorizontalError), "M",
+		fmt.Sprintf("%.1f", m.VerticalError), "M",
+		fmt.Sprintf("%.1f", m.PositionError), "M",
+	)
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
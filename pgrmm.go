@@ -0,0 +1,63 @@
+package nmea
+
+import (
+	"fmt"
+	"strings"
+)
+
+/*
+PGRMM Garmin Map Datum
+       1
+       |
+$PGRMM,c--c*hh
+
+1) Name of the active map datum, as configured on the device
+2) Checksum
+
+Examples:
+$PGRMM,WGS 84*06
+$PGRMM,NAD27 Canada*2F
+*/
+
+// NewPGRMM allocate PGRMM struct for Garmin map datum sentence
+func NewPGRMM(m Message) *PGRMM {
+	return &PGRMM{Message: m}
+}
+
+// PGRMM struct
+type PGRMM struct {
+	Message
+
+	Datum string // Name of the active map datum (ie: WGS 84)
+}
+
+func (m *PGRMM) parse() (err error) {
+	if len(m.Fields) != 1 {
+		return m.Error(fmt.Errorf("Incomplete PGRMM message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 1))
+	}
+
+	if m.Datum = m.Fields[0]; len(m.Datum) == 0 {
+		return m.Error(fmt.Errorf("Unable to parse map datum from data field (got: %s)", m.Fields[0]))
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence PGRMM as string
+func (m PGRMM) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("PGRMM")
+	fields := make([]string, 0)
+	fields = append(fields, m.Datum)
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+// IsWGS84 return true if positions are provided in WGS 84 datum
+func (m PGRMM) IsWGS84() bool {
+	name := strings.ToUpper(strings.Replace(m.Datum, " ", "", -1))
+	return name == "WGS84" || name == DatumWGS84
+}
@@ -0,0 +1,95 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+PGRMZ Garmin Altitude
+       1   2 3
+       |   | |
+$PGRMZ,x.x,f,x*hh
+
+1) Altitude
+2) Unit: f = feet
+3) Position fix dimension: 2 = user altitude, 3 = GPS altitude (empty on some variometers)
+4) Checksum
+
+Examples:
+$PGRMZ,246,f,3*1B
+$PGRMZ,1523.5,f,*06
+*/
+
+// NewPGRMZ allocate PGRMZ struct for Garmin altitude sentence
+func NewPGRMZ(m Message) *PGRMZ {
+	return &PGRMZ{Message: m}
+}
+
+// PGRMZ struct
+type PGRMZ struct {
+	Message
+
+	Altitude     float64
+	Unit         DepthUnit // Feet as specified, some devices provide meters
+	FixDimension int       // 2 for user altitude, 3 for GPS altitude, 0 if not provided
+}
+
+func (m *PGRMZ) parse() (err error) {
+	if len(m.Fields) != 3 {
+		return m.Error(fmt.Errorf("Incomplete PGRMZ message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 3))
+	}
+
+	if m.Altitude, err = strconv.ParseFloat(m.Fields[0], 64); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse altitude from data field (got: %s)", m.Fields[0]))
+	}
+
+	// Altitude is in feet or meters, fathoms make no sense
+	switch m.Unit = DepthUnit(m.Fields[1]); m.Unit {
+	case Feet, Meters:
+	default:
+		return m.Error(fmt.Errorf("Unable to parse altitude unit from data field (got: %s)", m.Fields[1]))
+	}
+
+	switch m.Fields[2] {
+	case "":
+	case "2", "3":
+		m.FixDimension, _ = strconv.Atoi(m.Fields[2])
+	default:
+		return m.Error(fmt.Errorf("Unable to parse position fix dimension from data field (got: %s)", m.Fields[2]))
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence PGRMZ as string
+func (m PGRMZ) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("PGRMZ")
+	fields := make([]string, 0)
+
+	unit := m.Unit
+	if len(unit) == 0 {
+		unit = Feet
+	}
+
+	dimension := ""
+	if m.FixDimension > 0 {
+		dimension = strconv.Itoa(m.FixDimension)
+	}
+
+	fields = append(fields, strconv.FormatFloat(m.Altitude, 'f', -1, 64), unit.Serialize(), dimension)
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+// AltitudeMeters return altitude converted to meters
+func (m PGRMZ) AltitudeMeters() float64 {
+	if len(m.Unit) == 0 {
+		return Feet.ToMeters(m.Altitude)
+	}
+	return m.Unit.ToMeters(m.Altitude)
+}
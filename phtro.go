@@ -0,0 +1,95 @@
+package nmea
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+/*
+PHTRO Pitch and Roll from motion reference unit
+       1    2 3    4
+       |    | |    |
+$PHTRO,x.xx,a,y.yy,b*hh
+
+1) Pitch in degrees (absolute value)
+2) M = bow up, P = bow down
+3) Roll in degrees (absolute value)
+4) T = port side up, B = port side down
+5) Checksum
+
+Examples:
+$PHTRO,1.52,M,2.31,T*4E
+$PHTRO,0.84,P,3.07,B*4B
+*/
+
+// NewPHTRO allocate PHTRO struct for pitch and roll sentence
+func NewPHTRO(m Message) *PHTRO {
+	return &PHTRO{Message: m}
+}
+
+// PHTRO struct
+type PHTRO struct {
+	Message
+
+	Pitch float64 // In degree, positive when bow up
+	Roll  float64 // In degree, positive when port side up
+}
+
+func (m *PHTRO) parse() (err error) {
+	if len(m.Fields) != 4 {
+		return m.Error(fmt.Errorf("Incomplete PHTRO message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 4))
+	}
+
+	if m.Pitch, err = parseSignedAngle(m.Fields[0], m.Fields[1], "M", "P"); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse pitch from data fields (got: %s,%s)", m.Fields[0], m.Fields[1]))
+	}
+
+	if m.Roll, err = parseSignedAngle(m.Fields[2], m.Fields[3], "T", "B"); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse roll from data fields (got: %s,%s)", m.Fields[2], m.Fields[3]))
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence PHTRO as string
+func (m PHTRO) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("PHTRO")
+	fields := make([]string, 0)
+
+	pitch, roll := "M", "T"
+	if m.Pitch < 0 {
+		pitch = "P"
+	}
+	if m.Roll < 0 {
+		roll = "B"
+	}
+
+	fields = append(fields,
+		fmt.Sprintf("%.2f", math.Abs(m.Pitch)), pitch,
+		fmt.Sprintf("%.2f", math.Abs(m.Roll)), roll,
+	)
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+// parseSignedAngle return the angle in degree signed according to its direction flag
+func parseSignedAngle(value, dir, positive, negative string) (float64, error) {
+	angle, err := strconv.ParseFloat(value, 64)
+	if err != nil || angle < 0 {
+		return 0, fmt.Errorf("Invalid angle (got: %s)", value)
+	}
+
+	switch dir {
+	case positive:
+		return angle, nil
+	case negative:
+		return -angle, nil
+	default:
+		return 0, fmt.Errorf("Invalid angle direction (got: %s)", dir)
+	}
+}
@@ -0,0 +1,130 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+PMTK001 Acknowledgement of PMTK command (PMTK_ACK)
+         1   2
+         |   |
+$PMTK001,Cmd,Flag*hh
+
+1) Packet type of the acknowledged command (ie: 314 for PMTK314)
+2) Flag:
+	0 = invalid command
+	1 = unsupported command
+	2 = valid command, but action failed
+	3 = valid command, and action succeeded
+3) Checksum
+
+Examples:
+$PMTK001,869,3*37
+$PMTK001,314,3*36
+*/
+
+// Allowed flags of PMTK command acknowledgement
+const (
+	// InvalidCommand is a MTKAckFlag type as int 0
+	InvalidCommand MTKAckFlag = 0
+	// UnsupportedCommand is a MTKAckFlag type as int 1
+	UnsupportedCommand MTKAckFlag = 1
+	// FailedCommand is a MTKAckFlag type as int 2
+	FailedCommand MTKAckFlag = 2
+	// SucceededCommand is a MTKAckFlag type as int 3
+	SucceededCommand MTKAckFlag = 3
+)
+
+// MTKAckFlag type as int
+type MTKAckFlag int
+
+// Serialize return MTKAckFlag as string
+func (f MTKAckFlag) Serialize() string {
+	return strconv.Itoa(int(f))
+}
+
+// String return MTKAckFlag as human string
+func (f MTKAckFlag) String() string {
+	switch f {
+	case InvalidCommand:
+		return "Invalid command"
+	case UnsupportedCommand:
+		return "Unsupported command"
+	case FailedCommand:
+		return "Valid command, but action failed"
+	case SucceededCommand:
+		return "Valid command, and action succeeded"
+	default:
+		return "unknow"
+	}
+}
+
+// ParseMTKAckFlag return MTKAckFlag from raw string, return an error
+// "unknow value" if not allowed
+func ParseMTKAckFlag(raw string) (f MTKAckFlag, err error) {
+	v, err := strconv.Atoi(raw)
+	if err != nil {
+		return f, fmt.Errorf("unknow value")
+	}
+	f = MTKAckFlag(v)
+	switch f {
+	case InvalidCommand, UnsupportedCommand, FailedCommand, SucceededCommand:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
+
+// NewPMTK001 allocate PMTK001 struct for MediaTek command acknowledgement
+func NewPMTK001(m Message) *PMTK001 {
+	return &PMTK001{Message: m}
+}
+
+// PMTK001 struct
+type PMTK001 struct {
+	Message
+
+	Command string // Packet type of the acknowledged command
+	Flag    MTKAckFlag
+}
+
+func (m *PMTK001) parse() (err error) {
+	if len(m.Fields) != 2 {
+		return m.Error(fmt.Errorf("Incomplete PMTK001 message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 2))
+	}
+
+	if m.Command = m.Fields[0]; m.Command == "" {
+		return m.Error(fmt.Errorf("Unable to parse acknowledged command from data field (got: %s)", m.Fields[0]))
+	}
+
+	if m.Flag, err = ParseMTKAckFlag(m.Fields[1]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse acknowledgement flag from data field (got: %s)", m.Fields[1]))
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence PMTK001 as string
+func (m PMTK001) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("PMTK001")
+	fields := make([]string, 0)
+	fields = append(fields, m.Command, m.Flag.Serialize())
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+// Acknowledges return true if the acknowledgement is related to the command
+func (m PMTK001) Acknowledges(cmd Message) bool {
+	t, ok := cmd.Type.(MtkTypeID)
+	return ok && t.PacketType == m.Command
+}
+
+// Succeeded return true if the acknowledged command has been applied
+func (m PMTK001) Succeeded() bool {
+	return m.Flag == SucceededCommand
+}
@@ -0,0 +1,113 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+PMTK010 System message (PMTK_SYS_MSG)
+         1
+         |
+$PMTK010,Msg*hh
+
+1) System message:
+	000 = unknown
+	001 = startup
+	002 = notification for the host aiding EPO
+	003 = notification for the transition to normal mode is successfully done
+2) Checksum
+
+Examples:
+$PMTK010,001*2E
+$PMTK010,002*2D
+*/
+
+// Allowed PMTK system messages
+const (
+	// UnknownSystemMessage is a MTKSystemMessage type as int 0
+	UnknownSystemMessage MTKSystemMessage = 0
+	// StartupSystemMessage is a MTKSystemMessage type as int 1
+	StartupSystemMessage MTKSystemMessage = 1
+	// EPOAidingSystemMessage is a MTKSystemMessage type as int 2
+	EPOAidingSystemMessage MTKSystemMessage = 2
+	// NormalModeSystemMessage is a MTKSystemMessage type as int 3
+	NormalModeSystemMessage MTKSystemMessage = 3
+)
+
+// MTKSystemMessage type as int
+type MTKSystemMessage int
+
+// Serialize return MTKSystemMessage as string
+func (s MTKSystemMessage) Serialize() string {
+	return fmt.Sprintf("%03d", int(s))
+}
+
+// String return MTKSystemMessage as human string
+func (s MTKSystemMessage) String() string {
+	switch s {
+	case UnknownSystemMessage:
+		return "Unknown"
+	case StartupSystemMessage:
+		return "Startup"
+	case EPOAidingSystemMessage:
+		return "Host aiding EPO"
+	case NormalModeSystemMessage:
+		return "Transition to normal mode done"
+	default:
+		return "unknow"
+	}
+}
+
+// ParseMTKSystemMessage return MTKSystemMessage from raw string, return an
+// error "unknow value" if not allowed
+func ParseMTKSystemMessage(raw string) (s MTKSystemMessage, err error) {
+	v, err := strconv.Atoi(raw)
+	if err != nil {
+		return s, fmt.Errorf("unknow value")
+	}
+	s = MTKSystemMessage(v)
+	switch s {
+	case UnknownSystemMessage, StartupSystemMessage, EPOAidingSystemMessage, NormalModeSystemMessage:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
+
+// NewPMTK010 allocate PMTK010 struct for MediaTek system message
+func NewPMTK010(m Message) *PMTK010 {
+	return &PMTK010{Message: m}
+}
+
+// PMTK010 struct
+type PMTK010 struct {
+	Message
+
+	SystemMessage MTKSystemMessage
+}
+
+func (m *PMTK010) parse() (err error) {
+	if len(m.Fields) != 1 {
+		return m.Error(fmt.Errorf("Incomplete PMTK010 message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 1))
+	}
+
+	if m.SystemMessage, err = ParseMTKSystemMessage(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse system message from data field (got: %s)", m.Fields[0]))
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence PMTK010 as string
+func (m PMTK010) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("PMTK010")
+	fields := make([]string, 0)
+	fields = append(fields, m.SystemMessage.Serialize())
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
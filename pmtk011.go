@@ -0,0 +1,59 @@
+package nmea
+
+import (
+	"fmt"
+	"strings"
+)
+
+/*
+PMTK011 Text message (PMTK_TXT_MSG), output by the module at startup
+         1
+         |
+$PMTK011,c--c*hh
+
+1) Text, "MTKGPS" at startup
+2) Checksum
+
+Examples:
+$PMTK011,MTKGPS*08
+*/
+
+// NewPMTK011 allocate PMTK011 struct for MediaTek text message
+func NewPMTK011(m Message) *PMTK011 {
+	return &PMTK011{Message: m}
+}
+
+// PMTK011 struct
+type PMTK011 struct {
+	Message
+
+	Text string
+}
+
+func (m *PMTK011) parse() (err error) {
+	if len(m.Fields) == 0 {
+		return m.Error(fmt.Errorf("Incomplete PMTK011 message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 1))
+	}
+
+	m.Text = strings.Join(m.Fields, FieldDelimiter)
+
+	return nil
+}
+
+// Serialize return a valid sentence PMTK011 as string
+func (m PMTK011) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("PMTK011")
+	fields := make([]string, 0)
+	fields = append(fields, m.Text)
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+// IsStartup return true if the text is the one output by the module at startup
+func (m PMTK011) IsStartup() bool {
+	return m.Text == "MTKGPS"
+}
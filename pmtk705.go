@@ -0,0 +1,72 @@
+package nmea
+
+import "fmt"
+
+/*
+PMTK705 Firmware release information (PMTK_DT_RELEASE), reply to PMTK605 query
+         1          2        3             4
+         |          |        |             |
+$PMTK705,ReleaseStr,Build_ID,Product_Model,SDK_Version*hh
+
+1) Firmware release string
+2) Build identifier
+3) Product model
+4) SDK version, empty or missing on some firmwares
+5) Checksum
+
+Examples:
+$PMTK705,AXN_3.10_3333_12102201,0000,QUECTEL-L80,*11
+$PMTK705,AXN_2.31_3339_13101700,5632,PA6H,1.0*6B
+*/
+
+// NewPMTK705 allocate PMTK705 struct for MediaTek firmware release information
+func NewPMTK705(m Message) *PMTK705 {
+	return &PMTK705{Message: m}
+}
+
+// PMTK705 struct
+type PMTK705 struct {
+	Message
+
+	Release      string
+	BuildID      string
+	ProductModel string
+	SDKVersion   *string // nil if not provided
+}
+
+func (m *PMTK705) parse() (err error) {
+	if len(m.Fields) != 3 && len(m.Fields) != 4 {
+		return m.Error(fmt.Errorf("Incomplete PMTK705 message, not enougth data fields (got: %d, wanted: %d or %d)", len(m.Fields), 3, 4))
+	}
+
+	if m.Release = m.Fields[0]; m.Release == "" {
+		return m.Error(fmt.Errorf("Unable to parse firmware release from data field (got: %s)", m.Fields[0]))
+	}
+
+	m.BuildID = m.Fields[1]
+	m.ProductModel = m.Fields[2]
+
+	if len(m.Fields) == 4 {
+		sdk := m.Fields[3]
+		m.SDKVersion = &sdk
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence PMTK705 as string
+func (m PMTK705) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("PMTK705")
+	fields := make([]string, 0)
+	fields = append(fields, m.Release, m.BuildID, m.ProductModel)
+
+	if m.SDKVersion != nil {
+		fields = append(fields, *m.SDKVersion)
+	}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -0,0 +1,93 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+/*
+PMTK707 EPO data status (PMTK_DT_EPO_INFO), reply to PMTK607 query
+         1   2   3    4   5    6    7     8    9
+         |   |   |    |   |    |    |     |    |
+$PMTK707,Set,FWN,FTOW,LWN,LTOW,FCWN,FCTOW,LCWN,LCTOW*hh
+
+1) Number of EPO data sets stored in the module (0 if no EPO data)
+2) GPS week number of the first set
+3) GPS time of week of the first set, in seconds
+4) GPS week number of the last set
+5) GPS time of week of the last set, in seconds
+6) GPS week number of the first current set
+7) GPS time of week of the first current set, in seconds
+8) GPS week number of the last current set
+9) GPS time of week of the last current set, in seconds
+10) Checksum
+
+Examples:
+$PMTK707,56,1468,172800,1470,151200,1468,237600,1468,259200*13
+$PMTK707,0,0,0,0,0,0,0,0,0*2E
+*/
+
+// NewPMTK707 allocate PMTK707 struct for MediaTek EPO data status
+func NewPMTK707(m Message) *PMTK707 {
+	return &PMTK707{Message: m}
+}
+
+// PMTK707 struct
+type PMTK707 struct {
+	Message
+
+	Sets         int       // Number of EPO data sets
+	First        time.Time // GPS time of the first set
+	Last         time.Time // GPS time of the last set
+	FirstCurrent time.Time // GPS time of the first current set
+	LastCurrent  time.Time // GPS time of the last current set
+}
+
+func (m *PMTK707) parse() (err error) {
+	if len(m.Fields) != 9 {
+		return m.Error(fmt.Errorf("Incomplete PMTK707 message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 9))
+	}
+
+	if m.Sets, err = strconv.Atoi(m.Fields[0]); err != nil || m.Sets < 0 {
+		return m.Error(fmt.Errorf("Unable to parse number of EPO sets from data field (got: %s)", m.Fields[0]))
+	}
+
+	for i, t := range []*time.Time{&m.First, &m.Last, &m.FirstCurrent, &m.LastCurrent} {
+		week, err := strconv.Atoi(m.Fields[1+2*i])
+		if err != nil || week < 0 {
+			return m.Error(fmt.Errorf("Unable to parse GPS week number from data field (got: %s)", m.Fields[1+2*i]))
+		}
+		tow, err := strconv.Atoi(m.Fields[2+2*i])
+		if err != nil || tow < 0 || tow >= 7*24*3600 {
+			return m.Error(fmt.Errorf("Unable to parse GPS time of week from data field (got: %s)", m.Fields[2+2*i]))
+		}
+		*t = GPSTime(week, float64(tow))
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence PMTK707 as string
+func (m PMTK707) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("PMTK707")
+	fields := make([]string, 0)
+	fields = append(fields, strconv.Itoa(m.Sets))
+
+	for _, t := range []time.Time{m.First, m.Last, m.FirstCurrent, m.LastCurrent} {
+		week, tow := GPSWeek(t)
+		fields = append(fields, strconv.Itoa(week), fmt.Sprintf("%.0f", tow))
+	}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+// IsValid return true if EPO data are available and cover time t (GPS time)
+func (m PMTK707) IsValid(t time.Time) bool {
+	// Each set covers 6 hours from its start time
+	return m.Sets > 0 && !t.Before(m.FirstCurrent) && t.Before(m.LastCurrent.Add(6*time.Hour))
+}
@@ -0,0 +1,67 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+PRDID Pitch, Roll and Heading from motion reference unit
+       1      2      3
+       |      |      |
+$PRDID,PPP.PP,RRR.RR,HHH.HH*hh
+
+1) Pitch in degrees, positive when bow up
+2) Roll in degrees, positive when port side up
+3) Heading in degrees (true)
+4) Checksum
+
+Examples:
+$PRDID,-1.31,7.81,047.31*58
+$PRDID,2.45,-0.63,312.08*5A
+*/
+
+// NewPRDID allocate PRDID struct for pitch, roll and heading sentence
+func NewPRDID(m Message) *PRDID {
+	return &PRDID{Message: m}
+}
+
+// PRDID struct
+type PRDID struct {
+	Message
+
+	Pitch   float64 // In degree, positive when bow up
+	Roll    float64 // In degree, positive when port side up
+	Heading float64 // In degree (true)
+}
+
+func (m *PRDID) parse() (err error) {
+	if len(m.Fields) != 3 {
+		return m.Error(fmt.Errorf("Incomplete PRDID message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 3))
+	}
+
+	for i, v := range []*float64{&m.Pitch, &m.Roll, &m.Heading} {
+		if *v, err = strconv.ParseFloat(m.Fields[i], 64); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse data field %d (got: %s)", i+1, m.Fields[i]))
+		}
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence PRDID as string
+func (m PRDID) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("PRDID")
+	fields := make([]string, 0)
+	fields = append(fields,
+		fmt.Sprintf("%.2f", m.Pitch),
+		fmt.Sprintf("%.2f", m.Roll),
+		fmt.Sprintf("%06.2f", m.Heading),
+	)
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
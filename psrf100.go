@@ -0,0 +1,168 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+PSRF100 SiRF Set Serial Port
+         1 2    3 4 5
+         | |    | | |
+$PSRF100,x,xxxx,x,x,x*hh
+
+1) Protocol: 0 = SiRF binary, 1 = NMEA
+2) Baud rate
+3) Data bits: 8 or 7
+4) Stop bits: 0 or 1
+5) Parity: 0 = none, 1 = odd, 2 = even
+6) Checksum
+
+Examples:
+$PSRF100,1,9600,8,1,0*0D
+$PSRF100,0,38400,8,1,0*3C
+*/
+
+// Allowed SiRF serial protocols
+const (
+	// SRFProtocolBinary is a SRFProtocol type as int 0
+	SRFProtocolBinary SRFProtocol = 0
+	// SRFProtocolNMEA is a SRFProtocol type as int 1
+	SRFProtocolNMEA SRFProtocol = 1
+)
+
+// SRFProtocol type as int
+type SRFProtocol int
+
+// Serialize return SRFProtocol as string
+func (p SRFProtocol) Serialize() string {
+	return strconv.Itoa(int(p))
+}
+
+// String return SRFProtocol as human string
+func (p SRFProtocol) String() string {
+	switch p {
+	case SRFProtocolBinary:
+		return "SiRF binary"
+	case SRFProtocolNMEA:
+		return "NMEA"
+	default:
+		return "unknow"
+	}
+}
+
+// Allowed serial port parities
+const (
+	// NoParity is a Parity type as int 0
+	NoParity Parity = 0
+	// OddParity is a Parity type as int 1
+	OddParity Parity = 1
+	// EvenParity is a Parity type as int 2
+	EvenParity Parity = 2
+)
+
+// Parity type as int
+type Parity int
+
+// Serialize return Parity as string
+func (p Parity) Serialize() string {
+	return strconv.Itoa(int(p))
+}
+
+// String return Parity as human string
+func (p Parity) String() string {
+	switch p {
+	case NoParity:
+		return "None"
+	case OddParity:
+		return "Odd"
+	case EvenParity:
+		return "Even"
+	default:
+		return "unknow"
+	}
+}
+
+// SRFBaudRates are the baud rates allowed by PSRF100 command
+var SRFBaudRates = []int{1200, 2400, 4800, 9600, 19200, 38400, 57600, 115200}
+
+// NewPSRF100 allocate PSRF100 struct for SiRF serial port configuration sentence
+func NewPSRF100(m Message) *PSRF100 {
+	return &PSRF100{Message: m}
+}
+
+// PSRF100 struct
+type PSRF100 struct {
+	Message
+
+	Protocol SRFProtocol
+	BaudRate int
+	DataBits int
+	StopBits int
+	Parity   Parity
+}
+
+// NewSRFSetSerialPort return the PSRF100 command switching protocol and serial port settings
+func NewSRFSetSerialPort(protocol SRFProtocol, baud, dataBits, stopBits int, parity Parity) (PSRF100, error) {
+	m := PSRF100{Protocol: protocol, BaudRate: baud, DataBits: dataBits, StopBits: stopBits, Parity: parity}
+	return m, m.validate()
+}
+
+func (m PSRF100) validate() error {
+	if m.Protocol != SRFProtocolBinary && m.Protocol != SRFProtocolNMEA {
+		return fmt.Errorf("Invalid protocol (got: %d)", m.Protocol)
+	}
+
+	if m.DataBits != 7 && m.DataBits != 8 {
+		return fmt.Errorf("Invalid data bits (got: %d, wanted: 7 or 8)", m.DataBits)
+	}
+
+	if m.StopBits != 0 && m.StopBits != 1 {
+		return fmt.Errorf("Invalid stop bits (got: %d, wanted: 0 or 1)", m.StopBits)
+	}
+
+	if m.Parity < NoParity || m.Parity > EvenParity {
+		return fmt.Errorf("Invalid parity (got: %d)", m.Parity)
+	}
+
+	for _, allowed := range SRFBaudRates {
+		if m.BaudRate == allowed {
+			return nil
+		}
+	}
+	return fmt.Errorf("Invalid baud rate (got: %d, wanted: one of %v)", m.BaudRate, SRFBaudRates)
+}
+
+func (m *PSRF100) parse() (err error) {
+	if len(m.Fields) != 5 {
+		return m.Error(fmt.Errorf("Incomplete PSRF100 message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 5))
+	}
+
+	values := make([]int, len(m.Fields))
+	for i, raw := range m.Fields {
+		if values[i], err = strconv.Atoi(raw); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse data field %d (got: %s)", i+1, raw))
+		}
+	}
+	m.Protocol, m.BaudRate, m.DataBits, m.StopBits, m.Parity = SRFProtocol(values[0]), values[1], values[2], values[3], Parity(values[4])
+
+	if err = m.validate(); err != nil {
+		return m.Error(err)
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence PSRF100 as string
+func (m PSRF100) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("PSRF100")
+	fields := make([]string, 0)
+	fields = append(fields, m.Protocol.Serialize(), strconv.Itoa(m.BaudRate),
+		strconv.Itoa(m.DataBits), strconv.Itoa(m.StopBits), m.Parity.Serialize())
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
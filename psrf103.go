@@ -0,0 +1,128 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+PSRF103 SiRF Query/Rate Control
+         1  2  3  4
+         |  |  |  |
+$PSRF103,xx,xx,xx,xx*hh
+
+1) Message: 00 = GGA, 01 = GLL, 02 = GSA, 03 = GSV, 04 = RMC, 05 = VTG, 06 = MSS, 08 = ZDA
+2) Mode: 0 = set rate, 1 = query (output the message once)
+3) Rate in seconds between outputs (0 ~ 255), 0 disables the message
+4) Checksum: 0 = disabled, 1 = enabled
+5) Checksum
+
+Examples:
+$PSRF103,00,01,00,01*25
+$PSRF103,05,00,01,01*20
+*/
+
+// SRFMessages are the NMEA message identifiers controlled by PSRF103 command, by code
+var SRFMessages = map[int]string{0: "GGA", 1: "GLL", 2: "GSA", 3: "GSV", 4: "RMC", 5: "VTG", 6: "MSS", 8: "ZDA"}
+
+// NewPSRF103 allocate PSRF103 struct for SiRF query and rate control sentence
+func NewPSRF103(m Message) *PSRF103 {
+	return &PSRF103{Message: m}
+}
+
+// PSRF103 struct
+type PSRF103 struct {
+	Message
+
+	MsgID           string // NMEA message identifier without talker (ie: GGA)
+	Query           bool   // True to output the message once, false to set its rate
+	Rate            int    // In seconds between outputs, 0 disables the message
+	ChecksumEnabled bool   // True if checksum of the message is enabled
+}
+
+// NewSRFSetRate return the PSRF103 command setting the output rate of NMEA message msgID
+func NewSRFSetRate(msgID string, rate int) (PSRF103, error) {
+	m := PSRF103{MsgID: msgID, Rate: rate, ChecksumEnabled: true}
+	return m, m.validate()
+}
+
+// NewSRFQuery return the PSRF103 command querying NMEA message msgID once
+func NewSRFQuery(msgID string) (PSRF103, error) {
+	m := PSRF103{MsgID: msgID, Query: true, ChecksumEnabled: true}
+	return m, m.validate()
+}
+
+func (m PSRF103) code() (int, bool) {
+	for code, id := range SRFMessages {
+		if id == m.MsgID {
+			return code, true
+		}
+	}
+	return 0, false
+}
+
+func (m PSRF103) validate() error {
+	if _, ok := m.code(); !ok {
+		return fmt.Errorf("Invalid NMEA message identifier (got: %s)", m.MsgID)
+	}
+
+	if m.Rate < 0 || m.Rate > 255 {
+		return fmt.Errorf("Invalid output rate (got: %d, wanted: 0 to 255)", m.Rate)
+	}
+
+	return nil
+}
+
+func (m *PSRF103) parse() (err error) {
+	if len(m.Fields) != 4 {
+		return m.Error(fmt.Errorf("Incomplete PSRF103 message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 4))
+	}
+
+	code, err := strconv.Atoi(m.Fields[0])
+	if m.MsgID = SRFMessages[code]; err != nil || m.MsgID == "" {
+		return m.Error(fmt.Errorf("Unable to parse message from data field (got: %s)", m.Fields[0]))
+	}
+
+	for i, v := range map[int]*bool{1: &m.Query, 3: &m.ChecksumEnabled} {
+		switch m.Fields[i] {
+		case "0", "00", "1", "01":
+			*v = m.Fields[i][len(m.Fields[i])-1] == '1'
+		default:
+			return m.Error(fmt.Errorf("Unable to parse data field %d (got: %s)", i+1, m.Fields[i]))
+		}
+	}
+
+	if m.Rate, err = strconv.Atoi(m.Fields[2]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse output rate from data field (got: %s)", m.Fields[2]))
+	}
+
+	if err = m.validate(); err != nil {
+		return m.Error(err)
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence PSRF103 as string
+func (m PSRF103) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("PSRF103")
+	fields := make([]string, 0)
+
+	code, _ := m.code()
+	query, checksum := 0, 0
+	if m.Query {
+		query = 1
+	}
+	if m.ChecksumEnabled {
+		checksum = 1
+	}
+
+	fields = append(fields, fmt.Sprintf("%02d", code), fmt.Sprintf("%02d", query),
+		fmt.Sprintf("%02d", m.Rate), fmt.Sprintf("%02d", checksum))
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
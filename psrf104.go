@@ -0,0 +1,193 @@
+package nmea
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+/*
+PSRF104 SiRF LLA Navigation Initialization
+         1          2           3 4     5      6    7  8
+         |          |           | |     |      |    |  |
+$PSRF104,x.xxxxxxx,x.xxxxxxx,x,x,x,x,xx,x*hh
+
+1) Latitude in degrees, positive north
+2) Longitude in degrees, positive east
+3) Altitude in meters above ellipsoid
+4) Clock drift in Hz, 0 uses the last saved value
+5) GPS time of week in seconds
+6) GPS week number (extended, no rollover)
+7) Channel count (1 ~ 12)
+8) Reset configuration: 1 = hot start, 2 = warm start (clear ephemeris),
+   4 = warm start with initialization, 8 = cold start (clear memory)
+9) Checksum
+
+Examples:
+$PSRF104,37.3875111,-121.97232,0,96000,237759,1946,12,1*06
+$PSRF104,47.6234,-122.3521,12,0,0,0,12,8*33
+*/
+
+// Allowed SiRF reset configurations
+const (
+	// SRFHotStart is a SRFReset type as int 1
+	SRFHotStart SRFReset = 1
+	// SRFWarmStart is a SRFReset type as int 2
+	SRFWarmStart SRFReset = 2
+	// SRFWarmStartInit is a SRFReset type as int 4
+	SRFWarmStartInit SRFReset = 4
+	// SRFColdStart is a SRFReset type as int 8
+	SRFColdStart SRFReset = 8
+)
+
+// SRFReset type as int
+type SRFReset int
+
+// Serialize return SRFReset as string
+func (r SRFReset) Serialize() string {
+	return strconv.Itoa(int(r))
+}
+
+// String return SRFReset as human string
+func (r SRFReset) String() string {
+	switch r {
+	case SRFHotStart:
+		return "Hot start"
+	case SRFWarmStart:
+		return "Warm start"
+	case SRFWarmStartInit:
+		return "Warm start with initialization"
+	case SRFColdStart:
+		return "Cold start"
+	default:
+		return "unknow"
+	}
+}
+
+// ParseSRFReset return SRFReset from raw string, return an error
+// "unknow value" if not allowed
+func ParseSRFReset(raw string) (r SRFReset, err error) {
+	v, err := strconv.Atoi(raw)
+	if err != nil {
+		return r, fmt.Errorf("unknow value")
+	}
+	r = SRFReset(v)
+	switch r {
+	case SRFHotStart, SRFWarmStart, SRFWarmStartInit, SRFColdStart:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
+
+// NewPSRF104 allocate PSRF104 struct for SiRF navigation initialization sentence
+func NewPSRF104(m Message) *PSRF104 {
+	return &PSRF104{Message: m}
+}
+
+// PSRF104 struct
+type PSRF104 struct {
+	Message
+
+	Latitude     LatLong // In decimal format
+	Longitude    LatLong // In decimal format
+	Altitude     float64 // In meters above ellipsoid
+	ClockDrift   int     // In Hz, 0 uses the last saved value
+	TimeOfWeek   int     // GPS time of week in seconds
+	Week         int     // GPS week number, without rollover
+	ChannelCount int
+	Reset        SRFReset
+}
+
+// NewSRFNavigationInit return the PSRF104 command restarting the receiver
+// with an initial position and GPS time t
+func NewSRFNavigationInit(pos Position, altitude float64, t time.Time, reset SRFReset) (PSRF104, error) {
+	week, tow := GPSWeek(t)
+	m := PSRF104{
+		Latitude:     pos.Latitude,
+		Longitude:    pos.Longitude,
+		Altitude:     altitude,
+		TimeOfWeek:   int(tow),
+		Week:         week,
+		ChannelCount: 12,
+		Reset:        reset,
+	}
+	return m, m.validate()
+}
+
+func (m PSRF104) validate() error {
+	if math.Abs(float64(m.Latitude)) > MaxLat || math.Abs(float64(m.Longitude)) > MaxLong {
+		return fmt.Errorf("Invalid position (got: %f, %f)", m.Latitude, m.Longitude)
+	}
+
+	if m.TimeOfWeek < 0 || m.TimeOfWeek >= 7*24*3600 || m.Week < 0 {
+		return fmt.Errorf("Invalid GPS time (got: week %d, time of week %d)", m.Week, m.TimeOfWeek)
+	}
+
+	if m.ChannelCount < 1 || m.ChannelCount > 12 {
+		return fmt.Errorf("Invalid channel count (got: %d, wanted: 1 to 12)", m.ChannelCount)
+	}
+
+	if _, err := ParseSRFReset(m.Reset.Serialize()); err != nil {
+		return fmt.Errorf("Invalid reset configuration (got: %d)", m.Reset)
+	}
+
+	return nil
+}
+
+func (m *PSRF104) parse() (err error) {
+	if len(m.Fields) != 8 {
+		return m.Error(fmt.Errorf("Incomplete PSRF104 message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 8))
+	}
+
+	for i, v := range map[int]*LatLong{0: &m.Latitude, 1: &m.Longitude} {
+		f, err := strconv.ParseFloat(m.Fields[i], 64)
+		if err != nil {
+			return m.Error(fmt.Errorf("Unable to parse data field %d (got: %s)", i+1, m.Fields[i]))
+		}
+		*v = LatLong(f)
+	}
+
+	if m.Altitude, err = strconv.ParseFloat(m.Fields[2], 64); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse altitude from data field (got: %s)", m.Fields[2]))
+	}
+
+	for i, v := range map[int]*int{3: &m.ClockDrift, 4: &m.TimeOfWeek, 5: &m.Week, 6: &m.ChannelCount} {
+		if *v, err = strconv.Atoi(m.Fields[i]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse data field %d (got: %s)", i+1, m.Fields[i]))
+		}
+	}
+
+	if m.Reset, err = ParseSRFReset(m.Fields[7]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse reset configuration from data field (got: %s)", m.Fields[7]))
+	}
+
+	if err = m.validate(); err != nil {
+		return m.Error(err)
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence PSRF104 as string
+func (m PSRF104) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("PSRF104")
+	fields := make([]string, 0)
+	fields = append(fields,
+		strconv.FormatFloat(float64(m.Latitude), 'f', -1, 64),
+		strconv.FormatFloat(float64(m.Longitude), 'f', -1, 64),
+		strconv.FormatFloat(m.Altitude, 'f', -1, 64),
+		strconv.Itoa(m.ClockDrift),
+		strconv.Itoa(m.TimeOfWeek),
+		strconv.Itoa(m.Week),
+		strconv.Itoa(m.ChannelCount),
+		m.Reset.Serialize(),
+	)
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -0,0 +1,115 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+PSXN,20 Kongsberg Seatex Quality of attitude and position data
+        1 2 3 4
+        | | | |
+$PSXN,20,x,x,x,x*hh
+
+1) Horizontal position and velocity quality
+2) Height and vertical velocity quality
+3) Heading quality
+4) Roll and pitch quality
+   Each quality is: 0 = normal, 1 = reduced performance, 2 = invalid data
+5) Checksum
+
+Examples:
+$PSXN,20,0,0,0,0*3B
+$PSXN,20,1,0,0,2*38
+*/
+
+// Allowed Kongsberg Seatex data qualities
+const (
+	// SXNNormal is a SXNQuality type as int 0
+	SXNNormal SXNQuality = 0
+	// SXNReduced is a SXNQuality type as int 1
+	SXNReduced SXNQuality = 1
+	// SXNInvalid is a SXNQuality type as int 2
+	SXNInvalid SXNQuality = 2
+)
+
+// SXNQuality type as int
+type SXNQuality int
+
+// Serialize return SXNQuality as string
+func (q SXNQuality) Serialize() string {
+	return strconv.Itoa(int(q))
+}
+
+// String return SXNQuality as human string
+func (q SXNQuality) String() string {
+	switch q {
+	case SXNNormal:
+		return "Normal"
+	case SXNReduced:
+		return "Reduced performance"
+	case SXNInvalid:
+		return "Invalid data"
+	default:
+		return "unknow"
+	}
+}
+
+// ParseSXNQuality return SXNQuality from raw string, return an error
+// "unknow value" if not allowed
+func ParseSXNQuality(raw string) (q SXNQuality, err error) {
+	v, err := strconv.Atoi(raw)
+	if err != nil {
+		return q, fmt.Errorf("unknow value")
+	}
+	q = SXNQuality(v)
+	switch q {
+	case SXNNormal, SXNReduced, SXNInvalid:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
+
+// NewPSXN20 allocate PSXN20 struct for Kongsberg Seatex quality sentence
+func NewPSXN20(m Message) *PSXN20 {
+	return &PSXN20{Message: m}
+}
+
+// PSXN20 struct
+type PSXN20 struct {
+	Message
+
+	HorizontalQuality SXNQuality // Horizontal position and velocity quality
+	HeightQuality     SXNQuality // Height and vertical velocity quality
+	HeadingQuality    SXNQuality
+	RollPitchQuality  SXNQuality
+}
+
+func (m *PSXN20) parse() (err error) {
+	if len(m.Fields) != 4 {
+		return m.Error(fmt.Errorf("Incomplete PSXN20 message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 4))
+	}
+
+	for i, v := range []*SXNQuality{&m.HorizontalQuality, &m.HeightQuality, &m.HeadingQuality, &m.RollPitchQuality} {
+		if *v, err = ParseSXNQuality(m.Fields[i]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse data field %d (got: %s)", i+1, m.Fields[i]))
+		}
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence PSXN,20 as string
+func (m PSXN20) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("PSXN,20")
+	fields := make([]string, 0)
+	fields = append(fields, m.HorizontalQuality.Serialize(), m.HeightQuality.Serialize(),
+		m.HeadingQuality.Serialize(), m.RollPitchQuality.Serialize())
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
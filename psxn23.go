@@ -0,0 +1,68 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+PSXN,23 Kongsberg Seatex Roll, pitch, heading and heave
+        1    2     3      4
+        |    |     |      |
+$PSXN,23,x.xx,x.xx,xxx.xx,x.xx*hh
+
+1) Roll in degrees, positive when port side up
+2) Pitch in degrees, positive when bow up
+3) Heading in degrees (true)
+4) Heave in meters, positive down
+5) Checksum
+
+Examples:
+$PSXN,23,0.30,-0.97,298.57,0.13*1B
+$PSXN,23,-1.12,0.45,12.80,-0.05*05
+*/
+
+// NewPSXN23 allocate PSXN23 struct for Kongsberg Seatex attitude sentence
+func NewPSXN23(m Message) *PSXN23 {
+	return &PSXN23{Message: m}
+}
+
+// PSXN23 struct
+type PSXN23 struct {
+	Message
+
+	Roll    float64 // In degree, positive when port side up
+	Pitch   float64 // In degree, positive when bow up
+	Heading float64 // In degree (true)
+	Heave   float64 // In meters, positive down
+}
+
+func (m *PSXN23) parse() (err error) {
+	if len(m.Fields) != 4 {
+		return m.Error(fmt.Errorf("Incomplete PSXN23 message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 4))
+	}
+
+	for i, v := range []*float64{&m.Roll, &m.Pitch, &m.Heading, &m.Heave} {
+		if *v, err = strconv.ParseFloat(m.Fields[i], 64); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse data field %d (got: %s)", i+1, m.Fields[i]))
+		}
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence PSXN,23 as string
+func (m PSXN23) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("PSXN,23")
+	fields := make([]string, 0)
+
+	for _, v := range []float64{m.Roll, m.Pitch, m.Heading, m.Heave} {
+		fields = append(fields, fmt.Sprintf("%.2f", v))
+	}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -0,0 +1,165 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/*
+PTNL,GGK Trimble Time, Position, Position Type and DOP
+         1         2      3             4 5              6 7 8  9   10          11
+         |         |      |             | |              | | |  |   |           |
+$PTNL,GGK,hhmmss.ss,mmddyy,llll.llllllll,a,yyyyy.yyyyyyyy,a,x,xx,x.x,EHTxxx.xxx,M*hh
+
+1) Time (UTC)
+2) Date (UTC), month first
+3) Latitude
+4) N or S (North or South)
+5) Longitude
+6) E or W (East or West)
+7) GPS quality indicator:
+	0 = fix not available or invalid
+	1 = autonomous GPS fix
+	2 = RTK float solution
+	3 = RTK fix solution
+	4 = differential, code phase only solution (DGPS)
+	5 = SBAS solution
+	6 = RTK float 3D network solution
+	7 = RTK fixed 3D network solution
+	8 = RTK float 2D network solution
+	9 = RTK fixed 2D network solution
+	10 = OmniSTAR HP/XP solution
+	11 = OmniSTAR VBS solution
+	12 = location RTK solution
+	13 = beacon DGPS
+8) Number of satellites in fix
+9) DOP of fix
+10) Ellipsoidal height of fix, prefixed by "EHT"
+11) M = meters
+12) Checksum
+
+Examples:
+$PTNL,GGK,102939.00,051910,5000.97323841,N,00827.62010742,E,5,09,1.9,EHT150.790,M*73
+$PTNL,GGK,172814.00,071296,3723.46587704,N,12202.26957864,W,3,06,1.7,EHT-6.777,M*4B
+$PTNL,GGK,102939.00,,,,,,0,00,,EHT0.000,M*69
+*/
+
+// NewPTNLGGK allocate PTNLGGK struct for Trimble high precision position sentence
+func NewPTNLGGK(m Message) *PTNLGGK {
+	return &PTNLGGK{Message: m}
+}
+
+// PTNLGGK struct
+type PTNLGGK struct {
+	Message
+
+	DateTimeUTC        time.Time // Aggregation of TimeUTC+Date data field, on zero date if date not provided
+	Latitude           LatLong   // In decimal format
+	Longitude          LatLong   // In decimal format
+	Quality            int       // GPS quality indicator, 0 if fix not available
+	NbOfSatellitesUsed int
+	DOP                *float64 // nil if not provided
+	EllipsoidalHeight  float64  // In meters
+}
+
+func (m *PTNLGGK) parse() (err error) {
+	if len(m.Fields) != 11 {
+		return m.Error(fmt.Errorf("Incomplete PTNLGGK message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 11))
+	}
+
+	// Validate fixed field
+	if m.Fields[10] != "M" {
+		return m.Error(fmt.Errorf("Invalid fixed field at %d (got: %s, wanted: %s)", 11, m.Fields[10], "M"))
+	}
+
+	if len(m.Fields[1]) == 0 {
+		// Date not provided, keep time of day only
+		var t TimeOfDay
+		if t, err = ParseTimeOfDay(m.Fields[0]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse time UTC from data field (got: %s)", m.Fields[0]))
+		}
+		m.DateTimeUTC = t.On(time.Time{})
+	} else {
+		datetime := fmt.Sprintf("%s %s", m.Fields[1], m.Fields[0])
+		if m.DateTimeUTC, err = time.Parse("010206 150405", datetime); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse datetime UTC from data field (got: %s)", datetime))
+		}
+
+		// Apply century policy on two-digit year
+		m.DateTimeUTC = m.DateTimeUTC.AddDate(ExpandYear(m.DateTimeUTC.Year()%100, DefaultCenturyPivot)-m.DateTimeUTC.Year(), 0, 0)
+	}
+
+	if latitude := strings.TrimSpace(strings.Join(m.Fields[2:4], " ")); len(latitude) > 0 {
+		if m.Latitude, err = NewLatLong(latitude); err != nil {
+			return m.Error(err)
+		}
+	}
+
+	if longitude := strings.TrimSpace(strings.Join(m.Fields[4:6], " ")); len(longitude) > 0 {
+		if m.Longitude, err = NewLatLong(longitude); err != nil {
+			return m.Error(err)
+		}
+	}
+
+	if m.Quality, err = strconv.Atoi(m.Fields[6]); err != nil || m.Quality < 0 || m.Quality > 13 {
+		return m.Error(fmt.Errorf("Unable to parse GPS quality indicator from data field (got: %s)", m.Fields[6]))
+	}
+
+	if m.NbOfSatellitesUsed, err = strconv.Atoi(m.Fields[7]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse number of satellites from data field (got: %s)", m.Fields[7]))
+	}
+
+	if m.DOP, err = parseOptionalFloat(m.Fields[8]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse DOP from data field (got: %s)", m.Fields[8]))
+	}
+
+	height := m.Fields[9]
+	if !strings.HasPrefix(height, "EHT") {
+		return m.Error(fmt.Errorf("Unable to parse ellipsoidal height from data field (got: %s)", height))
+	}
+	if m.EllipsoidalHeight, err = strconv.ParseFloat(height[3:], 64); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse ellipsoidal height from data field (got: %s)", height))
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence PTNL,GGK as string
+func (m PTNLGGK) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("PTNL,GGK")
+	fields := make([]string, 0)
+
+	date := m.DateTimeUTC.Format("010206")
+	if m.DateTimeUTC.Truncate(24 * time.Hour).IsZero() {
+		// Time of day only
+		date = ""
+	}
+
+	fields = append(fields, m.DateTimeUTC.Format("150405.00"), date)
+	fields = append(fields, serializeOptionalPosition(m.Latitude, m.Longitude, 8)...)
+	fields = append(fields,
+		strconv.Itoa(m.Quality),
+		fmt.Sprintf("%02d", m.NbOfSatellitesUsed),
+		formatOptionalFloat(m.DOP, "%.1f"),
+		fmt.Sprintf("EHT%.3f", m.EllipsoidalHeight),
+		"M",
+	)
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+// IsValid return true if a position fix is available
+func (m PTNLGGK) IsValid() bool {
+	return m.Quality > 0
+}
+
+// IsRTKFixed return true if the position is a RTK fixed solution (ie: centimeter accuracy)
+func (m PTNLGGK) IsRTKFixed() bool {
+	return m.Quality == 3 || m.Quality == 7 || m.Quality == 9
+}
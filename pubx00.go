@@ -0,0 +1,222 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+/*
+PUBX,00 u-blox Lat/Long Position Data
+        1         2           3 4            5 6       7  8   9   10    11    12    13  14   15   16   17 18 19
+        |         |           | |            | |       |  |   |   |     |     |     |   |    |    |    |  |  |
+$PUBX,00,hhmmss.ss,llll.llllll,a,yyyyy.yyyyyy,a,x.x,cc,x.x,x.x,x.xxx,x.xx,x.xxx,x.x,x.xx,x.xx,x.xx,x,x,x*hh
+
+1) Time (UTC)
+2) Latitude
+3) N or S (North or South)
+4) Longitude
+5) E or W (East or West)
+6) Altitude above user datum ellipsoid, meters
+7) Navigation status:
+	NF = No fix
+	DR = Dead reckoning only solution
+	G2 = Stand alone 2D solution
+	G3 = Stand alone 3D solution
+	D2 = Differential 2D solution
+	D3 = Differential 3D solution
+	RK = Combined GPS + dead reckoning solution
+	TT = Time only solution
+8) Horizontal accuracy estimate, meters
+9) Vertical accuracy estimate, meters
+10) Speed over ground, km/h
+11) Course over ground, degrees
+12) Vertical velocity, m/s (positive downwards)
+13) Age of differential corrections, seconds (empty if not used)
+14) HDOP
+15) VDOP
+16) TDOP
+17) Number of satellites used in the navigation solution
+18) Reserved, always 0
+19) DR used
+20) Checksum
+
+Examples:
+$PUBX,00,081350.00,4717.11321,N,11833.915187,E,546.589,G3,2.1,2.0,0.007,77.52,0.007,,0.92,1.19,0.77,9,0,0*6F
+$PUBX,00,221103.00,3852.55427,S,17745.23351,E,34.318,D3,1.3,2.6,1.854,213.45,-0.023,4,0.81,1.35,0.94,12,0,0*6C
+*/
+
+// Allowed navigation status of u-blox position
+const (
+	// UBXNoFix is a UBXNavStatus type as string "NF"
+	UBXNoFix UBXNavStatus = "NF"
+	// UBXDeadReckoning is a UBXNavStatus type as string "DR"
+	UBXDeadReckoning UBXNavStatus = "DR"
+	// UBXStandAlone2D is a UBXNavStatus type as string "G2"
+	UBXStandAlone2D UBXNavStatus = "G2"
+	// UBXStandAlone3D is a UBXNavStatus type as string "G3"
+	UBXStandAlone3D UBXNavStatus = "G3"
+	// UBXDifferential2D is a UBXNavStatus type as string "D2"
+	UBXDifferential2D UBXNavStatus = "D2"
+	// UBXDifferential3D is a UBXNavStatus type as string "D3"
+	UBXDifferential3D UBXNavStatus = "D3"
+	// UBXCombined is a UBXNavStatus type as string "RK"
+	UBXCombined UBXNavStatus = "RK"
+	// UBXTimeOnly is a UBXNavStatus type as string "TT"
+	UBXTimeOnly UBXNavStatus = "TT"
+)
+
+// UBXNavStatus type as string
+type UBXNavStatus string
+
+// Serialize return UBXNavStatus as string
+func (s UBXNavStatus) Serialize() string {
+	return string(s)
+}
+
+// String return UBXNavStatus as human string
+func (s UBXNavStatus) String() string {
+	switch s {
+	case UBXNoFix:
+		return "No fix"
+	case UBXDeadReckoning:
+		return "Dead reckoning only"
+	case UBXStandAlone2D:
+		return "Stand alone 2D"
+	case UBXStandAlone3D:
+		return "Stand alone 3D"
+	case UBXDifferential2D:
+		return "Differential 2D"
+	case UBXDifferential3D:
+		return "Differential 3D"
+	case UBXCombined:
+		return "Combined GPS and dead reckoning"
+	case UBXTimeOnly:
+		return "Time only"
+	default:
+		return "unknow"
+	}
+}
+
+// ParseUBXNavStatus check UBXNavStatus validity, return an error
+// "unknow value" if not
+func ParseUBXNavStatus(raw string) (s UBXNavStatus, err error) {
+	s = UBXNavStatus(raw)
+	switch s {
+	case UBXNoFix, UBXDeadReckoning, UBXStandAlone2D, UBXStandAlone3D,
+		UBXDifferential2D, UBXDifferential3D, UBXCombined, UBXTimeOnly:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
+
+// NewPUBX00 allocate PUBX00 struct for u-blox proprietary position sentence
+func NewPUBX00(m Message) *PUBX00 {
+	return &PUBX00{Message: m}
+}
+
+// PUBX00 struct
+type PUBX00 struct {
+	Message
+
+	TimeUTC            TimeOfDay // Time UTC data field, without date
+	Latitude           LatLong   // In decimal format
+	Longitude          LatLong   // In decimal format
+	Altitude           float64   // Above user datum ellipsoid in meters
+	NavStatus          UBXNavStatus
+	HorizontalAccuracy float64  // Horizontal accuracy estimate in meters
+	VerticalAccuracy   float64  // Vertical accuracy estimate in meters
+	SOG                float64  // Speed over ground in km/h
+	COG                float64  // Course over ground in degree
+	VerticalVelocity   float64  // In m/s, positive downwards
+	DGPSAge            *float64 // Age of differential corrections in seconds, nil if not used
+	HDOP               float64
+	VDOP               float64
+	TDOP               float64
+	NbOfSatellitesUsed int
+	DeadReckoning      int // DR used
+}
+
+func (m *PUBX00) parse() (err error) {
+	if len(m.Fields) != 19 {
+		return m.Error(fmt.Errorf("Incomplete PUBX00 message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 19))
+	}
+
+	if m.TimeUTC, err = ParseTimeOfDay(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse time UTC from data field (got: %s)", m.Fields[0]))
+	}
+
+	if latitude := strings.TrimSpace(strings.Join(m.Fields[1:3], " ")); len(latitude) > 0 {
+		if m.Latitude, err = NewLatLong(latitude); err != nil {
+			return m.Error(err)
+		}
+	}
+
+	if longitude := strings.TrimSpace(strings.Join(m.Fields[3:5], " ")); len(longitude) > 0 {
+		if m.Longitude, err = NewLatLong(longitude); err != nil {
+			return m.Error(err)
+		}
+	}
+
+	if m.NavStatus, err = ParseUBXNavStatus(m.Fields[6]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse navigation status from data field (got: %s)", m.Fields[6]))
+	}
+
+	for i, v := range map[int]*float64{5: &m.Altitude, 7: &m.HorizontalAccuracy, 8: &m.VerticalAccuracy,
+		9: &m.SOG, 10: &m.COG, 11: &m.VerticalVelocity, 13: &m.HDOP, 14: &m.VDOP, 15: &m.TDOP} {
+		if *v, err = strconv.ParseFloat(m.Fields[i], 64); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse data field %d (got: %s)", i+1, m.Fields[i]))
+		}
+	}
+
+	if m.DGPSAge, err = parseOptionalFloat(m.Fields[12]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse age of differential corrections from data field (got: %s)", m.Fields[12]))
+	}
+
+	if m.NbOfSatellitesUsed, err = strconv.Atoi(m.Fields[16]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse number of satellites from data field (got: %s)", m.Fields[16]))
+	}
+
+	if m.DeadReckoning, err = strconv.Atoi(m.Fields[18]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse DR used from data field (got: %s)", m.Fields[18]))
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence PUBX,00 as string
+func (m PUBX00) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("PUBX,00")
+	fields := make([]string, 0)
+
+	fields = append(fields, m.TimeUTC.Serialize())
+	fields = append(fields, serializeOptionalPosition(m.Latitude, m.Longitude, 5)...)
+	fields = append(fields,
+		fmt.Sprintf("%.3f", m.Altitude),
+		m.NavStatus.Serialize(),
+		fmt.Sprintf("%.1f", m.HorizontalAccuracy),
+		fmt.Sprintf("%.1f", m.VerticalAccuracy),
+		fmt.Sprintf("%.3f", m.SOG),
+		fmt.Sprintf("%.2f", m.COG),
+		fmt.Sprintf("%.3f", m.VerticalVelocity),
+		formatOptionalFloat(m.DGPSAge, "%.0f"),
+		fmt.Sprintf("%.2f", m.HDOP),
+		fmt.Sprintf("%.2f", m.VDOP),
+		fmt.Sprintf("%.2f", m.TDOP),
+		strconv.Itoa(m.NbOfSatellitesUsed),
+		"0", // Reserved
+		strconv.Itoa(m.DeadReckoning),
+	)
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+// IsValid return true if the navigation status provides a position
+func (m PUBX00) IsValid() bool {
+	return m.NavStatus != UBXNoFix && m.NavStatus != UBXTimeOnly
+}
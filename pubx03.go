@@ -0,0 +1,191 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+/*
+PUBX,03 u-blox Satellite Status
+        1  2    3 4   5  6  7
+        |  |    | |   |  |  |
+$PUBX,03,GT{,SVID,s,AZM,EL,SN,LK}*hh
+
+1) Number of satellites tracked
+2) Satellite ID
+3) Satellite status:
+	- = Not used
+	U = Used in solution
+	e = Ephemeris available, but not used for navigation
+4) Azimuth in degrees (000 ~ 359), empty if unknown
+5) Elevation in degrees (00 ~ 90), empty if unknown
+6) Signal strength (C/N0) in dBHz (00 ~ 99)
+7) Satellite carrier lock time in seconds (000 ~ 064), 0 means code lock only
+more satellite infos like 2)-7)
+n) Checksum
+
+Examples:
+$PUBX,03,11,23,-,,,45,010,29,-,,,46,013,07,-,,,42,015,08,U,067,31,42,025,10,U,195,33,46,026,18,U,326,08,39,026,17,-,,,32,015,26,U,306,66,48,025,27,U,073,10,36,026,28,U,089,61,46,024,15,-,,,39,014*0D
+$PUBX,03,3,05,U,241,62,47,064,13,e,092,14,,000,120,-,198,32,38,031*02
+*/
+
+// Allowed status of u-blox tracked satellite
+const (
+	// UBXSatNotUsed is a UBXSatStatus type as string "-"
+	UBXSatNotUsed UBXSatStatus = "-"
+	// UBXSatUsed is a UBXSatStatus type as string "U"
+	UBXSatUsed UBXSatStatus = "U"
+	// UBXSatEphemeris is a UBXSatStatus type as string "e"
+	UBXSatEphemeris UBXSatStatus = "e"
+)
+
+// UBXSatStatus type as string
+type UBXSatStatus string
+
+// Serialize return UBXSatStatus as string
+func (s UBXSatStatus) Serialize() string {
+	return string(s)
+}
+
+// String return UBXSatStatus as human string
+func (s UBXSatStatus) String() string {
+	switch s {
+	case UBXSatNotUsed:
+		return "Not used"
+	case UBXSatUsed:
+		return "Used in solution"
+	case UBXSatEphemeris:
+		return "Ephemeris available"
+	default:
+		return "unknow"
+	}
+}
+
+// ParseUBXSatStatus check UBXSatStatus validity, return an error
+// "unknow value" if not
+func ParseUBXSatStatus(raw string) (s UBXSatStatus, err error) {
+	s = UBXSatStatus(raw)
+	switch s {
+	case UBXSatNotUsed, UBXSatUsed, UBXSatEphemeris:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
+
+// UBXSatellite struct is a satellite tracked by u-blox receiver
+type UBXSatellite struct {
+	Satellite
+
+	Status   UBXSatStatus
+	LockTime int // Carrier lock time in seconds, 0 means code lock only
+}
+
+// NewPUBX03 allocate PUBX03 struct for u-blox proprietary satellite status sentence
+func NewPUBX03(m Message) *PUBX03 {
+	return &PUBX03{Message: m}
+}
+
+// PUBX03 struct
+type PUBX03 struct {
+	Message
+
+	Satellites []UBXSatellite
+}
+
+func (m *PUBX03) parse() (err error) {
+	if len(m.Fields) < 1 {
+		return m.Error(fmt.Errorf("Incomplete PUBX03 message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 1))
+	}
+
+	count, err := strconv.Atoi(m.Fields[0])
+	if err != nil || count < 0 {
+		return m.Error(fmt.Errorf("Unable to parse number of satellites from data field (got: %s)", m.Fields[0]))
+	}
+
+	if len(m.Fields) != 1+6*count {
+		return m.Error(fmt.Errorf("Incomplete PUBX03 message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 1+6*count))
+	}
+
+	m.Satellites = make([]UBXSatellite, 0, count)
+	for offset := 1; offset < len(m.Fields); offset += 6 {
+		f := m.Fields[offset : offset+6]
+
+		// Same layout than GSV satellite, except azimuth comes before elevation
+		sat := UBXSatellite{}
+		if sat.Satellite, err = newSatelliteFromFields([]string{f[0], f[3], f[2], f[4]}); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse satellite from data fields (got: %s)", strings.Join(f, FieldDelimiter)))
+		}
+
+		if sat.Status, err = ParseUBXSatStatus(f[1]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse satellite status from data field (got: %s)", f[1]))
+		}
+
+		if sat.LockTime, err = strconv.Atoi(f[5]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse carrier lock time from data field (got: %s)", f[5]))
+		}
+
+		m.Satellites = append(m.Satellites, sat)
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence PUBX,03 as string
+func (m PUBX03) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("PUBX,03")
+	fields := make([]string, 0)
+	fields = append(fields, strconv.Itoa(len(m.Satellites)))
+
+	for _, s := range m.Satellites {
+		fields = append(fields, s.ID, s.Status.Serialize())
+
+		if s.Azimuth != nil {
+			fields = append(fields, fmt.Sprintf("%03d", *s.Azimuth))
+		} else {
+			fields = append(fields, "")
+		}
+
+		if s.Elevation != nil {
+			fields = append(fields, fmt.Sprintf("%02d", *s.Elevation))
+		} else {
+			fields = append(fields, "")
+		}
+
+		if s.SNR != nil {
+			fields = append(fields, fmt.Sprintf("%02d", *s.SNR))
+		} else {
+			fields = append(fields, "")
+		}
+
+		fields = append(fields, fmt.Sprintf("%03d", s.LockTime))
+	}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+// SkyView return tracked satellites as a sky view of mixed constellations,
+// as assembled from GSV sentences
+func (m PUBX03) SkyView() *SkyView {
+	view := &SkyView{Talker: TalkerIDGN, SatellitesInView: len(m.Satellites)}
+	for _, s := range m.Satellites {
+		view.Satellites = append(view.Satellites, s.Satellite)
+	}
+	return view
+}
+
+// Used return ID of satellites used in the navigation solution
+func (m PUBX03) Used() []string {
+	ids := make([]string, 0)
+	for _, s := range m.Satellites {
+		if s.Status == UBXSatUsed {
+			ids = append(ids, s.ID)
+		}
+	}
+	return ids
+}
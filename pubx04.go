@@ -0,0 +1,125 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/*
+PUBX,04 u-blox Time of Day and Clock Information
+        1         2      3         4    5   6       7       8  9
+        |         |      |         |    |   |       |       |  |
+$PUBX,04,hhmmss.ss,ddmmyy,x.xx,xxxx,xxD,x,x.xxx,x,*hh
+
+1) Time (UTC)
+2) Date (UTC)
+3) UTC time of week in seconds
+4) UTC week number, continues beyond 1023
+5) Leap seconds, suffixed by "D" if the value is the firmware default one
+   (not yet received from the satellites)
+6) Receiver clock bias in nanoseconds
+7) Receiver clock drift in nanoseconds per second
+8) Time pulse granularity in nanoseconds
+9) Reserved, always empty
+10) Checksum
+
+Examples:
+$PUBX,04,073731.00,091202,113851.00,1196,15D,1930035,-2660.664,43,*5D
+$PUBX,04,221103.00,150324,511863.00,2305,18,-1542,28.417,21,*26
+*/
+
+// NewPUBX04 allocate PUBX04 struct for u-blox proprietary time of day sentence
+func NewPUBX04(m Message) *PUBX04 {
+	return &PUBX04{Message: m}
+}
+
+// PUBX04 struct
+type PUBX04 struct {
+	Message
+
+	DateTimeUTC          time.Time // Aggregation of TimeUTC+Date data field
+	UTCTimeOfWeek        float64   // In seconds
+	UTCWeek              int
+	LeapSeconds          int
+	LeapSecondsDefault   bool    // True if leap seconds is the firmware default value
+	ClockBias            int     // In nanoseconds
+	ClockDrift           float64 // In nanoseconds per second
+	TimePulseGranularity int     // In nanoseconds
+}
+
+func (m *PUBX04) parse() (err error) {
+	if len(m.Fields) != 9 {
+		return m.Error(fmt.Errorf("Incomplete PUBX04 message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 9))
+	}
+
+	datetime := fmt.Sprintf("%s %s", m.Fields[1], m.Fields[0])
+	if m.DateTimeUTC, err = time.Parse("020106 150405", datetime); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse datetime UTC from data field (got: %s)", datetime))
+	}
+
+	// Apply century policy on two-digit year
+	m.DateTimeUTC = m.DateTimeUTC.AddDate(ExpandYear(m.DateTimeUTC.Year()%100, DefaultCenturyPivot)-m.DateTimeUTC.Year(), 0, 0)
+
+	if m.UTCTimeOfWeek, err = strconv.ParseFloat(m.Fields[2], 64); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse UTC time of week from data field (got: %s)", m.Fields[2]))
+	}
+
+	if m.UTCWeek, err = strconv.Atoi(m.Fields[3]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse UTC week number from data field (got: %s)", m.Fields[3]))
+	}
+
+	m.LeapSecondsDefault = strings.HasSuffix(m.Fields[4], "D")
+	if m.LeapSeconds, err = strconv.Atoi(strings.TrimSuffix(m.Fields[4], "D")); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse leap seconds from data field (got: %s)", m.Fields[4]))
+	}
+
+	if m.ClockBias, err = strconv.Atoi(m.Fields[5]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse clock bias from data field (got: %s)", m.Fields[5]))
+	}
+
+	if m.ClockDrift, err = strconv.ParseFloat(m.Fields[6], 64); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse clock drift from data field (got: %s)", m.Fields[6]))
+	}
+
+	if m.TimePulseGranularity, err = strconv.Atoi(m.Fields[7]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse time pulse granularity from data field (got: %s)", m.Fields[7]))
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence PUBX,04 as string
+func (m PUBX04) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("PUBX,04")
+	fields := make([]string, 0)
+
+	leap := strconv.Itoa(m.LeapSeconds)
+	if m.LeapSecondsDefault {
+		leap += "D"
+	}
+
+	fields = append(fields,
+		m.DateTimeUTC.Format("150405.00"),
+		m.DateTimeUTC.Format("020106"),
+		fmt.Sprintf("%.2f", m.UTCTimeOfWeek),
+		strconv.Itoa(m.UTCWeek),
+		leap,
+		strconv.Itoa(m.ClockBias),
+		fmt.Sprintf("%.3f", m.ClockDrift),
+		strconv.Itoa(m.TimePulseGranularity),
+		"", // Reserved
+	)
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+// GPSTime return the GPS time of the sentence, ahead of UTC by the leap seconds
+func (m PUBX04) GPSTime() time.Time {
+	return UTCToGPS(m.DateTimeUTC, m.LeapSeconds)
+}
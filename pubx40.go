@@ -0,0 +1,155 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+PUBX,40 u-blox Set NMEA message output rate
+        1   2    3    4    5    6    7
+        |   |    |    |    |    |    |
+$PUBX,40,ccc,rddc,rus1,rus2,rusb,rspi,x*hh
+
+1) NMEA message identifier (ie: GLL, GSV)
+2) Output rate on port DDC (I2C), in number of navigation solutions (0 disables the message)
+3) Output rate on port USART 1
+4) Output rate on port USART 2
+5) Output rate on port USB
+6) Output rate on port SPI
+7) Reserved, always 0
+8) Checksum
+
+Examples:
+$PUBX,40,GLL,1,0,0,0,0,0*5D
+$PUBX,40,GSV,0,5,0,0,0,0*5C
+*/
+
+// Allowed u-blox receiver ports
+const (
+	// UBXPortDDC is a UBXPort type as int 0
+	UBXPortDDC UBXPort = 0
+	// UBXPortUART1 is a UBXPort type as int 1
+	UBXPortUART1 UBXPort = 1
+	// UBXPortUART2 is a UBXPort type as int 2
+	UBXPortUART2 UBXPort = 2
+	// UBXPortUSB is a UBXPort type as int 3
+	UBXPortUSB UBXPort = 3
+	// UBXPortSPI is a UBXPort type as int 4
+	UBXPortSPI UBXPort = 4
+
+	// UBXNbOfPorts is the number of u-blox receiver ports
+	UBXNbOfPorts = 5
+
+	// UBXMaxOutputRate is the maximum output rate of a message, in number of navigation solutions
+	UBXMaxOutputRate = 255
+)
+
+// UBXPort type as int
+type UBXPort int
+
+// Serialize return UBXPort as string
+func (p UBXPort) Serialize() string {
+	return strconv.Itoa(int(p))
+}
+
+// String return UBXPort as human string
+func (p UBXPort) String() string {
+	switch p {
+	case UBXPortDDC:
+		return "DDC"
+	case UBXPortUART1:
+		return "UART1"
+	case UBXPortUART2:
+		return "UART2"
+	case UBXPortUSB:
+		return "USB"
+	case UBXPortSPI:
+		return "SPI"
+	default:
+		return "unknow"
+	}
+}
+
+// ParseUBXPort return UBXPort from raw string, return an error
+// "unknow value" if not allowed
+func ParseUBXPort(raw string) (p UBXPort, err error) {
+	v, err := strconv.Atoi(raw)
+	if err != nil || v < 0 || v >= UBXNbOfPorts {
+		return p, fmt.Errorf("unknow value")
+	}
+	return UBXPort(v), nil
+}
+
+// NewPUBX40 allocate PUBX40 struct for u-blox proprietary output rate sentence
+func NewPUBX40(m Message) *PUBX40 {
+	return &PUBX40{Message: m}
+}
+
+// PUBX40 struct
+type PUBX40 struct {
+	Message
+
+	MsgID string            // NMEA message identifier without talker (ie: GLL)
+	Rates [UBXNbOfPorts]int // Output rate by port, 0 disables the message
+}
+
+// NewUBXSetRate return the PUBX,40 command setting the output rate of NMEA
+// message msgID on each port
+func NewUBXSetRate(msgID string, rates [UBXNbOfPorts]int) (PUBX40, error) {
+	m := PUBX40{MsgID: msgID, Rates: rates}
+	return m, m.validate()
+}
+
+func (m PUBX40) validate() error {
+	if _, ok := TypeIDs["GP"+m.MsgID]; !ok || len(m.MsgID) != 3 {
+		return fmt.Errorf("Invalid NMEA message identifier (got: %s)", m.MsgID)
+	}
+
+	for port, rate := range m.Rates {
+		if rate < 0 || rate > UBXMaxOutputRate {
+			return fmt.Errorf("Invalid output rate on port %s (got: %d, wanted: 0 to %d)", UBXPort(port), rate, UBXMaxOutputRate)
+		}
+	}
+
+	return nil
+}
+
+func (m *PUBX40) parse() (err error) {
+	if len(m.Fields) != 7 {
+		return m.Error(fmt.Errorf("Incomplete PUBX40 message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 7))
+	}
+
+	m.MsgID = m.Fields[0]
+
+	for i := range m.Rates {
+		if m.Rates[i], err = strconv.Atoi(m.Fields[1+i]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse output rate from data field (got: %s)", m.Fields[1+i]))
+		}
+	}
+
+	if err = m.validate(); err != nil {
+		return m.Error(err)
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence PUBX,40 as string
+func (m PUBX40) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("PUBX,40")
+	fields := make([]string, 0)
+	fields = append(fields, m.MsgID)
+
+	for _, rate := range m.Rates {
+		fields = append(fields, strconv.Itoa(rate))
+	}
+
+	fields = append(fields, "0") // Reserved
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
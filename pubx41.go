@@ -0,0 +1,160 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/*
+PUBX,41 u-blox Set protocols and baud rate of a port
+        1 2    3    4      5
+        | |    |    |      |
+$PUBX,41,x,hhhh,hhhh,xxxxxx,x*hh
+
+1) Port identifier: 0 = DDC, 1 = USART 1, 2 = USART 2, 3 = USB, 4 = SPI
+2) Input protocol mask (hex): bit 0 = UBX, bit 1 = NMEA, bit 2 = RTCM2, bit 5 = RTCM3
+3) Output protocol mask (hex), same bits than input protocol mask
+4) Baud rate
+5) Autobauding: 1 = enabled, 0 = disabled
+6) Checksum
+
+Examples:
+$PUBX,41,1,0007,0003,19200,0*25
+$PUBX,41,3,0003,0002,115200,1*1E
+*/
+
+// Allowed u-blox protocols, to be combined as a mask
+const (
+	// UBXProtocolUBX is a UBXProtocol type as int 0x01
+	UBXProtocolUBX UBXProtocol = 0x01
+	// UBXProtocolNMEA is a UBXProtocol type as int 0x02
+	UBXProtocolNMEA UBXProtocol = 0x02
+	// UBXProtocolRTCM2 is a UBXProtocol type as int 0x04
+	UBXProtocolRTCM2 UBXProtocol = 0x04
+	// UBXProtocolRTCM3 is a UBXProtocol type as int 0x20
+	UBXProtocolRTCM3 UBXProtocol = 0x20
+
+	ubxProtocolMask = UBXProtocolUBX | UBXProtocolNMEA | UBXProtocolRTCM2 | UBXProtocolRTCM3
+)
+
+// UBXProtocol type as int, a mask of protocols
+type UBXProtocol int
+
+// Serialize return UBXProtocol as string
+func (p UBXProtocol) Serialize() string {
+	return fmt.Sprintf("%04X", int(p))
+}
+
+// Has return true if the mask contains protocol
+func (p UBXProtocol) Has(protocol UBXProtocol) bool {
+	return p&protocol == protocol
+}
+
+// ParseUBXProtocol return UBXProtocol mask from raw string in hex, return
+// an error "unknow value" if not allowed
+func ParseUBXProtocol(raw string) (p UBXProtocol, err error) {
+	v, err := strconv.ParseUint(raw, 16, 16)
+	if err != nil || UBXProtocol(v)&^ubxProtocolMask != 0 {
+		return p, fmt.Errorf("unknow value")
+	}
+	return UBXProtocol(v), nil
+}
+
+// UBXBaudRates are the baud rates allowed by PUBX,41 command
+var UBXBaudRates = []int{4800, 9600, 19200, 38400, 57600, 115200, 230400, 460800, 921600}
+
+// NewPUBX41 allocate PUBX41 struct for u-blox proprietary port configuration sentence
+func NewPUBX41(m Message) *PUBX41 {
+	return &PUBX41{Message: m}
+}
+
+// PUBX41 struct
+type PUBX41 struct {
+	Message
+
+	Port         UBXPort
+	InProtocols  UBXProtocol
+	OutProtocols UBXProtocol
+	BaudRate     int
+	Autobauding  bool
+}
+
+// NewUBXSetPort return the PUBX,41 command setting protocols and baud rate of port
+func NewUBXSetPort(port UBXPort, in, out UBXProtocol, baud int, autobauding bool) (PUBX41, error) {
+	m := PUBX41{Port: port, InProtocols: in, OutProtocols: out, BaudRate: baud, Autobauding: autobauding}
+	return m, m.validate()
+}
+
+func (m PUBX41) validate() error {
+	if m.Port < 0 || m.Port >= UBXNbOfPorts {
+		return fmt.Errorf("Invalid port identifier (got: %d)", m.Port)
+	}
+
+	for _, p := range []UBXProtocol{m.InProtocols, m.OutProtocols} {
+		if p&^ubxProtocolMask != 0 {
+			return fmt.Errorf("Invalid protocol mask (got: %s)", p.Serialize())
+		}
+	}
+
+	for _, allowed := range UBXBaudRates {
+		if m.BaudRate == allowed {
+			return nil
+		}
+	}
+	return fmt.Errorf("Invalid baud rate (got: %d, wanted: one of %v)", m.BaudRate, UBXBaudRates)
+}
+
+func (m *PUBX41) parse() (err error) {
+	if len(m.Fields) != 5 {
+		return m.Error(fmt.Errorf("Incomplete PUBX41 message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 5))
+	}
+
+	if m.Port, err = ParseUBXPort(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse port identifier from data field (got: %s)", m.Fields[0]))
+	}
+
+	if m.InProtocols, err = ParseUBXProtocol(m.Fields[1]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse input protocol mask from data field (got: %s)", m.Fields[1]))
+	}
+
+	if m.OutProtocols, err = ParseUBXProtocol(m.Fields[2]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse output protocol mask from data field (got: %s)", m.Fields[2]))
+	}
+
+	if m.BaudRate, err = strconv.Atoi(m.Fields[3]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse baud rate from data field (got: %s)", m.Fields[3]))
+	}
+
+	switch m.Fields[4] {
+	case "0", "1":
+		m.Autobauding = m.Fields[4] == "1"
+	default:
+		return m.Error(fmt.Errorf("Unable to parse autobauding from data field (got: %s)", m.Fields[4]))
+	}
+
+	if err = m.validate(); err != nil {
+		return m.Error(err)
+	}
+
+	return nil
+}
+
+// Serialize return a valid sentence PUBX,41 as string
+func (m PUBX41) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("PUBX,41")
+	fields := make([]string, 0)
+
+	autobauding := "0"
+	if m.Autobauding {
+		autobauding = "1"
+	}
+
+	fields = append(fields, m.Port.Serialize(), m.InProtocols.Serialize(), m.OutProtocols.Serialize(),
+		strconv.Itoa(m.BaudRate), autobauding)
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -0,0 +1,60 @@
+package nmea
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"time"
+)
+
+// Reader struct reads and parses NMEA sentences from a stream, one per line,
+// resynchronizing on sentence start
+type Reader struct {
+	scanner *bufio.Scanner
+	stats   *Stats
+}
+
+// NewReader allocate Reader struct reading from r, stats could be nil
+func NewReader(r io.Reader, stats *Stats) *Reader {
+	return &Reader{scanner: bufio.NewScanner(r), stats: stats}
+}
+
+// Stats return statistics of the Reader (could be nil)
+func (r Reader) Stats() *Stats {
+	return r.stats
+}
+
+// Next return next parsed message. A parsing error doesn't stop the stream,
+// io.EOF is returned at end of stream
+func (r *Reader) Next() (NMEA, error) {
+	for r.scanner.Scan() {
+		line := strings.TrimRight(r.scanner.Text(), "\r")
+
+		// Resync on sentence start, skipping garbage
+		start := strings.Index(line, Prefix)
+		if start < 0 {
+			if len(line) > 0 && r.stats != nil {
+				r.stats.AddBytes(len(line))
+				r.stats.Resync()
+			}
+			continue
+		}
+
+		if start > 0 && r.stats != nil {
+			r.stats.AddBytes(start)
+			r.stats.Resync()
+		}
+
+		raw := line[start:]
+		msg, err := Parse(raw)
+		if r.stats != nil {
+			r.stats.Record(raw, msg, err, time.Now())
+		}
+		return msg, err
+	}
+
+	if err := r.scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
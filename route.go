@@ -0,0 +1,124 @@
+package nmea
+
+import "fmt"
+
+// MaxSentenceLength is the maximum length of a NMEA sentence, including $ and CRLF
+const MaxSentenceLength = 82
+
+// Waypoint struct
+type Waypoint struct {
+	Name     string
+	Position *Position // nil if location not yet received from WPL sentence
+}
+
+// Route struct
+type Route struct {
+	Name      string
+	Complete  bool // True for complete route, false for working route (first listed waypoint is the FROM)
+	Waypoints []Waypoint
+}
+
+// RouteAssembler struct reconstructs routes from multi-sentence RTE and
+// associated WPL sentences
+type RouteAssembler struct {
+	waypoints map[string]Position // Location of waypoints by name from WPL sentences
+	pending   *Route
+	nbOfMsg   int
+	seqNumber int
+}
+
+// NewRouteAssembler allocate RouteAssembler struct
+func NewRouteAssembler() *RouteAssembler {
+	return &RouteAssembler{waypoints: make(map[string]Position)}
+}
+
+// Add feed RouteAssembler with a message, other messages than RTE and WPL are ignored.
+// Return the route once all RTE sentences of a group have been received
+func (a *RouteAssembler) Add(msg NMEA) (*Route, error) {
+	switch m := msg.(type) {
+	case *GPWPL:
+		a.waypoints[m.Name] = m.Position
+		if a.pending != nil {
+			a.locate(a.pending)
+		}
+	case *GPRTE:
+		return a.addRoute(m)
+	}
+	return nil, nil
+}
+
+// Waypoint return the location of waypoint by name, as received from WPL sentences
+func (a RouteAssembler) Waypoint(name string) (Position, bool) {
+	pos, ok := a.waypoints[name]
+	return pos, ok
+}
+
+func (a *RouteAssembler) addRoute(m *GPRTE) (*Route, error) {
+	if m.MessageNumber == 1 {
+		a.pending = &Route{Name: m.RouteName, Complete: m.RouteMode == CompleteRoute}
+	} else if a.pending == nil || m.MessageNumber != a.seqNumber+1 || m.NbOfMessages != a.nbOfMsg || m.RouteName != a.pending.Name {
+		a.pending = nil
+		return nil, m.Error(fmt.Errorf("Out of sequence RTE message (got: %d/%d)", m.MessageNumber, m.NbOfMessages))
+	}
+	a.nbOfMsg, a.seqNumber = m.NbOfMessages, m.MessageNumber
+
+	for _, id := range m.WaypointIDs {
+		a.pending.Waypoints = append(a.pending.Waypoints, Waypoint{Name: id})
+	}
+
+	if m.MessageNumber < m.NbOfMessages {
+		return nil, nil
+	}
+
+	route := a.pending
+	a.pending = nil
+	a.locate(route)
+	return route, nil
+}
+
+func (a RouteAssembler) locate(r *Route) {
+	for i, wpt := range r.Waypoints {
+		if pos, ok := a.waypoints[wpt.Name]; ok && wpt.Position == nil {
+			r.Waypoints[i].Position = &pos
+		}
+	}
+}
+
+// Sentences return the WPL sentences of located waypoints followed by the RTE
+// sentences of the route, split to respect MaxSentenceLength
+func (r Route) Sentences() []string {
+	sentences := make([]string, 0)
+
+	for _, wpt := range r.Waypoints {
+		if wpt.Position == nil {
+			continue
+		}
+		sentences = append(sentences, GPWPL{Position: *wpt.Position, Name: wpt.Name}.Serialize())
+	}
+
+	mode := WorkingRoute
+	if r.Complete {
+		mode = CompleteRoute
+	}
+
+	// Group waypoint names by sentence, headers fields use at most 2 digits for message numbers
+	header := GPRTE{NbOfMessages: 99, MessageNumber: 99, RouteMode: mode, RouteName: r.Name}
+	available := MaxSentenceLength - len(header.Serialize()) - 2 // -2 for CRLF
+	groups := [][]string{{}}
+	length := 0
+	for _, wpt := range r.Waypoints {
+		g := len(groups) - 1
+		if len(groups[g]) > 0 && length+len(wpt.Name)+len(FieldDelimiter) > available {
+			groups, g, length = append(groups, []string{}), g+1, 0
+		}
+		groups[g] = append(groups[g], wpt.Name)
+		length += len(wpt.Name) + len(FieldDelimiter)
+	}
+
+	for i, names := range groups {
+		rte := GPRTE{NbOfMessages: len(groups), MessageNumber: i + 1, RouteMode: mode, RouteName: r.Name, WaypointIDs: names}
+		sentences = append(sentences, rte.Serialize())
+	}
+
+	return sentences
+}
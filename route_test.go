@@ -0,0 +1,125 @@
+package nmea
+
+import "testing"
+
+func TestRouteAssembler(t *testing.T) {
+	nmeas := []string{
+		"$GPWPL,4917.16,N,12310.64,W,003*65",
+		"$GPRTE,2,1,c,0,PBRCPK,PBRTO,PTELGR,PPLAND,PYAMBU,PPFAIR,PWARRN,PMORTL,PLISMR*73",
+		"$GPRTE,2,2,c,0,PCRESY,GRYRIE,GCORIO,GWERR,GWESTG,7FED,003*2B",
+	}
+
+	a := NewRouteAssembler()
+	var route *Route
+	for _, raw := range nmeas {
+		msg, err := Parse(raw)
+		if err != nil {
+			t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+		}
+		if route, err = a.Add(msg); err != nil {
+			t.Fatalf("Unable to assemble \"%s\", err: %s", raw, err.Error())
+		}
+	}
+
+	if route == nil {
+		t.Fatal("Route shouldn't be nil")
+	}
+
+	if len(route.Waypoints) != 16 || !route.Complete || route.Name != "0" {
+		t.Fatalf("Wrong route assembled (got: %+v)", route)
+	}
+
+	if last := route.Waypoints[15]; last.Position == nil || last.Position.Latitude.ToDM() != "4917.16" {
+		t.Fatalf("Waypoint should be located (got: %+v)", last)
+	}
+
+	// Check bijectivity of assembly/generation process
+	b := NewRouteAssembler()
+	var generated *Route
+	for _, raw := range route.Sentences() {
+		msg, err := Parse(raw)
+		if err != nil {
+			t.Fatalf("Unable to parse generated \"%s\", err: %s", raw, err.Error())
+		}
+		if len(raw) > MaxSentenceLength-2 {
+			t.Fatalf("Generated sentence too long \"%s\"", raw)
+		}
+		if generated, err = b.Add(msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if generated == nil || len(generated.Waypoints) != len(route.Waypoints) || generated.Waypoints[15].Position == nil {
+		t.Fatalf("Wrong generated route (got: %+v)", generated)
+	}
+}
+
+func TestCrossTrackError(t *testing.T) {
+	origin := Position{Latitude: 47, Longitude: -3}
+	destination := Position{Latitude: 48, Longitude: -3}
+
+	// 0.5 nautical mile east of a northward track: vessel is on the right
+	p := Position{Latitude: 47.5, Longitude: -3}.Destination(90, 0.5*MetersPerNauticalMile)
+	xte := NewCrossTrackError(p, origin, destination)
+	if xte.SteerDirection != SteerLeft || Round(xte.CrossTrackError, 2) != 0.5 {
+		t.Fatalf("Wrong cross track error (got: %.3f %s)", xte.CrossTrackError, xte.SteerDirection)
+	}
+
+	msg, err := Parse(xte.Serialize())
+	if err != nil {
+		t.Fatalf("Unable to parse generated XTE, err: %s", err.Error())
+	}
+	if m := msg.(*GPXTE); m.SteerDirection != SteerLeft || m.IsValid != Valid {
+		t.Fatalf("Wrong generated XTE (got: %s)", m.Serialize())
+	}
+}
+
+func TestNavigation(t *testing.T) {
+	origin := Position{Latitude: 47, Longitude: -3}
+	destination := Position{Latitude: 48, Longitude: -3}
+	f := Fix{Position: Position{Latitude: 47.99, Longitude: -3}, Speed: 6, COG: 0, IsValid: Valid}
+
+	rmb, err := NewNavigation(f, Waypoint{Name: "ORIG", Position: &origin}, Waypoint{Name: "DEST", Position: &destination}, 1000)
+	if err != nil {
+		t.Fatalf("Unable to compute navigation, err: %s", err.Error())
+	}
+	if rmb.IsArrived != Invalid || Round(rmb.Range, 1) != 0.6 || Round(rmb.ClosingVelocity, 1) != 6 {
+		t.Fatalf("Wrong navigation (got: %s)", rmb.Serialize())
+	}
+
+	if apb := rmb.APB(origin.BearingTo(destination)); apb.IsPerpendicularPassed != Invalid || apb.DestinationID != "DEST" {
+		t.Fatalf("Wrong autopilot sentence (got: %s)", apb.Serialize())
+	}
+
+	// Moving away from the destination gives a negative closing velocity
+	f.COG = 180
+	if rmb, err = NewNavigation(f, Waypoint{Name: "ORIG", Position: &origin}, Waypoint{Name: "DEST", Position: &destination}, 1000); err != nil {
+		t.Fatalf("Unable to compute navigation, err: %s", err.Error())
+	}
+	m, err := Parse(rmb.Serialize())
+	if err != nil {
+		t.Fatalf("Unable to parse serialized RMB, err: %s", err.Error())
+	}
+	if v := m.(*GPRMB).ClosingVelocity; Round(v, 1) != -6 {
+		t.Fatalf("Wrong closing velocity (got: %f)", v)
+	}
+
+	if _, err := NewNavigation(f, Waypoint{Name: "ORIG"}, Waypoint{Name: "DEST"}, 1000); err == nil {
+		t.Fatal("Navigation to an unlocated waypoint should fail")
+	}
+}
+
+func TestRhumbLine(t *testing.T) {
+	p := Position{Latitude: 47, Longitude: -3}
+	wpt := Position{Latitude: 47, Longitude: -2}
+
+	bwr, err := NewRhumbLine(p, TimeOfDay{}, Waypoint{Name: "EAST", Position: &wpt}, -2)
+	if err != nil {
+		t.Fatalf("Unable to compute rhumb line, err: %s", err.Error())
+	}
+
+	// Along a parallel, rhumb line is due east and shorter than 60 nautical miles
+	if Round(*bwr.BearingTrue, 1) != 90 || Round(*bwr.BearingMagnetic, 1) != 92 || Round(*bwr.Distance, 1) != 40.9 {
+		t.Fatalf("Wrong rhumb line (got: %s)", bwr.Serialize())
+	}
+}
@@ -0,0 +1,81 @@
+package nmea
+
+import (
+	"math"
+	"time"
+)
+
+type motionSample struct {
+	sog, cog float64
+	time     time.Time
+}
+
+// MotionSmoother struct smooths speed and course over ground on a sliding time window,
+// course is a circular mean weighted by speed
+type MotionSmoother struct {
+	Window time.Duration // Sliding window length
+
+	samples []motionSample
+}
+
+// NewMotionSmoother allocate MotionSmoother struct with window length
+func NewMotionSmoother(window time.Duration) *MotionSmoother {
+	return &MotionSmoother{Window: window}
+}
+
+// Update feed MotionSmoother with a RMC or VTG message received at time now,
+// other messages are ignored
+func (s *MotionSmoother) Update(msg NMEA, now time.Time) {
+	switch m := msg.(type) {
+	case *GPRMC:
+		if m.IsValid {
+			s.Add(m.Speed, m.COG, now)
+		}
+	case *GPVTG:
+		s.Add(m.SpeedKnots, m.COG, now)
+	}
+}
+
+// Add feed MotionSmoother with speed over ground in knots and course over ground in degree
+func (s *MotionSmoother) Add(sog, cog float64, now time.Time) {
+	s.samples = append(s.samples, motionSample{sog: sog, cog: cog, time: now})
+	s.samples = s.window(now) // Drop samples out of window
+}
+
+// SOG return mean speed over ground in knots at time now, false if no sample in window
+func (s MotionSmoother) SOG(now time.Time) (float64, bool) {
+	samples := s.window(now)
+	if len(samples) == 0 {
+		return 0, false
+	}
+
+	sum := 0.0
+	for _, sample := range samples {
+		sum += sample.sog
+	}
+	return sum / float64(len(samples)), true
+}
+
+// COG return circular mean of course over ground in degree weighted by speed
+// at time now, false if no sample in window or vessel is not moving
+func (s MotionSmoother) COG(now time.Time) (float64, bool) {
+	var x, y float64
+	for _, sample := range s.window(now) {
+		x += sample.sog * math.Sin(toRadians(sample.cog))
+		y += sample.sog * math.Cos(toRadians(sample.cog))
+	}
+
+	if x == 0 && y == 0 {
+		return 0, false
+	}
+	return normalizeDegrees(toDegrees(math.Atan2(x, y))), true
+}
+
+// window return samples in window at time now, stale samples are kept until next Add
+func (s MotionSmoother) window(now time.Time) []motionSample {
+	i := 0
+	for i < len(s.samples) && now.Sub(s.samples[i].time) > s.Window {
+		i++
+	}
+	return s.samples[i:]
+}
@@ -0,0 +1,32 @@
+package nmea
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMotionSmoother(t *testing.T) {
+	start := time.Date(2020, time.June, 1, 12, 0, 0, 0, time.UTC)
+	s := NewMotionSmoother(10 * time.Second)
+
+	// Course across north is averaged the shortest way
+	s.Add(4, 350, start)
+	s.Add(6, 10, start.Add(time.Second))
+	s.Add(5, 0, start.Add(2*time.Second))
+
+	now := start.Add(3 * time.Second)
+	if sog, ok := s.SOG(now); !ok || sog != 5 {
+		t.Fatalf("Wrong smoothed SOG (got: %f)", sog)
+	}
+	if cog, ok := s.COG(now); !ok || Round(cog, 1) != 1.3 {
+		t.Fatalf("Wrong smoothed COG (got: %f)", cog)
+	}
+
+	// Stale samples aren't reported once the stream stops
+	if sog, ok := s.SOG(start.Add(time.Minute)); ok {
+		t.Fatalf("SOG shouldn't be available after window (got: %f)", sog)
+	}
+	if cog, ok := s.COG(start.Add(time.Minute)); ok {
+		t.Fatalf("COG shouldn't be available after window (got: %f)", cog)
+	}
+}
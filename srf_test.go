@@ -0,0 +1,55 @@
+package nmea
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSRFCommands(t *testing.T) {
+	port, err := NewSRFSetSerialPort(SRFProtocolNMEA, 9600, 8, 1, NoParity)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	query, err := NewSRFQuery("GGA")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rate, err := NewSRFSetRate("VTG", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for wanted, cmd := range map[string]NMEA{
+		"$PSRF100,1,9600,8,1,0*0D": port,
+		"$PSRF103,00,01,00,01*25":  query,
+		"$PSRF103,05,00,01,01*20":  rate,
+	} {
+		if cmd.Serialize() != wanted {
+			t.Fatalf("Wrong SiRF command (got: %s, wanted: %s)", cmd.Serialize(), wanted)
+		}
+	}
+
+	if _, err := NewSRFSetSerialPort(SRFProtocolNMEA, 9601, 8, 1, NoParity); err == nil {
+		t.Fatal("Unsupported baud rate should be rejected")
+	}
+	if _, err := NewSRFSetRate("HDT", 1); err == nil {
+		t.Fatal("Unsupported message should be rejected")
+	}
+
+	// GPS week 1946 starts on 2017-04-23
+	gps := time.Date(2017, time.April, 25, 18, 2, 39, 0, time.UTC)
+	init, err := NewSRFNavigationInit(Position{Latitude: 37.3875111, Longitude: -121.97232}, 0, gps, SRFHotStart)
+	if err != nil {
+		t.Fatal(err)
+	}
+	init.ClockDrift = 96000
+	if wanted := "$PSRF104,37.3875111,-121.97232,0,96000,237759,1946,12,1*06"; init.Serialize() != wanted {
+		t.Fatalf("Wrong SiRF navigation initialization (got: %s, wanted: %s)", init.Serialize(), wanted)
+	}
+
+	if _, err := NewSRFNavigationInit(Position{Latitude: 91}, 0, gps, SRFColdStart); err == nil {
+		t.Fatal("Invalid position should be rejected")
+	}
+}
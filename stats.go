@@ -0,0 +1,180 @@
+package nmea
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SentenceStats struct counts parsing results for a kind of sentence
+type SentenceStats struct {
+	Parsed           uint64 // Successfully parsed sentences
+	ChecksumFailures uint64 // Sentences with checksum mismatch
+	FieldErrors      uint64 // Sentences with invalid data fields
+}
+
+// Stats struct maintains parser and stream health statistics, safe for concurrent use
+type Stats struct {
+	mu            sync.Mutex
+	sentences     map[string]*SentenceStats
+	invalid       uint64 // Sentences with invalid envelope or unknown type
+	bytes         uint64
+	resyncs       uint64
+	last          time.Time
+	maxGap        time.Duration
+	totalGap      time.Duration
+	nbOfGaps      uint64
+	firstReceived time.Time
+}
+
+// StatsSnapshot struct is a copy of statistics at a given time
+type StatsSnapshot struct {
+	Sentences      map[string]SentenceStats // By sentence type (ie: "GPRMC")
+	Invalid        uint64                   // Sentences with invalid envelope or unknown type
+	Bytes          uint64                   // Bytes processed, without line terminators
+	Resyncs        uint64                   // Resynchronization on sentence start
+	MaxGap         time.Duration            // Maximum delay between two sentences
+	MeanGap        time.Duration            // Mean delay between two sentences
+	LastReceived   time.Time
+	FirstReceived  time.Time
+	TotalSentences uint64
+}
+
+// NewStats allocate Stats struct
+func NewStats() *Stats {
+	return &Stats{sentences: make(map[string]*SentenceStats)}
+}
+
+// Record account a raw sentence received at time now with the result of Parse()
+func (s *Stats) Record(raw string, msg NMEA, err error, now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.bytes += uint64(len(raw))
+
+	if !s.last.IsZero() {
+		gap := now.Sub(s.last)
+		if gap > s.maxGap {
+			s.maxGap = gap
+		}
+		s.totalGap += gap
+		s.nbOfGaps++
+	} else {
+		s.firstReceived = now
+	}
+	s.last = now
+
+	if cerr, ok := err.(ChecksumError); ok {
+		s.sentence(cerr.Message.Type.Serialize()).ChecksumFailures++
+		return
+	}
+
+	if msg == nil {
+		s.invalid++
+		return
+	}
+
+	if err != nil {
+		s.sentence(msg.GetMessage().Type.Serialize()).FieldErrors++
+		return
+	}
+
+	s.sentence(msg.GetMessage().Type.Serialize()).Parsed++
+}
+
+// AddBytes account bytes processed but not part of a sentence (ie: garbage skipped)
+func (s *Stats) AddBytes(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.bytes += uint64(n)
+}
+
+// Resync account a resynchronization on sentence start
+func (s *Stats) Resync() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.resyncs++
+}
+
+func (s *Stats) sentence(typ string) *SentenceStats {
+	st, ok := s.sentences[typ]
+	if !ok {
+		st = &SentenceStats{}
+		s.sentences[typ] = st
+	}
+	return st
+}
+
+// Snapshot return a copy of statistics
+func (s *Stats) Snapshot() StatsSnapshot {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	snap := StatsSnapshot{
+		Sentences:     make(map[string]SentenceStats, len(s.sentences)),
+		Invalid:       s.invalid,
+		Bytes:         s.bytes,
+		Resyncs:       s.resyncs,
+		MaxGap:        s.maxGap,
+		LastReceived:  s.last,
+		FirstReceived: s.firstReceived,
+	}
+
+	if s.nbOfGaps > 0 {
+		snap.MeanGap = s.totalGap / time.Duration(s.nbOfGaps)
+	}
+
+	snap.TotalSentences = s.invalid
+	for typ, st := range s.sentences {
+		snap.Sentences[typ] = *st
+		snap.TotalSentences += st.Parsed + st.ChecksumFailures + st.FieldErrors
+	}
+
+	return snap
+}
+
+// WritePrometheus export statistics in Prometheus text exposition format,
+// metric names are prefixed by namespace (ie: "nmea")
+func (s *Stats) WritePrometheus(w io.Writer, namespace string) error {
+	snap := s.Snapshot()
+
+	types := make([]string, 0, len(snap.Sentences))
+	for typ := range snap.Sentences {
+		types = append(types, typ)
+	}
+	sort.Strings(types)
+
+	var b strings.Builder
+	counter := func(name, help string) {
+		fmt.Fprintf(&b, "# HELP %s_%s %s\n# TYPE %s_%s counter\n", namespace, name, help, namespace, name)
+	}
+
+	counter("sentences_total", "Number of sentences by type and result.")
+	for _, typ := range types {
+		st := snap.Sentences[typ]
+		fmt.Fprintf(&b, "%s_sentences_total{type=%q,result=\"parsed\"} %d\n", namespace, typ, st.Parsed)
+		fmt.Fprintf(&b, "%s_sentences_total{type=%q,result=\"checksum_failure\"} %d\n", namespace, typ, st.ChecksumFailures)
+		fmt.Fprintf(&b, "%s_sentences_total{type=%q,result=\"field_error\"} %d\n", namespace, typ, st.FieldErrors)
+	}
+
+	counter("invalid_sentences_total", "Number of sentences with invalid envelope or unknown type.")
+	fmt.Fprintf(&b, "%s_invalid_sentences_total %d\n", namespace, snap.Invalid)
+
+	counter("bytes_total", "Number of bytes processed.")
+	fmt.Fprintf(&b, "%s_bytes_total %d\n", namespace, snap.Bytes)
+
+	counter("resyncs_total", "Number of resynchronizations on sentence start.")
+	fmt.Fprintf(&b, "%s_resyncs_total %d\n", namespace, snap.Resyncs)
+
+	fmt.Fprintf(&b, "# HELP %s_max_gap_seconds Maximum delay between two sentences.\n# TYPE %s_max_gap_seconds gauge\n", namespace, namespace)
+	fmt.Fprintf(&b, "%s_max_gap_seconds %g\n", namespace, snap.MaxGap.Seconds())
+
+	fmt.Fprintf(&b, "# HELP %s_mean_gap_seconds Mean delay between two sentences.\n# TYPE %s_mean_gap_seconds gauge\n", namespace, namespace)
+	fmt.Fprintf(&b, "%s_mean_gap_seconds %g\n", namespace, snap.MeanGap.Seconds())
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
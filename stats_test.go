@@ -0,0 +1,59 @@
+package nmea
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestReaderStats(t *testing.T) {
+	stream := strings.Join([]string{
+		"$GPRMC,013732.000,A,3150.7238,N,11711.7278,E,0.00,0.00,220413,,,A*68",
+		"garbage$GPVTG,0.0,T,,M,0.0,N,0.1,K,A*0C",
+		"noise",
+		"$GPVTG,0.0,T,,M,0.0,N,0.1,K,A*0D",
+		"$GPGSA,A,9,14,06,16,31,23,,,,,,,,1.66,1.42,0.84*05",
+		"$GPXXX,1*00",
+	}, "\r\n")
+
+	stats := NewStats()
+	r := NewReader(strings.NewReader(stream), stats)
+	nb := 0
+	for {
+		if _, err := r.Next(); err == io.EOF {
+			break
+		}
+		nb++
+	}
+
+	if nb != 5 {
+		t.Fatalf("Wrong number of sentences read (got: %d)", nb)
+	}
+
+	snap := stats.Snapshot()
+	if snap.Resyncs != 2 || snap.Invalid != 1 || snap.TotalSentences != 5 {
+		t.Fatalf("Wrong stream statistics (got: %+v)", snap)
+	}
+
+	// Garbage and sentences are accounted the same way, without line terminators
+	if wanted := uint64(len(strings.Replace(stream, "\r\n", "", -1))); snap.Bytes != wanted {
+		t.Fatalf("Wrong number of bytes (got: %d, wanted: %d)", snap.Bytes, wanted)
+	}
+
+	if st := snap.Sentences["GPVTG"]; st.Parsed != 1 || st.ChecksumFailures != 1 {
+		t.Fatalf("Wrong GPVTG statistics (got: %+v)", st)
+	}
+
+	if st := snap.Sentences["GPGSA"]; st.FieldErrors != 1 {
+		t.Fatalf("Wrong GPGSA statistics (got: %+v)", st)
+	}
+
+	var buf bytes.Buffer
+	if err := stats.WritePrometheus(&buf, "nmea"); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), `nmea_sentences_total{type="GPVTG",result="checksum_failure"} 1`) {
+		t.Fatalf("Wrong Prometheus export:\n%s", buf.String())
+	}
+}
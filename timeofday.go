@@ -0,0 +1,172 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TimeOfDay struct is a time UTC without date, as provided by date-less sentences (ie: GGA, GLL)
+type TimeOfDay struct {
+	Hour       int
+	Minute     int
+	Second     int
+	Nanosecond int
+
+	decimals int // Number of decimals of seconds to serialize, 0 means default (3), -1 means none
+}
+
+// NewTimeOfDay return TimeOfDay of a time
+func NewTimeOfDay(t time.Time) TimeOfDay {
+	t = t.UTC()
+	return TimeOfDay{Hour: t.Hour(), Minute: t.Minute(), Second: t.Second(), Nanosecond: t.Nanosecond()}
+}
+
+// ParseTimeOfDay return TimeOfDay from format "hhmmss" or "hhmmss.sss"
+func ParseTimeOfDay(raw string) (t TimeOfDay, err error) {
+	if len(raw) < 6 {
+		return t, fmt.Errorf("Wrong time of day format, got: \"%s\"", raw)
+	}
+
+	if t.Hour, err = strconv.Atoi(raw[0:2]); err != nil || t.Hour > 23 {
+		return t, fmt.Errorf("Invalid hour in time of day, got: \"%s\"", raw)
+	}
+
+	if t.Minute, err = strconv.Atoi(raw[2:4]); err != nil || t.Minute > 59 {
+		return t, fmt.Errorf("Invalid minute in time of day, got: \"%s\"", raw)
+	}
+
+	seconds, err := strconv.ParseFloat(raw[4:], 64)
+	if err != nil || seconds < 0 || seconds >= 61 || strings.ContainsAny(raw[4:], "+-eE") {
+		return t, fmt.Errorf("Invalid second in time of day, got: \"%s\"", raw)
+	}
+	t.Second = int(seconds)
+	t.Nanosecond = int(Round((seconds-float64(t.Second))*1e9, 0))
+
+	// Keep precision to serialize it back identically
+	t.decimals = -1
+	if i := strings.Index(raw, "."); i >= 0 {
+		t.decimals = len(raw) - i - 1
+	}
+
+	return t, nil
+}
+
+// Duration return elapsed time since midnight
+func (t TimeOfDay) Duration() time.Duration {
+	return time.Duration(t.Hour)*time.Hour +
+		time.Duration(t.Minute)*time.Minute +
+		time.Duration(t.Second)*time.Second +
+		time.Duration(t.Nanosecond)
+}
+
+// On return time at TimeOfDay on the day of date (UTC)
+func (t TimeOfDay) On(date time.Time) time.Time {
+	y, m, d := date.UTC().Date()
+	return time.Date(y, m, d, t.Hour, t.Minute, t.Second, t.Nanosecond, time.UTC)
+}
+
+// Serialize return TimeOfDay as string "hhmmss.sss", with the precision of parsed data field if any
+func (t TimeOfDay) Serialize() string {
+	hhmmss := fmt.Sprintf("%02d%02d%02d", t.Hour, t.Minute, t.Second)
+	switch {
+	case t.decimals < 0:
+		return hhmmss
+	case t.decimals == 0:
+		return hhmmss + fmt.Sprintf(".%03d", t.Nanosecond/int(time.Millisecond))
+	default:
+		fraction := fmt.Sprintf("%09d", t.Nanosecond)
+		for len(fraction) < t.decimals {
+			fraction += "0"
+		}
+		return hhmmss + "." + fraction[:t.decimals]
+	}
+}
+
+// String return TimeOfDay as human string
+func (t TimeOfDay) String() string {
+	return fmt.Sprintf("%02d:%02d:%02d.%03d", t.Hour, t.Minute, t.Second, t.Nanosecond/int(time.Millisecond))
+}
+
+// DateMerger struct combines time of date-less sentences with the date of
+// the most recent RMC, ZDA or PUBX,04 sentence
+type DateMerger struct {
+	resolver *DateResolver
+	last     *time.Time
+}
+
+// NewDateMerger allocate DateMerger struct resolving dates with resolver,
+// a default DateResolver is used if nil
+func NewDateMerger(resolver *DateResolver) *DateMerger {
+	if resolver == nil {
+		resolver = NewDateResolver()
+	}
+	return &DateMerger{resolver: resolver}
+}
+
+// Update feed DateMerger with a message, only RMC, ZDA and PUBX,04 sentences provide a date
+func (d *DateMerger) Update(msg NMEA) {
+	if t, ok := d.resolver.Resolve(msg); ok {
+		d.last = &t
+	}
+}
+
+// Merge return full timestamp of t on the date of the most recent RMC or ZDA
+// sentence, handling midnight rollover. Return false if no date received yet
+func (d DateMerger) Merge(t TimeOfDay) (time.Time, bool) {
+	if d.last == nil {
+		return time.Time{}, false
+	}
+
+	merged := t.On(*d.last)
+	switch diff := merged.Sub(*d.last); {
+	case diff > 12*time.Hour: // ie: last date at 00:00:01 and time at 23:59:59
+		merged = merged.AddDate(0, 0, -1)
+	case diff < -12*time.Hour: // ie: last date at 23:59:59 and time at 00:00:01
+		merged = merged.AddDate(0, 0, 1)
+	}
+	return merged, true
+}
+
+// parseElapsedTime return duration from format "hhmmss" or "hhmmss.ss", as
+// provided by time-to-go fields where hours are not limited to 23
+func parseElapsedTime(raw string) (d time.Duration, err error) {
+	if len(raw) < 6 || strings.ContainsAny(raw, "+-eE") {
+		return 0, fmt.Errorf("Wrong elapsed time format, got: \"%s\"", raw)
+	}
+
+	hours, err := strconv.Atoi(raw[0:2])
+	if err != nil {
+		return 0, fmt.Errorf("Invalid hours in elapsed time, got: \"%s\"", raw)
+	}
+
+	minutes, err := strconv.Atoi(raw[2:4])
+	if err != nil || minutes > 59 {
+		return 0, fmt.Errorf("Invalid minutes in elapsed time, got: \"%s\"", raw)
+	}
+
+	seconds, err := strconv.ParseFloat(raw[4:], 64)
+	if err != nil || seconds >= 60 {
+		return 0, fmt.Errorf("Invalid seconds in elapsed time, got: \"%s\"", raw)
+	}
+
+	return time.Duration(hours)*time.Hour +
+		time.Duration(minutes)*time.Minute +
+		time.Duration(Round(seconds*1e9, 0)), nil
+}
+
+// formatElapsedTime return duration as string "hhmmss.ss", hours are capped to 99
+func formatElapsedTime(d time.Duration) string {
+	if d < 0 {
+		d = 0
+	}
+	if limit := 100*time.Hour - 10*time.Millisecond; d > limit {
+		d = limit
+	}
+	d = d.Round(10 * time.Millisecond)
+	hours := d / time.Hour
+	minutes := (d % time.Hour) / time.Minute
+	seconds := float64(d%time.Minute) / float64(time.Second)
+	return fmt.Sprintf("%02d%02d%05.2f", hours, minutes, seconds)
+}
@@ -0,0 +1,42 @@
+package nmea
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTrimblePosition(t *testing.T) {
+	msg, err := Parse("$PTNL,GGK,172814.00,071296,3723.46587704,N,12202.26957864,W,3,06,1.7,EHT-6.777,M*4B")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ggk := msg.(*PTNLGGK)
+	if wanted := time.Date(1996, time.July, 12, 17, 28, 14, 0, time.UTC); !ggk.DateTimeUTC.Equal(wanted) {
+		t.Fatalf("Wrong datetime (got: %s, wanted: %s)", ggk.DateTimeUTC, wanted)
+	}
+
+	if !ggk.IsRTKFixed() || ggk.EllipsoidalHeight != -6.777 {
+		t.Fatalf("Wrong high precision position (got: %+v)", ggk)
+	}
+
+	if f, ok := NewFix(msg); !ok || f.IsValid != Valid || f.Position.Longitude >= 0 {
+		t.Fatalf("Wrong fix (got: %+v)", f)
+	}
+}
+
+func TestTrimbleNoFix(t *testing.T) {
+	msg, err := Parse("$PTNL,GGK,102939.00,,,,,,0,00,,EHT0.000,M*69")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ggk := msg.(*PTNLGGK)
+	if wanted := (TimeOfDay{Hour: 10, Minute: 29, Second: 39}).On(time.Time{}); !ggk.DateTimeUTC.Equal(wanted) {
+		t.Fatalf("Wrong time of day (got: %s, wanted: %s)", ggk.DateTimeUTC, wanted)
+	}
+
+	if ggk.IsValid() || ggk.DOP != nil {
+		t.Fatalf("Wrong position without fix (got: %+v)", ggk)
+	}
+}
@@ -0,0 +1,61 @@
+package nmea
+
+// MetersPerNauticalMile is the length of a nautical mile in meters
+const MetersPerNauticalMile = 1852.0
+
+// TripComputer struct accumulates distance over ground from fixes and
+// reconciles it with the distance through water provided by the log (VLW)
+type TripComputer struct {
+	GroundDistance float64 // Distance over ground from fixes since reset, in nautical miles
+	LogDistance    float64 // Distance through water from log since reset, in nautical miles
+	MinMove        float64 // Minimum move in meters between fixes to be accounted (filters jitter)
+
+	last     *Position
+	logStart *float64 // Log trip distance at reset
+}
+
+// NewTripComputer allocate TripComputer struct
+func NewTripComputer() *TripComputer {
+	return &TripComputer{MinMove: 5}
+}
+
+// Update feed TripComputer with a message: fixes (RMC, GGA, GNS, GLL) and VLW sentences
+func (t *TripComputer) Update(msg NMEA) {
+	if m, ok := msg.(*GPVLW); ok {
+		if t.logStart == nil {
+			start := m.TripWaterDistance
+			t.logStart = &start
+		}
+		t.LogDistance = m.TripWaterDistance - *t.logStart
+		return
+	}
+
+	f, ok := NewFix(msg)
+	if !ok || f.IsValid != Valid {
+		return
+	}
+
+	if t.last == nil {
+		t.last = &f.Position
+		return
+	}
+
+	if d := t.last.DistanceTo(f.Position); d >= t.MinMove {
+		t.GroundDistance += d / MetersPerNauticalMile
+		t.last = &f.Position
+	}
+}
+
+// Reset restart trip distances
+func (t *TripComputer) Reset() {
+	t.GroundDistance, t.LogDistance, t.last, t.logStart = 0, 0, nil, nil
+}
+
+// LogRatio return ratio of log distance over ground distance (ie: to calibrate
+// the log without current), false if ground distance is null
+func (t TripComputer) LogRatio() (float64, bool) {
+	if t.GroundDistance == 0 {
+		return 0, false
+	}
+	return t.LogDistance / t.GroundDistance, true
+}
@@ -0,0 +1,53 @@
+package nmea
+
+import (
+	"math"
+	"testing"
+)
+
+func TestTripComputer(t *testing.T) {
+	c := NewTripComputer()
+
+	// One minute of latitude north then one minute of latitude south, jitter
+	// and invalid fixes are ignored
+	for _, m := range []*GPRMC{
+		{Latitude: 47, Longitude: -3, IsValid: Valid},
+		{Latitude: 47.00001, Longitude: -3, IsValid: Valid},
+		{Latitude: 47 + 1.0/60, Longitude: -3, IsValid: Valid},
+		{Latitude: 48, Longitude: -3, IsValid: Invalid},
+		{Latitude: 47, Longitude: -3, IsValid: Valid},
+	} {
+		c.Update(m)
+	}
+	if math.Abs(c.GroundDistance-2) > 0.01 {
+		t.Fatalf("Wrong ground distance (got: %f)", c.GroundDistance)
+	}
+
+	// Log distance is the delta from the first VLW received
+	c.Update(&GPVLW{TotalWaterDistance: 100, TripWaterDistance: 10})
+	c.Update(&GPVLW{TotalWaterDistance: 102.2, TripWaterDistance: 12.2})
+	if Round(c.LogDistance, 3) != 2.2 {
+		t.Fatalf("Wrong log distance (got: %f)", c.LogDistance)
+	}
+	if ratio, ok := c.LogRatio(); !ok || math.Abs(ratio-1.1) > 0.01 {
+		t.Fatalf("Wrong log ratio (got: %f, %t)", ratio, ok)
+	}
+
+	// Reset restarts from next fix and next VLW
+	c.Reset()
+	if c.GroundDistance != 0 || c.LogDistance != 0 {
+		t.Fatalf("Trip not reset (got: %f, %f)", c.GroundDistance, c.LogDistance)
+	}
+	if _, ok := c.LogRatio(); ok {
+		t.Fatal("Log ratio shouldn't be available after reset")
+	}
+	c.Update(&GPRMC{Latitude: 10, Longitude: 10, IsValid: Valid})
+	c.Update(&GPVLW{TripWaterDistance: 12.5})
+	if c.GroundDistance != 0 || c.LogDistance != 0 {
+		t.Fatalf("Trip should restart from new references (got: %f, %f)", c.GroundDistance, c.LogDistance)
+	}
+	c.Update(&GPVLW{TripWaterDistance: 13})
+	if Round(c.LogDistance, 3) != 0.5 {
+		t.Fatalf("Wrong log distance (got: %f)", c.LogDistance)
+	}
+}
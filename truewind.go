@@ -0,0 +1,107 @@
+package nmea
+
+import "math"
+
+// TrueWind struct is wind computed relative to ground from apparent wind and vessel motion
+type TrueWind struct {
+	Angle     float64 // Wind angle relative to the bow in degree (0 ~ 360)
+	Direction float64 // Direction the wind blows from in degree (true)
+	Speed     float64 // Wind speed in knots
+}
+
+// ComputeTrueWind return true wind from apparent wind angle (relative to the bow, degree)
+// and speed (knots), speed over ground (knots), course over ground and heading (true, degree)
+func ComputeTrueWind(apparentAngle, apparentSpeed, sog, cog, heading float64) TrueWind {
+	// Vectors (east, north) of air motion
+	from := toRadians(heading + apparentAngle)
+	x := -apparentSpeed*math.Sin(from) + sog*math.Sin(toRadians(cog))
+	y := -apparentSpeed*math.Cos(from) + sog*math.Cos(toRadians(cog))
+
+	w := TrueWind{Speed: math.Hypot(x, y)}
+	if w.Speed > 0 {
+		w.Direction = normalizeDegrees(toDegrees(math.Atan2(-x, -y)))
+	}
+	w.Angle = normalizeDegrees(w.Direction - heading)
+	return w
+}
+
+// TrueWindCalculator struct keeps track of vessel motion to compute true wind
+// from apparent wind
+type TrueWindCalculator struct {
+	SOG     float64  // Speed over ground in knots
+	COG     float64  // Course over ground in degree (true)
+	Heading *float64 // Heading in degree (true), COG is used if nil
+}
+
+// NewTrueWindCalculator allocate TrueWindCalculator struct
+func NewTrueWindCalculator() *TrueWindCalculator {
+	return &TrueWindCalculator{}
+}
+
+// UpdateFix set vessel motion from a fix, invalid fixes are ignored
+func (c *TrueWindCalculator) UpdateFix(f Fix) {
+	if !f.IsValid {
+		return
+	}
+	c.SOG, c.COG = f.Speed, f.COG
+}
+
+// SetHeading set vessel heading in degree (true)
+func (c *TrueWindCalculator) SetHeading(heading float64) {
+	c.Heading = &heading
+}
+
+// Compute return true wind from apparent wind angle relative to the bow (degree) and speed (knots)
+func (c TrueWindCalculator) Compute(apparentAngle, apparentSpeed float64) TrueWind {
+	heading := c.COG
+	if c.Heading != nil {
+		heading = *c.Heading
+	}
+	return ComputeTrueWind(apparentAngle, apparentSpeed, c.SOG, c.COG, heading)
+}
+
+// Update feed TrueWindCalculator with a message: fixes (RMC, GGA, GNS, GLL),
+// true heading (HDT) and relative wind (MWV, VWR). Return true wind computed from
+// valid relative wind, nil otherwise
+func (c *TrueWindCalculator) Update(msg NMEA) *TrueWind {
+	switch m := msg.(type) {
+	case *GPHDT:
+		c.SetHeading(m.Heading)
+	case *GPMWV:
+		if m.IsValid && m.Reference == RelativeWind {
+			w := c.Compute(m.Angle, m.SpeedKnots())
+			return &w
+		}
+	case *GPVWR:
+		mwv := m.MWV()
+		return c.Update(&mwv)
+	default:
+		if f, ok := NewFix(msg); ok {
+			c.UpdateFix(f)
+		}
+	}
+	return nil
+}
+
+// MWV return true wind as MWV sentence (theoretical wind speed and angle) in knots
+func (w TrueWind) MWV() GPMWV {
+	return GPMWV{Angle: w.Angle, Reference: TheoreticalWind, Speed: w.Speed, SpeedUnit: Knots, IsValid: Valid}
+}
+
+// MWD return true wind as MWD sentence (wind direction and speed)
+// with magnetic variation in degree (negative = West)
+func (w TrueWind) MWD(variation float64) GPMWD {
+	direction, magnetic := w.Direction, normalizeDegrees(w.Direction-variation)
+	return GPMWD{
+		DirectionTrue:     &direction,
+		DirectionMagnetic: &magnetic,
+		SpeedKnots:        w.Speed,
+		SpeedMeters:       w.Speed * KnotsToMetersPerSecond,
+	}
+}
+
+// SerializeMWD return true wind as MWD sentence (wind direction and speed)
+// with magnetic variation in degree (negative = West)
+func (w TrueWind) SerializeMWD(variation float64) string {
+	return w.MWD(variation).Serialize()
+}
@@ -0,0 +1,59 @@
+package nmea
+
+import (
+	"math"
+	"testing"
+)
+
+func TestComputeTrueWind(t *testing.T) {
+	// Heading north at 6 knots with true wind from east at 8 knots gives
+	// apparent wind at 10 knots, 53.13° on starboard bow
+	w := ComputeTrueWind(toDegrees(math.Atan2(8, 6)), 10, 6, 0, 0)
+	if Round(w.Speed, 3) != 8 || Round(w.Direction, 3) != 90 || Round(w.Angle, 3) != 90 {
+		t.Fatalf("Wrong true wind (got: %+v)", w)
+	}
+
+	// Heading west while drifting south at 6 knots with true wind from west
+	// at 8 knots gives apparent wind at 10 knots, 36.87° on port bow
+	w = ComputeTrueWind(360-toDegrees(math.Atan2(6, 8)), 10, 6, 180, 270)
+	if Round(w.Speed, 3) != 8 || Round(w.Direction, 3) != 270 || math.Abs(math.Remainder(w.Angle, 360)) > 1e-9 {
+		t.Fatalf("Wrong true wind (got: %+v)", w)
+	}
+}
+
+func TestTrueWindCalculator(t *testing.T) {
+	c := NewTrueWindCalculator()
+	c.UpdateFix(Fix{Speed: 5, COG: 90, IsValid: Valid})
+	update := func(raw string) *TrueWind {
+		msg, err := Parse(raw)
+		if err != nil {
+			t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+		}
+		return c.Update(msg)
+	}
+
+	// Apparent wind on the nose at 10 knots while heading east at 5 knots
+	w := update("$IIVWR,000.0,R,10.0,N,,M,,K*78")
+	if w == nil {
+		t.Fatal("True wind shouldn't be nil")
+	}
+	if Round(w.Speed, 1) != 5 || Round(w.Direction, 1) != 90 || Round(w.Angle, 1) != 0 {
+		t.Fatalf("Wrong true wind (got: %+v)", *w)
+	}
+
+	// Apparent wind on port beam, speed only provided in km/h
+	if w = update("$IIVWR,090.0,L,,N,,M,9.26,K*63"); w == nil || Round(w.Direction, 1) != 315 || Round(w.Speed, 1) != 7.1 {
+		t.Fatalf("Wrong true wind (got: %+v)", w)
+	}
+
+	// Relative wind without speed or angle is ignored
+	for _, raw := range []string{"$IIVWR,090.0,L,,N,,M,,K*70", "$IIVWR,,,10.0,N,5.1,M,18.5,K*3C"} {
+		if tw := update(raw); tw != nil {
+			t.Fatalf("Unexpected true wind from \"%s\" (got: %+v)", raw, *tw)
+		}
+	}
+
+	if mwd := w.MWD(-2); Round(*mwd.DirectionMagnetic, 1) != 317 {
+		t.Fatalf("Wrong MWD (got: %s)", mwd.Serialize())
+	}
+}
@@ -0,0 +1,133 @@
+package nmea
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestUBXPosition(t *testing.T) {
+	msg, err := Parse("$PUBX,00,221103.00,3852.55427,S,17745.23351,E,34.318,D3,1.3,2.6,1.854,213.45,-0.023,4,0.81,1.35,0.94,12,0,0*6C")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pos := msg.(*PUBX00)
+
+	if pos.NavStatus != UBXDifferential3D || pos.DGPSAge == nil || *pos.DGPSAge != 4 || pos.NbOfSatellitesUsed != 12 {
+		t.Fatalf("Wrong u-blox position (got: %+v)", pos)
+	}
+
+	f, ok := NewFix(msg)
+	if !ok || f.IsValid != Valid || math.Abs(f.Speed-1.0011) > 1e-4 || f.Position.Latitude >= 0 {
+		t.Fatalf("Wrong u-blox fix (got: %+v)", f)
+	}
+
+	// Generated without header
+	if raw := (PUBX00{NavStatus: UBXNoFix}).Serialize(); raw[:9] != "$PUBX,00," {
+		t.Fatalf("Wrong generated PUBX,00 (got: %s)", raw)
+	}
+}
+
+func TestUBXSatellites(t *testing.T) {
+	msg, err := Parse("$PUBX,03,3,05,U,241,62,47,064,13,e,092,14,,000,120,-,198,32,38,031*02")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sats := msg.(*PUBX03)
+	if s := sats.Satellites[0]; *s.Azimuth != 241 || *s.Elevation != 62 || *s.SNR != 47 || s.LockTime != 64 {
+		t.Fatalf("Wrong u-blox satellite (got: %+v)", s)
+	}
+	if s := sats.Satellites[1]; s.Status != UBXSatEphemeris || s.SNR != nil {
+		t.Fatalf("Wrong u-blox satellite (got: %+v)", s)
+	}
+	if used := sats.Used(); len(used) != 1 || used[0] != "05" {
+		t.Fatalf("Wrong used satellites (got: %v)", used)
+	}
+
+	view, err := NewGSVAssembler().Add(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if view == nil || view.SatellitesInView != 3 || view.Satellites[2].ID != "120" {
+		t.Fatalf("Wrong u-blox sky view (got: %+v)", view)
+	}
+}
+
+func TestUBXTime(t *testing.T) {
+	r := NewDateResolver()
+	if leap, ok := r.LeapSeconds(); ok || leap != DefaultLeapSeconds {
+		t.Fatalf("Wrong default leap seconds (got: %d)", leap)
+	}
+
+	// Default leap seconds can be overridden until received
+	r.DefaultLeapSeconds = 17
+	if leap, ok := r.LeapSeconds(); ok || leap != 17 {
+		t.Fatalf("Wrong overridden leap seconds (got: %d)", leap)
+	}
+
+	// Firmware default leap seconds are ignored
+	msg, err := Parse("$PUBX,04,073731.00,091202,113851.00,1196,15D,1930035,-2660.664,43,*5D")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := r.Resolve(msg); !ok {
+		t.Fatal("PUBX,04 should provide a date")
+	}
+	if _, ok := r.LeapSeconds(); ok {
+		t.Fatal("Default leap seconds shouldn't be kept")
+	}
+
+	msg, err = Parse("$PUBX,04,221103.00,150324,511863.00,2305,18,-1542,28.417,21,*26")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tod := msg.(*PUBX04)
+
+	if !GPSTime(tod.UTCWeek, tod.UTCTimeOfWeek).Equal(tod.DateTimeUTC) {
+		t.Fatalf("Wrong UTC week and time of week (got: %s)", GPSTime(tod.UTCWeek, tod.UTCTimeOfWeek))
+	}
+
+	r.Resolve(msg)
+	if leap, ok := r.LeapSeconds(); !ok || leap != 18 {
+		t.Fatalf("Wrong leap seconds (got: %d)", leap)
+	}
+
+	if !GPSToUTC(tod.GPSTime(), 18).Equal(tod.DateTimeUTC) || tod.GPSTime().Sub(tod.DateTimeUTC) != 18*time.Second {
+		t.Fatalf("Wrong GPS time (got: %s)", tod.GPSTime())
+	}
+}
+
+func TestUBXCommands(t *testing.T) {
+	rate, err := NewUBXSetRate("GSV", [UBXNbOfPorts]int{UBXPortUART1: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if wanted := "$PUBX,40,GSV,0,5,0,0,0,0*5C"; rate.Serialize() != wanted {
+		t.Fatalf("Wrong u-blox rate command (got: %s, wanted: %s)", rate.Serialize(), wanted)
+	}
+
+	port, err := NewUBXSetPort(UBXPortUART1, UBXProtocolUBX|UBXProtocolNMEA|UBXProtocolRTCM2, UBXProtocolUBX|UBXProtocolNMEA, 19200, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if wanted := "$PUBX,41,1,0007,0003,19200,0*25"; port.Serialize() != wanted {
+		t.Fatalf("Wrong u-blox port command (got: %s, wanted: %s)", port.Serialize(), wanted)
+	}
+
+	if _, err := NewUBXSetRate("XYZ", [UBXNbOfPorts]int{}); err == nil {
+		t.Fatal("Unknown message identifier should be rejected")
+	}
+	if _, err := NewUBXSetRate("GGA", [UBXNbOfPorts]int{UBXPortUSB: 256}); err == nil {
+		t.Fatal("Output rate out of range should be rejected")
+	}
+	if _, err := NewUBXSetPort(UBXPortUSB, UBXProtocolNMEA, 0x40, 9600, false); err == nil {
+		t.Fatal("Unknown protocol should be rejected")
+	}
+	if _, err := NewUBXSetPort(UBXPortUSB, UBXProtocolNMEA, UBXProtocolNMEA, 1200, false); err == nil {
+		t.Fatal("Unsupported baud rate should be rejected")
+	}
+	if _, err := Parse("$PUBX,41,5,0007,0003,19200,0*21"); err == nil {
+		t.Fatal("Unknown port should be rejected")
+	}
+}
@@ -0,0 +1,294 @@
+package nmea
+
+import "fmt"
+
+const (
+	// Feet is a DepthUnit as string "f"
+	Feet DepthUnit = "f"
+	// Meters is a DepthUnit as string "M"
+	Meters DepthUnit = "M"
+	// Fathoms is a DepthUnit as string "F"
+	Fathoms DepthUnit = "F"
+
+	metersPerFoot   = 0.3048
+	metersPerFathom = 1.8288
+)
+
+// DepthUnit type as string
+type DepthUnit string
+
+// Serialize return DepthUnit as string
+func (u DepthUnit) Serialize() string {
+	return string(u)
+}
+
+// String return DepthUnit as human string
+func (u DepthUnit) String() string {
+	switch u {
+	case Feet:
+		return "feet"
+	case Meters:
+		return "meters"
+	case Fathoms:
+		return "fathoms"
+	default:
+		return "unknow"
+	}
+}
+
+// ToMeters convert value expressed in DepthUnit to meters
+func (u DepthUnit) ToMeters(value float64) float64 {
+	switch u {
+	case Feet:
+		return value * metersPerFoot
+	case Fathoms:
+		return value * metersPerFathom
+	default:
+		return value
+	}
+}
+
+// FromMeters convert value in meters to DepthUnit
+func (u DepthUnit) FromMeters(value float64) float64 {
+	switch u {
+	case Feet:
+		return value / metersPerFoot
+	case Fathoms:
+		return value / metersPerFathom
+	default:
+		return value
+	}
+}
+
+// ParseDepthUnit check DepthUnit validity, return an error
+// "unknow value" if not
+func ParseDepthUnit(raw string) (u DepthUnit, err error) {
+	u = DepthUnit(raw)
+	switch u {
+	case Feet, Meters, Fathoms:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
+
+const (
+	// Knots is a SpeedUnit as string "N"
+	Knots SpeedUnit = "N"
+	// KilometersPerHour is a SpeedUnit as string "K"
+	KilometersPerHour SpeedUnit = "K"
+	// MetersPerSecond is a SpeedUnit as string "M"
+	MetersPerSecond SpeedUnit = "M"
+	// StatuteMilesPerHour is a SpeedUnit as string "S"
+	StatuteMilesPerHour SpeedUnit = "S"
+
+	kilometersPerHourPerKnot = 1.852
+	statuteMilesPerKnot      = 1.150779
+)
+
+// SpeedUnit type as string
+type SpeedUnit string
+
+// Serialize return SpeedUnit as string
+func (u SpeedUnit) Serialize() string {
+	return string(u)
+}
+
+// String return SpeedUnit as human string
+func (u SpeedUnit) String() string {
+	switch u {
+	case Knots:
+		return "knots"
+	case KilometersPerHour:
+		return "km/h"
+	case MetersPerSecond:
+		return "m/s"
+	case StatuteMilesPerHour:
+		return "mph"
+	default:
+		return "unknow"
+	}
+}
+
+// ToKnots convert value expressed in SpeedUnit to knots
+func (u SpeedUnit) ToKnots(value float64) float64 {
+	switch u {
+	case KilometersPerHour:
+		return value / kilometersPerHourPerKnot
+	case MetersPerSecond:
+		return value / KnotsToMetersPerSecond
+	case StatuteMilesPerHour:
+		return value / statuteMilesPerKnot
+	default:
+		return value
+	}
+}
+
+// FromKnots convert value in knots to SpeedUnit
+func (u SpeedUnit) FromKnots(value float64) float64 {
+	switch u {
+	case KilometersPerHour:
+		return value * kilometersPerHourPerKnot
+	case MetersPerSecond:
+		return value * KnotsToMetersPerSecond
+	case StatuteMilesPerHour:
+		return value * statuteMilesPerKnot
+	default:
+		return value
+	}
+}
+
+// ParseSpeedUnit check SpeedUnit validity, return an error
+// "unknow value" if not
+func ParseSpeedUnit(raw string) (u SpeedUnit, err error) {
+	u = SpeedUnit(raw)
+	switch u {
+	case Knots, KilometersPerHour, MetersPerSecond, StatuteMilesPerHour:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
+
+const (
+	// InchesOfMercury is a PressureUnit as string "I"
+	InchesOfMercury PressureUnit = "I"
+	// Bars is a PressureUnit as string "B"
+	Bars PressureUnit = "B"
+	// Pascals is a PressureUnit as string "P"
+	Pascals PressureUnit = "P"
+
+	barsPerInchOfMercury = 0.0338639
+	pascalsPerBar        = 100000
+)
+
+// PressureUnit type as string
+type PressureUnit string
+
+// Serialize return PressureUnit as string
+func (u PressureUnit) Serialize() string {
+	return string(u)
+}
+
+// String return PressureUnit as human string
+func (u PressureUnit) String() string {
+	switch u {
+	case InchesOfMercury:
+		return "inHg"
+	case Bars:
+		return "bar"
+	case Pascals:
+		return "Pa"
+	default:
+		return "unknow"
+	}
+}
+
+// ToBars convert value expressed in PressureUnit to bars
+func (u PressureUnit) ToBars(value float64) float64 {
+	switch u {
+	case InchesOfMercury:
+		return value * barsPerInchOfMercury
+	case Pascals:
+		return value / pascalsPerBar
+	default:
+		return value
+	}
+}
+
+// FromBars convert value in bars to PressureUnit
+func (u PressureUnit) FromBars(value float64) float64 {
+	switch u {
+	case InchesOfMercury:
+		return value / barsPerInchOfMercury
+	case Pascals:
+		return value * pascalsPerBar
+	default:
+		return value
+	}
+}
+
+// ParsePressureUnit check PressureUnit validity, return an error
+// "unknow value" if not
+func ParsePressureUnit(raw string) (u PressureUnit, err error) {
+	u = PressureUnit(raw)
+	switch u {
+	case InchesOfMercury, Bars, Pascals:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
+
+const (
+	// Kilometers is a DistanceUnit as string "K"
+	Kilometers DistanceUnit = "K"
+	// NauticalMiles is a DistanceUnit as string "N"
+	NauticalMiles DistanceUnit = "N"
+	// StatuteMiles is a DistanceUnit as string "S"
+	StatuteMiles DistanceUnit = "S"
+
+	metersPerStatuteMile = 1609.344
+)
+
+// DistanceUnit type as string
+type DistanceUnit string
+
+// Serialize return DistanceUnit as string
+func (u DistanceUnit) Serialize() string {
+	return string(u)
+}
+
+// String return DistanceUnit as human string
+func (u DistanceUnit) String() string {
+	switch u {
+	case Kilometers:
+		return "kilometers"
+	case NauticalMiles:
+		return "nautical miles"
+	case StatuteMiles:
+		return "statute miles"
+	default:
+		return "unknow"
+	}
+}
+
+// ToMeters convert value expressed in DistanceUnit to meters
+func (u DistanceUnit) ToMeters(value float64) float64 {
+	switch u {
+	case Kilometers:
+		return value * 1000
+	case NauticalMiles:
+		return value * MetersPerNauticalMile
+	case StatuteMiles:
+		return value * metersPerStatuteMile
+	default:
+		return value
+	}
+}
+
+// FromMeters convert value in meters to DistanceUnit
+func (u DistanceUnit) FromMeters(value float64) float64 {
+	switch u {
+	case Kilometers:
+		return value / 1000
+	case NauticalMiles:
+		return value / MetersPerNauticalMile
+	case StatuteMiles:
+		return value / metersPerStatuteMile
+	default:
+		return value
+	}
+}
+
+// ParseDistanceUnit check DistanceUnit validity, return an error
+// "unknow value" if not
+func ParseDistanceUnit(raw string) (u DistanceUnit, err error) {
+	u = DistanceUnit(raw)
+	switch u {
+	case Kilometers, NauticalMiles, StatuteMiles:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}